@@ -7,20 +7,35 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/tribbae/backend/internal/admin"
 	"github.com/tribbae/backend/internal/ai"
 	"github.com/tribbae/backend/internal/auth"
+	"github.com/tribbae/backend/internal/calendar"
 	"github.com/tribbae/backend/internal/child"
 	"github.com/tribbae/backend/internal/comment"
 	"github.com/tribbae/backend/internal/config"
 	"github.com/tribbae/backend/internal/db"
+	"github.com/tribbae/backend/internal/exchange"
 	"github.com/tribbae/backend/internal/folder"
 	"github.com/tribbae/backend/internal/follow"
+	"github.com/tribbae/backend/internal/geo"
+	"github.com/tribbae/backend/internal/gift"
+	"github.com/tribbae/backend/internal/household"
 	"github.com/tribbae/backend/internal/interceptor"
 	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/linkcheck"
+	"github.com/tribbae/backend/internal/mealplan"
+	"github.com/tribbae/backend/internal/notify"
+	"github.com/tribbae/backend/internal/pricewatch"
+	"github.com/tribbae/backend/internal/reminder"
+	"github.com/tribbae/backend/internal/search"
+	"github.com/tribbae/backend/internal/shopping"
+	"github.com/tribbae/backend/internal/trash"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -52,11 +67,87 @@ func main() {
 	authSvc := auth.NewService(database.Col("users"), cfg.JWTSecret)
 	folderSvc := folder.NewService(database.Col("folders"), database.Col("links"), database.Col("users"), cfg.BaseURL)
 	linkSvc := link.NewService(database.Col("links"), database.Col("folders"))
+	linkSvc.SetGeocoder(newGeocoder(cfg))
 	childSvc := child.NewService(database.DB())
 	followSvc := follow.NewService(database.Col("follows"), database.Col("users"))
 	commentSvc := comment.NewService(database.Col("comments"), database.Col("links"), database.Col("users"))
+	searchSvc := search.NewService(database.Col("links"), database.Col("folders"), linkSvc)
+	shoppingSvc := shopping.NewService(database.Col("shopping_lists"), linkSvc)
+	mealPlanSvc := mealplan.NewService(database.Col("meal_plans"), database.Col("folders"), linkSvc, shoppingSvc)
+	calendarSvc := calendar.NewService(database.Col("calendar_feeds"), database.Col("folders"), linkSvc, cfg.BaseURL)
+	if len(cfg.BirthdayReminderOffsets) > 0 {
+		calendarSvc.SetBirthdayLead(time.Duration(slices.Max(cfg.BirthdayReminderOffsets)) * 24 * time.Hour)
+	}
+	giftSvc := gift.NewService(database.Col("gift_claims"), database.Col("links"), database.Col("folders"), database.Col("users"))
+	exchangeSvc := exchange.NewService(database.Col("gift_exchanges"), database.Col("gift_exchange_draws"), database.Col("users"),
+		database.Col("folders"), database.Col("links"), notify.LogNotifier{})
+	householdSvc := household.NewService(database.Col("households"), database.Col("folders"), database.Col("users"))
 	aiSvc := ai.NewService(cfg.OllamaURL, cfg.OllamaModel, cfg.SearxURL, cfg.GeminiAPIKey)
 
+	// Remplit les champs dérivés des documents créés avant leur ajout : texte
+	// de recherche des dossiers, date normalisée, clé de doublon, ingrédients
+	// analysés, coordonnées, tranches d'âge et prix des liens
+	go func() {
+		if err := linkSvc.BackfillFolderSearchText(context.Background()); err != nil {
+			log.Printf("ERROR: backfill folder search text: %v", err)
+		}
+		if err := linkSvc.BackfillEventAt(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link event_at: %v", err)
+		}
+		if err := linkSvc.BackfillCanonicalKeys(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link canonical keys: %v", err)
+		}
+		if err := linkSvc.BackfillParsedIngredients(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link parsed ingredients: %v", err)
+		}
+		if err := linkSvc.BackfillGeo(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link coordinates: %v", err)
+		}
+		if err := linkSvc.BackfillAges(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link age ranges: %v", err)
+		}
+		if err := linkSvc.BackfillPriceAmounts(context.Background()); err != nil {
+			log.Printf("ERROR: backfill link prices: %v", err)
+		}
+		if err := childSvc.BackfillBirthdays(context.Background()); err != nil {
+			log.Printf("ERROR: backfill child birthdays: %v", err)
+		}
+	}()
+
+	// Texte de recherche des dossiers dont les liens ont changé
+	if cfg.SearchTextInterval > 0 {
+		go linkSvc.RunFolderSearchText(context.Background(), cfg.SearchTextInterval)
+	}
+
+	// Vérification périodique des URLs des liens
+	if cfg.LinkCheckInterval > 0 {
+		checkCfg := linkcheck.DefaultConfig
+		checkCfg.Interval = cfg.LinkCheckInterval
+		go linkcheck.NewChecker(database.Col("links"), checkCfg).Run(context.Background())
+	}
+
+	// Rappels des événements
+	if cfg.ReminderInterval > 0 {
+		reminderCfg := reminder.DefaultConfig
+		reminderCfg.Interval = cfg.ReminderInterval
+		reminderCfg.Offsets = cfg.ReminderOffsets
+		reminderCfg.BirthdayOffsets = cfg.BirthdayReminderOffsets
+		reminderCfg.NotifyCollaborators = cfg.BirthdayNotifyCollaborators
+		if loc, err := time.LoadLocation(cfg.ReminderTimezone); err == nil {
+			reminderCfg.Location = loc
+		} else {
+			log.Printf("invalid REMINDER_TIMEZONE %q, using %s", cfg.ReminderTimezone, reminderCfg.Location)
+		}
+		go reminder.NewScheduler(database.Col("links"), database.Col("reminders"), notify.LogNotifier{}, reminderCfg).Run(context.Background())
+	}
+
+	// Suivi des prix des cadeaux
+	if cfg.PriceWatchInterval > 0 {
+		watchCfg := pricewatch.DefaultConfig
+		watchCfg.Interval = cfg.PriceWatchInterval
+		go pricewatch.NewWatcher(database.Col("links"), database.Col("price_history"), notify.LogNotifier{}, watchCfg).Run(context.Background())
+	}
+
 	// Handlers (gRPC servers)
 	authH := auth.NewHandler(authSvc)
 	folderH := folder.NewHandler(folderSvc)
@@ -65,6 +156,14 @@ func main() {
 	followH := follow.NewHandler(followSvc)
 	commentH := comment.NewHandler(commentSvc)
 	adminH := admin.NewHandler(authSvc)
+	trashH := trash.NewHandler(folderSvc, linkSvc)
+	searchH := search.NewHandler(searchSvc)
+	shoppingH := shopping.NewHandler(shoppingSvc)
+	mealPlanH := mealplan.NewHandler(mealPlanSvc)
+	calendarH := calendar.NewHandler(calendarSvc)
+	giftH := gift.NewHandler(giftSvc)
+	exchangeH := exchange.NewHandler(exchangeSvc)
+	householdH := household.NewHandler(householdSvc)
 	
 	// Adaptateur pour récupérer le statut premium d'un utilisateur
 	userGetter := &userGetterAdapter{authSvc: authSvc}
@@ -124,6 +223,14 @@ func main() {
 	pb.RegisterFollowServiceServer(grpcServer, followH)
 	pb.RegisterCommentServiceServer(grpcServer, commentH)
 	pb.RegisterAdminServiceServer(grpcServer, adminH)
+	pb.RegisterTrashServiceServer(grpcServer, trashH)
+	pb.RegisterSearchServiceServer(grpcServer, searchH)
+	pb.RegisterShoppingListServiceServer(grpcServer, shoppingH)
+	pb.RegisterMealPlanServiceServer(grpcServer, mealPlanH)
+	pb.RegisterCalendarServiceServer(grpcServer, calendarH)
+	pb.RegisterGiftServiceServer(grpcServer, giftH)
+	pb.RegisterGiftExchangeServiceServer(grpcServer, exchangeH)
+	pb.RegisterHouseholdServiceServer(grpcServer, householdH)
 	reflection.Register(grpcServer)
 
 	grpcAddr := ":" + cfg.GRPCPort
@@ -175,13 +282,57 @@ func main() {
 	if err := pb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		log.Fatalf("register admin gateway: %v", err)
 	}
+	if err := pb.RegisterTrashServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register trash gateway: %v", err)
+	}
+	if err := pb.RegisterSearchServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register search gateway: %v", err)
+	}
+	if err := pb.RegisterShoppingListServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register shopping list gateway: %v", err)
+	}
+	if err := pb.RegisterMealPlanServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register meal plan gateway: %v", err)
+	}
+	if err := pb.RegisterCalendarServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register calendar gateway: %v", err)
+	}
+	if err := pb.RegisterGiftServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register gift gateway: %v", err)
+	}
+	if err := pb.RegisterGiftExchangeServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register gift exchange gateway: %v", err)
+	}
+	if err := pb.RegisterHouseholdServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("register household gateway: %v", err)
+	}
 
 	httpAddr := ":" + cfg.Port
 	log.Printf("HTTP server listening on %s", httpAddr)
-	handler := cors(withAI(aiH, withPreview(withSPA(mux))))
+	handler := cors(withAI(aiH, withPreview(withCalendarFeed(calendarSvc, withSPA(mux)))))
 	log.Fatal(http.ListenAndServe(httpAddr, handler))
 }
 
+// newGeocoder interroge l'API Adresse configurée et se replie sur le
+// répertoire des communes quand elle ne répond pas
+func newGeocoder(cfg *config.Config) geo.Geocoder {
+	gazetteer := geo.DefaultGazetteer()
+	if cfg.GazetteerPath != "" {
+		f, err := os.Open(cfg.GazetteerPath)
+		if err != nil {
+			log.Fatalf("open gazetteer: %v", err)
+		}
+		defer f.Close()
+		if gazetteer, err = geo.LoadGazetteer(f); err != nil {
+			log.Fatalf("load gazetteer: %v", err)
+		}
+	}
+	if cfg.GeocoderURL == "off" {
+		return gazetteer
+	}
+	return geo.Chain{geo.NewAddressAPI(cfg.GeocoderURL), gazetteer}
+}
+
 func withAI(aiH http.Handler, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/v1/ai/generate" && r.Method == http.MethodPost {
@@ -203,6 +354,19 @@ func withPreview(next http.Handler) http.Handler {
 	})
 }
 
+// withCalendarFeed sert les flux iCalendar des agendas abonnés, authentifiés
+// par le jeton de leur adresse plutôt que par l'en-tête Authorization
+func withCalendarFeed(svc *calendar.Service, next http.Handler) http.Handler {
+	feed := svc.FeedHandler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, calendar.FeedPrefix) && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+			feed(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func cors(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
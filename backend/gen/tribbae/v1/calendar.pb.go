@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/calendar.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Flux iCalendar des liens événements, auquel un agenda peut s'abonner.
+// L'adresse contient un jeton secret : quiconque la connaît voit les événements.
+type CalendarFeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`    // vide : tous les événements de l'utilisateur
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                              // https://…/v1/calendar/<jeton>.ics
+	WebcalUrl     string                 `protobuf:"bytes,3,opt,name=webcal_url,json=webcalUrl,proto3" json:"webcal_url,omitempty"` // webcal://…, ouvre l'abonnement sur le téléphone
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CalendarFeed) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetWebcalUrl() string {
+	if x != nil {
+		return x.WebcalUrl
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Rotate        bool                   `protobuf:"varint,2,opt,name=rotate,proto3" json:"rotate,omitempty"` // remplace le jeton ; l'ancienne adresse cesse de fonctionner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCalendarFeedRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetRotate() bool {
+	if x != nil {
+		return x.Rotate
+	}
+	return false
+}
+
+type GetCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          *CalendarFeed          `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCalendarFeedResponse) GetFeed() *CalendarFeed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+type RevokeCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevokeCalendarFeedRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type RevokeCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedResponse) Reset() {
+	*x = RevokeCalendarFeedResponse{}
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedResponse) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_calendar_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+var File_tribbae_v1_calendar_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_calendar_proto_rawDesc = "" +
+	"\n" +
+	"\x19tribbae/v1/calendar.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x01\n" +
+	"\fCalendarFeed\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"webcal_url\x18\x03 \x01(\tR\twebcalUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\x16GetCalendarFeedRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x16\n" +
+	"\x06rotate\x18\x02 \x01(\bR\x06rotate\"G\n" +
+	"\x17GetCalendarFeedResponse\x12,\n" +
+	"\x04feed\x18\x01 \x01(\v2\x18.tribbae.v1.CalendarFeedR\x04feed\"8\n" +
+	"\x19RevokeCalendarFeedRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\x1c\n" +
+	"\x1aRevokeCalendarFeedResponse2\x8d\x02\n" +
+	"\x0fCalendarService\x12y\n" +
+	"\x0fGetCalendarFeed\x12\".tribbae.v1.GetCalendarFeedRequest\x1a#.tribbae.v1.GetCalendarFeedResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/calendar-feeds\x12\x7f\n" +
+	"\x12RevokeCalendarFeed\x12%.tribbae.v1.RevokeCalendarFeedRequest\x1a&.tribbae.v1.RevokeCalendarFeedResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/calendar-feedsB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_calendar_proto_rawDescOnce sync.Once
+	file_tribbae_v1_calendar_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_calendar_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_calendar_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_calendar_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_calendar_proto_rawDesc), len(file_tribbae_v1_calendar_proto_rawDesc)))
+	})
+	return file_tribbae_v1_calendar_proto_rawDescData
+}
+
+var file_tribbae_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tribbae_v1_calendar_proto_goTypes = []any{
+	(*CalendarFeed)(nil),               // 0: tribbae.v1.CalendarFeed
+	(*GetCalendarFeedRequest)(nil),     // 1: tribbae.v1.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),    // 2: tribbae.v1.GetCalendarFeedResponse
+	(*RevokeCalendarFeedRequest)(nil),  // 3: tribbae.v1.RevokeCalendarFeedRequest
+	(*RevokeCalendarFeedResponse)(nil), // 4: tribbae.v1.RevokeCalendarFeedResponse
+	(*timestamppb.Timestamp)(nil),      // 5: google.protobuf.Timestamp
+}
+var file_tribbae_v1_calendar_proto_depIdxs = []int32{
+	5, // 0: tribbae.v1.CalendarFeed.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: tribbae.v1.GetCalendarFeedResponse.feed:type_name -> tribbae.v1.CalendarFeed
+	1, // 2: tribbae.v1.CalendarService.GetCalendarFeed:input_type -> tribbae.v1.GetCalendarFeedRequest
+	3, // 3: tribbae.v1.CalendarService.RevokeCalendarFeed:input_type -> tribbae.v1.RevokeCalendarFeedRequest
+	2, // 4: tribbae.v1.CalendarService.GetCalendarFeed:output_type -> tribbae.v1.GetCalendarFeedResponse
+	4, // 5: tribbae.v1.CalendarService.RevokeCalendarFeed:output_type -> tribbae.v1.RevokeCalendarFeedResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_calendar_proto_init() }
+func file_tribbae_v1_calendar_proto_init() {
+	if File_tribbae_v1_calendar_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_calendar_proto_rawDesc), len(file_tribbae_v1_calendar_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_calendar_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_calendar_proto_depIdxs,
+		MessageInfos:      file_tribbae_v1_calendar_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_calendar_proto = out.File
+	file_tribbae_v1_calendar_proto_goTypes = nil
+	file_tribbae_v1_calendar_proto_depIdxs = nil
+}
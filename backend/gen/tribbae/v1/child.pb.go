@@ -23,15 +23,20 @@ const (
 )
 
 type Child struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	BirthDate     int64                  `protobuf:"varint,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	BirthDate int64                  `protobuf:"varint,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Parents avec qui le propriétaire partage l'enfant ; ils le modifient
+	// comme lui, sans pouvoir le supprimer
+	CoParentIds []string `protobuf:"bytes,7,rep,name=co_parent_ids,json=coParentIds,proto3" json:"co_parent_ids,omitempty"`
+	// Parents invités qui n'ont pas encore accepté ; ils ne voient pas l'enfant
+	PendingCoParentIds []string `protobuf:"bytes,8,rep,name=pending_co_parent_ids,json=pendingCoParentIds,proto3" json:"pending_co_parent_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Child) Reset() {
@@ -106,6 +111,20 @@ func (x *Child) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Child) GetCoParentIds() []string {
+	if x != nil {
+		return x.CoParentIds
+	}
+	return nil
+}
+
+func (x *Child) GetPendingCoParentIds() []string {
+	if x != nil {
+		return x.PendingCoParentIds
+	}
+	return nil
+}
+
 type CreateChildRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -466,12 +485,456 @@ func (*DeleteChildResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{8}
 }
 
+// Invite un co-parent ; il n'a accès à l'enfant qu'après avoir accepté
+type AddCoParentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // compte du co-parent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCoParentRequest) Reset() {
+	*x = AddCoParentRequest{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCoParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCoParentRequest) ProtoMessage() {}
+
+func (x *AddCoParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCoParentRequest.ProtoReflect.Descriptor instead.
+func (*AddCoParentRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddCoParentRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *AddCoParentRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AddCoParentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Child         *Child                 `protobuf:"bytes,1,opt,name=child,proto3" json:"child,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCoParentResponse) Reset() {
+	*x = AddCoParentResponse{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCoParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCoParentResponse) ProtoMessage() {}
+
+func (x *AddCoParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCoParentResponse.ProtoReflect.Descriptor instead.
+func (*AddCoParentResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddCoParentResponse) GetChild() *Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// Le propriétaire retire un co-parent ou annule son invitation ; un co-parent
+// peut se retirer lui-même
+type RemoveCoParentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCoParentRequest) Reset() {
+	*x = RemoveCoParentRequest{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCoParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCoParentRequest) ProtoMessage() {}
+
+func (x *RemoveCoParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCoParentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCoParentRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveCoParentRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *RemoveCoParentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveCoParentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Child         *Child                 `protobuf:"bytes,1,opt,name=child,proto3" json:"child,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCoParentResponse) Reset() {
+	*x = RemoveCoParentResponse{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCoParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCoParentResponse) ProtoMessage() {}
+
+func (x *RemoveCoParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCoParentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCoParentResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveCoParentResponse) GetChild() *Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+type ListCoParentInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoParentInvitesRequest) Reset() {
+	*x = ListCoParentInvitesRequest{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoParentInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoParentInvitesRequest) ProtoMessage() {}
+
+func (x *ListCoParentInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoParentInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoParentInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{13}
+}
+
+// Enfants auxquels l'utilisateur est invité, réduits à id, owner_id et name
+type ListCoParentInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Children      []*Child               `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoParentInvitesResponse) Reset() {
+	*x = ListCoParentInvitesResponse{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoParentInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoParentInvitesResponse) ProtoMessage() {}
+
+func (x *ListCoParentInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoParentInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListCoParentInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCoParentInvitesResponse) GetChildren() []*Child {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type AcceptCoParentInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCoParentInviteRequest) Reset() {
+	*x = AcceptCoParentInviteRequest{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCoParentInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCoParentInviteRequest) ProtoMessage() {}
+
+func (x *AcceptCoParentInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCoParentInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCoParentInviteRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcceptCoParentInviteRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+type AcceptCoParentInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Child         *Child                 `protobuf:"bytes,1,opt,name=child,proto3" json:"child,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCoParentInviteResponse) Reset() {
+	*x = AcceptCoParentInviteResponse{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCoParentInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCoParentInviteResponse) ProtoMessage() {}
+
+func (x *AcceptCoParentInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCoParentInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCoParentInviteResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptCoParentInviteResponse) GetChild() *Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+type DeclineCoParentInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineCoParentInviteRequest) Reset() {
+	*x = DeclineCoParentInviteRequest{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineCoParentInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineCoParentInviteRequest) ProtoMessage() {}
+
+func (x *DeclineCoParentInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineCoParentInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCoParentInviteRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeclineCoParentInviteRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+type DeclineCoParentInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineCoParentInviteResponse) Reset() {
+	*x = DeclineCoParentInviteResponse{}
+	mi := &file_tribbae_v1_child_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineCoParentInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineCoParentInviteResponse) ProtoMessage() {}
+
+func (x *DeclineCoParentInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_child_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineCoParentInviteResponse.ProtoReflect.Descriptor instead.
+func (*DeclineCoParentInviteResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_child_proto_rawDescGZIP(), []int{18}
+}
+
 var File_tribbae_v1_child_proto protoreflect.FileDescriptor
 
 const file_tribbae_v1_child_proto_rawDesc = "" +
 	"\n" +
 	"\x16tribbae/v1/child.proto\x12\n" +
-	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\"\xa3\x01\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\"\xfa\x01\n" +
 	"\x05Child\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
@@ -481,7 +944,9 @@ const file_tribbae_v1_child_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"G\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12\"\n" +
+	"\rco_parent_ids\x18\a \x03(\tR\vcoParentIds\x121\n" +
+	"\x15pending_co_parent_ids\x18\b \x03(\tR\x12pendingCoParentIds\"G\n" +
 	"\x12CreateChildRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
@@ -500,12 +965,37 @@ const file_tribbae_v1_child_proto_rawDesc = "" +
 	"\x05child\x18\x01 \x01(\v2\x11.tribbae.v1.ChildR\x05child\"/\n" +
 	"\x12DeleteChildRequest\x12\x19\n" +
 	"\bchild_id\x18\x01 \x01(\tR\achildId\"\x15\n" +
-	"\x13DeleteChildResponse2\xc5\x03\n" +
+	"\x13DeleteChildResponse\"E\n" +
+	"\x12AddCoParentRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\">\n" +
+	"\x13AddCoParentResponse\x12'\n" +
+	"\x05child\x18\x01 \x01(\v2\x11.tribbae.v1.ChildR\x05child\"K\n" +
+	"\x15RemoveCoParentRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"A\n" +
+	"\x16RemoveCoParentResponse\x12'\n" +
+	"\x05child\x18\x01 \x01(\v2\x11.tribbae.v1.ChildR\x05child\"\x1c\n" +
+	"\x1aListCoParentInvitesRequest\"L\n" +
+	"\x1bListCoParentInvitesResponse\x12-\n" +
+	"\bchildren\x18\x01 \x03(\v2\x11.tribbae.v1.ChildR\bchildren\"8\n" +
+	"\x1bAcceptCoParentInviteRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\"G\n" +
+	"\x1cAcceptCoParentInviteResponse\x12'\n" +
+	"\x05child\x18\x01 \x01(\v2\x11.tribbae.v1.ChildR\x05child\"9\n" +
+	"\x1cDeclineCoParentInviteRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\"\x1f\n" +
+	"\x1dDeclineCoParentInviteResponse2\x95\t\n" +
 	"\fChildService\x12g\n" +
 	"\vCreateChild\x12\x1e.tribbae.v1.CreateChildRequest\x1a\x1f.tribbae.v1.CreateChildResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/children\x12g\n" +
 	"\fListChildren\x12\x1f.tribbae.v1.ListChildrenRequest\x1a .tribbae.v1.ListChildrenResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/children\x12r\n" +
 	"\vUpdateChild\x12\x1e.tribbae.v1.UpdateChildRequest\x1a\x1f.tribbae.v1.UpdateChildResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/children/{child_id}\x12o\n" +
-	"\vDeleteChild\x12\x1e.tribbae.v1.DeleteChildRequest\x1a\x1f.tribbae.v1.DeleteChildResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/children/{child_id}B5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+	"\vDeleteChild\x12\x1e.tribbae.v1.DeleteChildRequest\x1a\x1f.tribbae.v1.DeleteChildResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/children/{child_id}\x12}\n" +
+	"\vAddCoParent\x12\x1e.tribbae.v1.AddCoParentRequest\x1a\x1f.tribbae.v1.AddCoParentResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/children/{child_id}/co-parents\x12\x8d\x01\n" +
+	"\x0eRemoveCoParent\x12!.tribbae.v1.RemoveCoParentRequest\x1a\".tribbae.v1.RemoveCoParentResponse\"4\x82\xd3\xe4\x93\x02.*,/v1/children/{child_id}/co-parents/{user_id}\x12\x85\x01\n" +
+	"\x13ListCoParentInvites\x12&.tribbae.v1.ListCoParentInvitesRequest\x1a'.tribbae.v1.ListCoParentInvitesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/co-parent-invites\x12\x9d\x01\n" +
+	"\x14AcceptCoParentInvite\x12'.tribbae.v1.AcceptCoParentInviteRequest\x1a(.tribbae.v1.AcceptCoParentInviteResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/co-parent-invites/{child_id}/accept\x12\x96\x01\n" +
+	"\x15DeclineCoParentInvite\x12(.tribbae.v1.DeclineCoParentInviteRequest\x1a).tribbae.v1.DeclineCoParentInviteResponse\"(\x82\xd3\xe4\x93\x02\"* /v1/co-parent-invites/{child_id}B5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
 
 var (
 	file_tribbae_v1_child_proto_rawDescOnce sync.Once
@@ -519,35 +1009,59 @@ func file_tribbae_v1_child_proto_rawDescGZIP() []byte {
 	return file_tribbae_v1_child_proto_rawDescData
 }
 
-var file_tribbae_v1_child_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tribbae_v1_child_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_tribbae_v1_child_proto_goTypes = []any{
-	(*Child)(nil),                // 0: tribbae.v1.Child
-	(*CreateChildRequest)(nil),   // 1: tribbae.v1.CreateChildRequest
-	(*CreateChildResponse)(nil),  // 2: tribbae.v1.CreateChildResponse
-	(*ListChildrenRequest)(nil),  // 3: tribbae.v1.ListChildrenRequest
-	(*ListChildrenResponse)(nil), // 4: tribbae.v1.ListChildrenResponse
-	(*UpdateChildRequest)(nil),   // 5: tribbae.v1.UpdateChildRequest
-	(*UpdateChildResponse)(nil),  // 6: tribbae.v1.UpdateChildResponse
-	(*DeleteChildRequest)(nil),   // 7: tribbae.v1.DeleteChildRequest
-	(*DeleteChildResponse)(nil),  // 8: tribbae.v1.DeleteChildResponse
+	(*Child)(nil),                         // 0: tribbae.v1.Child
+	(*CreateChildRequest)(nil),            // 1: tribbae.v1.CreateChildRequest
+	(*CreateChildResponse)(nil),           // 2: tribbae.v1.CreateChildResponse
+	(*ListChildrenRequest)(nil),           // 3: tribbae.v1.ListChildrenRequest
+	(*ListChildrenResponse)(nil),          // 4: tribbae.v1.ListChildrenResponse
+	(*UpdateChildRequest)(nil),            // 5: tribbae.v1.UpdateChildRequest
+	(*UpdateChildResponse)(nil),           // 6: tribbae.v1.UpdateChildResponse
+	(*DeleteChildRequest)(nil),            // 7: tribbae.v1.DeleteChildRequest
+	(*DeleteChildResponse)(nil),           // 8: tribbae.v1.DeleteChildResponse
+	(*AddCoParentRequest)(nil),            // 9: tribbae.v1.AddCoParentRequest
+	(*AddCoParentResponse)(nil),           // 10: tribbae.v1.AddCoParentResponse
+	(*RemoveCoParentRequest)(nil),         // 11: tribbae.v1.RemoveCoParentRequest
+	(*RemoveCoParentResponse)(nil),        // 12: tribbae.v1.RemoveCoParentResponse
+	(*ListCoParentInvitesRequest)(nil),    // 13: tribbae.v1.ListCoParentInvitesRequest
+	(*ListCoParentInvitesResponse)(nil),   // 14: tribbae.v1.ListCoParentInvitesResponse
+	(*AcceptCoParentInviteRequest)(nil),   // 15: tribbae.v1.AcceptCoParentInviteRequest
+	(*AcceptCoParentInviteResponse)(nil),  // 16: tribbae.v1.AcceptCoParentInviteResponse
+	(*DeclineCoParentInviteRequest)(nil),  // 17: tribbae.v1.DeclineCoParentInviteRequest
+	(*DeclineCoParentInviteResponse)(nil), // 18: tribbae.v1.DeclineCoParentInviteResponse
 }
 var file_tribbae_v1_child_proto_depIdxs = []int32{
-	0, // 0: tribbae.v1.CreateChildResponse.child:type_name -> tribbae.v1.Child
-	0, // 1: tribbae.v1.ListChildrenResponse.children:type_name -> tribbae.v1.Child
-	0, // 2: tribbae.v1.UpdateChildResponse.child:type_name -> tribbae.v1.Child
-	1, // 3: tribbae.v1.ChildService.CreateChild:input_type -> tribbae.v1.CreateChildRequest
-	3, // 4: tribbae.v1.ChildService.ListChildren:input_type -> tribbae.v1.ListChildrenRequest
-	5, // 5: tribbae.v1.ChildService.UpdateChild:input_type -> tribbae.v1.UpdateChildRequest
-	7, // 6: tribbae.v1.ChildService.DeleteChild:input_type -> tribbae.v1.DeleteChildRequest
-	2, // 7: tribbae.v1.ChildService.CreateChild:output_type -> tribbae.v1.CreateChildResponse
-	4, // 8: tribbae.v1.ChildService.ListChildren:output_type -> tribbae.v1.ListChildrenResponse
-	6, // 9: tribbae.v1.ChildService.UpdateChild:output_type -> tribbae.v1.UpdateChildResponse
-	8, // 10: tribbae.v1.ChildService.DeleteChild:output_type -> tribbae.v1.DeleteChildResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: tribbae.v1.CreateChildResponse.child:type_name -> tribbae.v1.Child
+	0,  // 1: tribbae.v1.ListChildrenResponse.children:type_name -> tribbae.v1.Child
+	0,  // 2: tribbae.v1.UpdateChildResponse.child:type_name -> tribbae.v1.Child
+	0,  // 3: tribbae.v1.AddCoParentResponse.child:type_name -> tribbae.v1.Child
+	0,  // 4: tribbae.v1.RemoveCoParentResponse.child:type_name -> tribbae.v1.Child
+	0,  // 5: tribbae.v1.ListCoParentInvitesResponse.children:type_name -> tribbae.v1.Child
+	0,  // 6: tribbae.v1.AcceptCoParentInviteResponse.child:type_name -> tribbae.v1.Child
+	1,  // 7: tribbae.v1.ChildService.CreateChild:input_type -> tribbae.v1.CreateChildRequest
+	3,  // 8: tribbae.v1.ChildService.ListChildren:input_type -> tribbae.v1.ListChildrenRequest
+	5,  // 9: tribbae.v1.ChildService.UpdateChild:input_type -> tribbae.v1.UpdateChildRequest
+	7,  // 10: tribbae.v1.ChildService.DeleteChild:input_type -> tribbae.v1.DeleteChildRequest
+	9,  // 11: tribbae.v1.ChildService.AddCoParent:input_type -> tribbae.v1.AddCoParentRequest
+	11, // 12: tribbae.v1.ChildService.RemoveCoParent:input_type -> tribbae.v1.RemoveCoParentRequest
+	13, // 13: tribbae.v1.ChildService.ListCoParentInvites:input_type -> tribbae.v1.ListCoParentInvitesRequest
+	15, // 14: tribbae.v1.ChildService.AcceptCoParentInvite:input_type -> tribbae.v1.AcceptCoParentInviteRequest
+	17, // 15: tribbae.v1.ChildService.DeclineCoParentInvite:input_type -> tribbae.v1.DeclineCoParentInviteRequest
+	2,  // 16: tribbae.v1.ChildService.CreateChild:output_type -> tribbae.v1.CreateChildResponse
+	4,  // 17: tribbae.v1.ChildService.ListChildren:output_type -> tribbae.v1.ListChildrenResponse
+	6,  // 18: tribbae.v1.ChildService.UpdateChild:output_type -> tribbae.v1.UpdateChildResponse
+	8,  // 19: tribbae.v1.ChildService.DeleteChild:output_type -> tribbae.v1.DeleteChildResponse
+	10, // 20: tribbae.v1.ChildService.AddCoParent:output_type -> tribbae.v1.AddCoParentResponse
+	12, // 21: tribbae.v1.ChildService.RemoveCoParent:output_type -> tribbae.v1.RemoveCoParentResponse
+	14, // 22: tribbae.v1.ChildService.ListCoParentInvites:output_type -> tribbae.v1.ListCoParentInvitesResponse
+	16, // 23: tribbae.v1.ChildService.AcceptCoParentInvite:output_type -> tribbae.v1.AcceptCoParentInviteResponse
+	18, // 24: tribbae.v1.ChildService.DeclineCoParentInvite:output_type -> tribbae.v1.DeclineCoParentInviteResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_tribbae_v1_child_proto_init() }
@@ -561,7 +1075,7 @@ func file_tribbae_v1_child_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_child_proto_rawDesc), len(file_tribbae_v1_child_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
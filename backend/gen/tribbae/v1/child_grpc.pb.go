@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChildService_CreateChild_FullMethodName  = "/tribbae.v1.ChildService/CreateChild"
-	ChildService_ListChildren_FullMethodName = "/tribbae.v1.ChildService/ListChildren"
-	ChildService_UpdateChild_FullMethodName  = "/tribbae.v1.ChildService/UpdateChild"
-	ChildService_DeleteChild_FullMethodName  = "/tribbae.v1.ChildService/DeleteChild"
+	ChildService_CreateChild_FullMethodName           = "/tribbae.v1.ChildService/CreateChild"
+	ChildService_ListChildren_FullMethodName          = "/tribbae.v1.ChildService/ListChildren"
+	ChildService_UpdateChild_FullMethodName           = "/tribbae.v1.ChildService/UpdateChild"
+	ChildService_DeleteChild_FullMethodName           = "/tribbae.v1.ChildService/DeleteChild"
+	ChildService_AddCoParent_FullMethodName           = "/tribbae.v1.ChildService/AddCoParent"
+	ChildService_RemoveCoParent_FullMethodName        = "/tribbae.v1.ChildService/RemoveCoParent"
+	ChildService_ListCoParentInvites_FullMethodName   = "/tribbae.v1.ChildService/ListCoParentInvites"
+	ChildService_AcceptCoParentInvite_FullMethodName  = "/tribbae.v1.ChildService/AcceptCoParentInvite"
+	ChildService_DeclineCoParentInvite_FullMethodName = "/tribbae.v1.ChildService/DeclineCoParentInvite"
 )
 
 // ChildServiceClient is the client API for ChildService service.
@@ -33,6 +38,11 @@ type ChildServiceClient interface {
 	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error)
 	UpdateChild(ctx context.Context, in *UpdateChildRequest, opts ...grpc.CallOption) (*UpdateChildResponse, error)
 	DeleteChild(ctx context.Context, in *DeleteChildRequest, opts ...grpc.CallOption) (*DeleteChildResponse, error)
+	AddCoParent(ctx context.Context, in *AddCoParentRequest, opts ...grpc.CallOption) (*AddCoParentResponse, error)
+	RemoveCoParent(ctx context.Context, in *RemoveCoParentRequest, opts ...grpc.CallOption) (*RemoveCoParentResponse, error)
+	ListCoParentInvites(ctx context.Context, in *ListCoParentInvitesRequest, opts ...grpc.CallOption) (*ListCoParentInvitesResponse, error)
+	AcceptCoParentInvite(ctx context.Context, in *AcceptCoParentInviteRequest, opts ...grpc.CallOption) (*AcceptCoParentInviteResponse, error)
+	DeclineCoParentInvite(ctx context.Context, in *DeclineCoParentInviteRequest, opts ...grpc.CallOption) (*DeclineCoParentInviteResponse, error)
 }
 
 type childServiceClient struct {
@@ -83,6 +93,56 @@ func (c *childServiceClient) DeleteChild(ctx context.Context, in *DeleteChildReq
 	return out, nil
 }
 
+func (c *childServiceClient) AddCoParent(ctx context.Context, in *AddCoParentRequest, opts ...grpc.CallOption) (*AddCoParentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCoParentResponse)
+	err := c.cc.Invoke(ctx, ChildService_AddCoParent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *childServiceClient) RemoveCoParent(ctx context.Context, in *RemoveCoParentRequest, opts ...grpc.CallOption) (*RemoveCoParentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCoParentResponse)
+	err := c.cc.Invoke(ctx, ChildService_RemoveCoParent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *childServiceClient) ListCoParentInvites(ctx context.Context, in *ListCoParentInvitesRequest, opts ...grpc.CallOption) (*ListCoParentInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoParentInvitesResponse)
+	err := c.cc.Invoke(ctx, ChildService_ListCoParentInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *childServiceClient) AcceptCoParentInvite(ctx context.Context, in *AcceptCoParentInviteRequest, opts ...grpc.CallOption) (*AcceptCoParentInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCoParentInviteResponse)
+	err := c.cc.Invoke(ctx, ChildService_AcceptCoParentInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *childServiceClient) DeclineCoParentInvite(ctx context.Context, in *DeclineCoParentInviteRequest, opts ...grpc.CallOption) (*DeclineCoParentInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineCoParentInviteResponse)
+	err := c.cc.Invoke(ctx, ChildService_DeclineCoParentInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChildServiceServer is the server API for ChildService service.
 // All implementations should embed UnimplementedChildServiceServer
 // for forward compatibility.
@@ -91,6 +151,11 @@ type ChildServiceServer interface {
 	ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error)
 	UpdateChild(context.Context, *UpdateChildRequest) (*UpdateChildResponse, error)
 	DeleteChild(context.Context, *DeleteChildRequest) (*DeleteChildResponse, error)
+	AddCoParent(context.Context, *AddCoParentRequest) (*AddCoParentResponse, error)
+	RemoveCoParent(context.Context, *RemoveCoParentRequest) (*RemoveCoParentResponse, error)
+	ListCoParentInvites(context.Context, *ListCoParentInvitesRequest) (*ListCoParentInvitesResponse, error)
+	AcceptCoParentInvite(context.Context, *AcceptCoParentInviteRequest) (*AcceptCoParentInviteResponse, error)
+	DeclineCoParentInvite(context.Context, *DeclineCoParentInviteRequest) (*DeclineCoParentInviteResponse, error)
 }
 
 // UnimplementedChildServiceServer should be embedded to have
@@ -112,6 +177,21 @@ func (UnimplementedChildServiceServer) UpdateChild(context.Context, *UpdateChild
 func (UnimplementedChildServiceServer) DeleteChild(context.Context, *DeleteChildRequest) (*DeleteChildResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteChild not implemented")
 }
+func (UnimplementedChildServiceServer) AddCoParent(context.Context, *AddCoParentRequest) (*AddCoParentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCoParent not implemented")
+}
+func (UnimplementedChildServiceServer) RemoveCoParent(context.Context, *RemoveCoParentRequest) (*RemoveCoParentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCoParent not implemented")
+}
+func (UnimplementedChildServiceServer) ListCoParentInvites(context.Context, *ListCoParentInvitesRequest) (*ListCoParentInvitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoParentInvites not implemented")
+}
+func (UnimplementedChildServiceServer) AcceptCoParentInvite(context.Context, *AcceptCoParentInviteRequest) (*AcceptCoParentInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptCoParentInvite not implemented")
+}
+func (UnimplementedChildServiceServer) DeclineCoParentInvite(context.Context, *DeclineCoParentInviteRequest) (*DeclineCoParentInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineCoParentInvite not implemented")
+}
 func (UnimplementedChildServiceServer) testEmbeddedByValue() {}
 
 // UnsafeChildServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +284,96 @@ func _ChildService_DeleteChild_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChildService_AddCoParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCoParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChildServiceServer).AddCoParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChildService_AddCoParent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChildServiceServer).AddCoParent(ctx, req.(*AddCoParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChildService_RemoveCoParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCoParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChildServiceServer).RemoveCoParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChildService_RemoveCoParent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChildServiceServer).RemoveCoParent(ctx, req.(*RemoveCoParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChildService_ListCoParentInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoParentInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChildServiceServer).ListCoParentInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChildService_ListCoParentInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChildServiceServer).ListCoParentInvites(ctx, req.(*ListCoParentInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChildService_AcceptCoParentInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCoParentInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChildServiceServer).AcceptCoParentInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChildService_AcceptCoParentInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChildServiceServer).AcceptCoParentInvite(ctx, req.(*AcceptCoParentInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChildService_DeclineCoParentInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCoParentInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChildServiceServer).DeclineCoParentInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChildService_DeclineCoParentInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChildServiceServer).DeclineCoParentInvite(ctx, req.(*DeclineCoParentInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChildService_ServiceDesc is the grpc.ServiceDesc for ChildService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +397,26 @@ var ChildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChild",
 			Handler:    _ChildService_DeleteChild_Handler,
 		},
+		{
+			MethodName: "AddCoParent",
+			Handler:    _ChildService_AddCoParent_Handler,
+		},
+		{
+			MethodName: "RemoveCoParent",
+			Handler:    _ChildService_RemoveCoParent_Handler,
+		},
+		{
+			MethodName: "ListCoParentInvites",
+			Handler:    _ChildService_ListCoParentInvites_Handler,
+		},
+		{
+			MethodName: "AcceptCoParentInvite",
+			Handler:    _ChildService_AcceptCoParentInvite_Handler,
+		},
+		{
+			MethodName: "DeclineCoParentInvite",
+			Handler:    _ChildService_DeclineCoParentInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tribbae/v1/child.proto",
@@ -0,0 +1,1175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/exchange.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Participant d'un échange de cadeaux. À la création, user_id ou email
+// désigne la personne ; key l'identifie ensuite dans l'échange.
+type ExchangeParticipant struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email            string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"` // organisateur seulement
+	HasWishlist      bool                   `protobuf:"varint,5,opt,name=has_wishlist,json=hasWishlist,proto3" json:"has_wishlist,omitempty"`
+	Me               bool                   `protobuf:"varint,6,opt,name=me,proto3" json:"me,omitempty"`                                                      // l'utilisateur connecté
+	WishlistFolderId string                 `protobuf:"bytes,7,opt,name=wishlist_folder_id,json=wishlistFolderId,proto3" json:"wishlist_folder_id,omitempty"` // le sien seulement
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExchangeParticipant) Reset() {
+	*x = ExchangeParticipant{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeParticipant) ProtoMessage() {}
+
+func (x *ExchangeParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeParticipant.ProtoReflect.Descriptor instead.
+func (*ExchangeParticipant) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExchangeParticipant) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExchangeParticipant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExchangeParticipant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExchangeParticipant) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ExchangeParticipant) GetHasWishlist() bool {
+	if x != nil {
+		return x.HasWishlist
+	}
+	return false
+}
+
+func (x *ExchangeParticipant) GetMe() bool {
+	if x != nil {
+		return x.Me
+	}
+	return false
+}
+
+func (x *ExchangeParticipant) GetWishlistFolderId() string {
+	if x != nil {
+		return x.WishlistFolderId
+	}
+	return ""
+}
+
+// Deux participants qui ne se tirent pas l'un l'autre. À la création, ils
+// sont désignés par user_id ou email ; en réponse, par leur clé.
+type ExchangeExclusion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	A             string                 `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B             string                 `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeExclusion) Reset() {
+	*x = ExchangeExclusion{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeExclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeExclusion) ProtoMessage() {}
+
+func (x *ExchangeExclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeExclusion.ProtoReflect.Descriptor instead.
+func (*ExchangeExclusion) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExchangeExclusion) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *ExchangeExclusion) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+// Échange de cadeaux (« Secret Santa »). Les tirages n'y figurent jamais :
+// chacun ne découvre le sien que par GetMyExchangeAssignment.
+type GiftExchange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrganizerId   string                 `protobuf:"bytes,3,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	IsOrganizer   bool                   `protobuf:"varint,4,opt,name=is_organizer,json=isOrganizer,proto3" json:"is_organizer,omitempty"`
+	Participants  []*ExchangeParticipant `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	Exclusions    []*ExchangeExclusion   `protobuf:"bytes,6,rep,name=exclusions,proto3" json:"exclusions,omitempty"` // organisateur seulement
+	Drawn         bool                   `protobuf:"varint,7,opt,name=drawn,proto3" json:"drawn,omitempty"`
+	DrawnAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=drawn_at,json=drawnAt,proto3" json:"drawn_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftExchange) Reset() {
+	*x = GiftExchange{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftExchange) ProtoMessage() {}
+
+func (x *GiftExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftExchange.ProtoReflect.Descriptor instead.
+func (*GiftExchange) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GiftExchange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GiftExchange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GiftExchange) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *GiftExchange) GetIsOrganizer() bool {
+	if x != nil {
+		return x.IsOrganizer
+	}
+	return false
+}
+
+func (x *GiftExchange) GetParticipants() []*ExchangeParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GiftExchange) GetExclusions() []*ExchangeExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *GiftExchange) GetDrawn() bool {
+	if x != nil {
+		return x.Drawn
+	}
+	return false
+}
+
+func (x *GiftExchange) GetDrawnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return nil
+}
+
+func (x *GiftExchange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Un lien de la liste de souhaits du destinataire
+type ExchangeWish struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeWish) Reset() {
+	*x = ExchangeWish{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeWish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeWish) ProtoMessage() {}
+
+func (x *ExchangeWish) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeWish.ProtoReflect.Descriptor instead.
+func (*ExchangeWish) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExchangeWish) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ExchangeWish) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExchangeWish) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExchangeWish) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ExchangeWish) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type CreateGiftExchangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Participants  []*ExchangeParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"` // au moins 3
+	Exclusions    []*ExchangeExclusion   `protobuf:"bytes,3,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGiftExchangeRequest) Reset() {
+	*x = CreateGiftExchangeRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGiftExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGiftExchangeRequest) ProtoMessage() {}
+
+func (x *CreateGiftExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGiftExchangeRequest.ProtoReflect.Descriptor instead.
+func (*CreateGiftExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateGiftExchangeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGiftExchangeRequest) GetParticipants() []*ExchangeParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *CreateGiftExchangeRequest) GetExclusions() []*ExchangeExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+type CreateGiftExchangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      *GiftExchange          `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGiftExchangeResponse) Reset() {
+	*x = CreateGiftExchangeResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGiftExchangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGiftExchangeResponse) ProtoMessage() {}
+
+func (x *CreateGiftExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGiftExchangeResponse.ProtoReflect.Descriptor instead.
+func (*CreateGiftExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateGiftExchangeResponse) GetExchange() *GiftExchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+type GetGiftExchangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeId    string                 `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftExchangeRequest) Reset() {
+	*x = GetGiftExchangeRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftExchangeRequest) ProtoMessage() {}
+
+func (x *GetGiftExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftExchangeRequest.ProtoReflect.Descriptor instead.
+func (*GetGiftExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetGiftExchangeRequest) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+type GetGiftExchangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      *GiftExchange          `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftExchangeResponse) Reset() {
+	*x = GetGiftExchangeResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftExchangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftExchangeResponse) ProtoMessage() {}
+
+func (x *GetGiftExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftExchangeResponse.ProtoReflect.Descriptor instead.
+func (*GetGiftExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGiftExchangeResponse) GetExchange() *GiftExchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+type ListGiftExchangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftExchangesRequest) Reset() {
+	*x = ListGiftExchangesRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftExchangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftExchangesRequest) ProtoMessage() {}
+
+func (x *ListGiftExchangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftExchangesRequest.ProtoReflect.Descriptor instead.
+func (*ListGiftExchangesRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{8}
+}
+
+type ListGiftExchangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchanges     []*GiftExchange        `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftExchangesResponse) Reset() {
+	*x = ListGiftExchangesResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftExchangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftExchangesResponse) ProtoMessage() {}
+
+func (x *ListGiftExchangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftExchangesResponse.ProtoReflect.Descriptor instead.
+func (*ListGiftExchangesResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListGiftExchangesResponse) GetExchanges() []*GiftExchange {
+	if x != nil {
+		return x.Exchanges
+	}
+	return nil
+}
+
+type DeleteGiftExchangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeId    string                 `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGiftExchangeRequest) Reset() {
+	*x = DeleteGiftExchangeRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGiftExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGiftExchangeRequest) ProtoMessage() {}
+
+func (x *DeleteGiftExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGiftExchangeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGiftExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteGiftExchangeRequest) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+type DeleteGiftExchangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGiftExchangeResponse) Reset() {
+	*x = DeleteGiftExchangeResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGiftExchangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGiftExchangeResponse) ProtoMessage() {}
+
+func (x *DeleteGiftExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGiftExchangeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGiftExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{11}
+}
+
+type DrawGiftExchangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeId    string                 `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawGiftExchangeRequest) Reset() {
+	*x = DrawGiftExchangeRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawGiftExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGiftExchangeRequest) ProtoMessage() {}
+
+func (x *DrawGiftExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGiftExchangeRequest.ProtoReflect.Descriptor instead.
+func (*DrawGiftExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DrawGiftExchangeRequest) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+type DrawGiftExchangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      *GiftExchange          `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawGiftExchangeResponse) Reset() {
+	*x = DrawGiftExchangeResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawGiftExchangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGiftExchangeResponse) ProtoMessage() {}
+
+func (x *DrawGiftExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGiftExchangeResponse.ProtoReflect.Descriptor instead.
+func (*DrawGiftExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DrawGiftExchangeResponse) GetExchange() *GiftExchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+type SetExchangeWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeId    string                 `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // un dossier à soi ; vide pour retirer la liste
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeWishlistRequest) Reset() {
+	*x = SetExchangeWishlistRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeWishlistRequest) ProtoMessage() {}
+
+func (x *SetExchangeWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeWishlistRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetExchangeWishlistRequest) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+func (x *SetExchangeWishlistRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type SetExchangeWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *ExchangeParticipant   `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeWishlistResponse) Reset() {
+	*x = SetExchangeWishlistResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeWishlistResponse) ProtoMessage() {}
+
+func (x *SetExchangeWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeWishlistResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetExchangeWishlistResponse) GetParticipant() *ExchangeParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type GetMyExchangeAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeId    string                 `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyExchangeAssignmentRequest) Reset() {
+	*x = GetMyExchangeAssignmentRequest{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyExchangeAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyExchangeAssignmentRequest) ProtoMessage() {}
+
+func (x *GetMyExchangeAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyExchangeAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetMyExchangeAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMyExchangeAssignmentRequest) GetExchangeId() string {
+	if x != nil {
+		return x.ExchangeId
+	}
+	return ""
+}
+
+type GetMyExchangeAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receiver      *ExchangeParticipant   `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`                             // à qui offrir
+	WishlistName  string                 `protobuf:"bytes,2,opt,name=wishlist_name,json=wishlistName,proto3" json:"wishlist_name,omitempty"` // vide sans liste de souhaits
+	Wishes        []*ExchangeWish        `protobuf:"bytes,3,rep,name=wishes,proto3" json:"wishes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyExchangeAssignmentResponse) Reset() {
+	*x = GetMyExchangeAssignmentResponse{}
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyExchangeAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyExchangeAssignmentResponse) ProtoMessage() {}
+
+func (x *GetMyExchangeAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_exchange_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyExchangeAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*GetMyExchangeAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_exchange_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMyExchangeAssignmentResponse) GetReceiver() *ExchangeParticipant {
+	if x != nil {
+		return x.Receiver
+	}
+	return nil
+}
+
+func (x *GetMyExchangeAssignmentResponse) GetWishlistName() string {
+	if x != nil {
+		return x.WishlistName
+	}
+	return ""
+}
+
+func (x *GetMyExchangeAssignmentResponse) GetWishes() []*ExchangeWish {
+	if x != nil {
+		return x.Wishes
+	}
+	return nil
+}
+
+var File_tribbae_v1_exchange_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_exchange_proto_rawDesc = "" +
+	"\n" +
+	"\x19tribbae/v1/exchange.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x01\n" +
+	"\x13ExchangeParticipant\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12!\n" +
+	"\fhas_wishlist\x18\x05 \x01(\bR\vhasWishlist\x12\x0e\n" +
+	"\x02me\x18\x06 \x01(\bR\x02me\x12,\n" +
+	"\x12wishlist_folder_id\x18\a \x01(\tR\x10wishlistFolderId\"/\n" +
+	"\x11ExchangeExclusion\x12\f\n" +
+	"\x01a\x18\x01 \x01(\tR\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\tR\x01b\"\x84\x03\n" +
+	"\fGiftExchange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\forganizer_id\x18\x03 \x01(\tR\vorganizerId\x12!\n" +
+	"\fis_organizer\x18\x04 \x01(\bR\visOrganizer\x12C\n" +
+	"\fparticipants\x18\x05 \x03(\v2\x1f.tribbae.v1.ExchangeParticipantR\fparticipants\x12=\n" +
+	"\n" +
+	"exclusions\x18\x06 \x03(\v2\x1d.tribbae.v1.ExchangeExclusionR\n" +
+	"exclusions\x12\x14\n" +
+	"\x05drawn\x18\a \x01(\bR\x05drawn\x125\n" +
+	"\bdrawn_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adrawnAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\fExchangeWish\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\"\xb3\x01\n" +
+	"\x19CreateGiftExchangeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1f.tribbae.v1.ExchangeParticipantR\fparticipants\x12=\n" +
+	"\n" +
+	"exclusions\x18\x03 \x03(\v2\x1d.tribbae.v1.ExchangeExclusionR\n" +
+	"exclusions\"R\n" +
+	"\x1aCreateGiftExchangeResponse\x124\n" +
+	"\bexchange\x18\x01 \x01(\v2\x18.tribbae.v1.GiftExchangeR\bexchange\"9\n" +
+	"\x16GetGiftExchangeRequest\x12\x1f\n" +
+	"\vexchange_id\x18\x01 \x01(\tR\n" +
+	"exchangeId\"O\n" +
+	"\x17GetGiftExchangeResponse\x124\n" +
+	"\bexchange\x18\x01 \x01(\v2\x18.tribbae.v1.GiftExchangeR\bexchange\"\x1a\n" +
+	"\x18ListGiftExchangesRequest\"S\n" +
+	"\x19ListGiftExchangesResponse\x126\n" +
+	"\texchanges\x18\x01 \x03(\v2\x18.tribbae.v1.GiftExchangeR\texchanges\"<\n" +
+	"\x19DeleteGiftExchangeRequest\x12\x1f\n" +
+	"\vexchange_id\x18\x01 \x01(\tR\n" +
+	"exchangeId\"\x1c\n" +
+	"\x1aDeleteGiftExchangeResponse\":\n" +
+	"\x17DrawGiftExchangeRequest\x12\x1f\n" +
+	"\vexchange_id\x18\x01 \x01(\tR\n" +
+	"exchangeId\"P\n" +
+	"\x18DrawGiftExchangeResponse\x124\n" +
+	"\bexchange\x18\x01 \x01(\v2\x18.tribbae.v1.GiftExchangeR\bexchange\"Z\n" +
+	"\x1aSetExchangeWishlistRequest\x12\x1f\n" +
+	"\vexchange_id\x18\x01 \x01(\tR\n" +
+	"exchangeId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\"`\n" +
+	"\x1bSetExchangeWishlistResponse\x12A\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1f.tribbae.v1.ExchangeParticipantR\vparticipant\"A\n" +
+	"\x1eGetMyExchangeAssignmentRequest\x12\x1f\n" +
+	"\vexchange_id\x18\x01 \x01(\tR\n" +
+	"exchangeId\"\xb5\x01\n" +
+	"\x1fGetMyExchangeAssignmentResponse\x12;\n" +
+	"\breceiver\x18\x01 \x01(\v2\x1f.tribbae.v1.ExchangeParticipantR\breceiver\x12#\n" +
+	"\rwishlist_name\x18\x02 \x01(\tR\fwishlistName\x120\n" +
+	"\x06wishes\x18\x03 \x03(\v2\x18.tribbae.v1.ExchangeWishR\x06wishes2\x8a\b\n" +
+	"\x13GiftExchangeService\x12\x82\x01\n" +
+	"\x12CreateGiftExchange\x12%.tribbae.v1.CreateGiftExchangeRequest\x1a&.tribbae.v1.CreateGiftExchangeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/gift-exchanges\x12\x84\x01\n" +
+	"\x0fGetGiftExchange\x12\".tribbae.v1.GetGiftExchangeRequest\x1a#.tribbae.v1.GetGiftExchangeResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/gift-exchanges/{exchange_id}\x12|\n" +
+	"\x11ListGiftExchanges\x12$.tribbae.v1.ListGiftExchangesRequest\x1a%.tribbae.v1.ListGiftExchangesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/gift-exchanges\x12\x8d\x01\n" +
+	"\x12DeleteGiftExchange\x12%.tribbae.v1.DeleteGiftExchangeRequest\x1a&.tribbae.v1.DeleteGiftExchangeResponse\"(\x82\xd3\xe4\x93\x02\"* /v1/gift-exchanges/{exchange_id}\x12\x8f\x01\n" +
+	"\x10DrawGiftExchange\x12#.tribbae.v1.DrawGiftExchangeRequest\x1a$.tribbae.v1.DrawGiftExchangeResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/gift-exchanges/{exchange_id}/draw\x12\x9c\x01\n" +
+	"\x13SetExchangeWishlist\x12&.tribbae.v1.SetExchangeWishlistRequest\x1a'.tribbae.v1.SetExchangeWishlistResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/gift-exchanges/{exchange_id}/wishlist\x12\xa7\x01\n" +
+	"\x17GetMyExchangeAssignment\x12*.tribbae.v1.GetMyExchangeAssignmentRequest\x1a+.tribbae.v1.GetMyExchangeAssignmentResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/gift-exchanges/{exchange_id}/assignmentB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_exchange_proto_rawDescOnce sync.Once
+	file_tribbae_v1_exchange_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_exchange_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_exchange_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_exchange_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_exchange_proto_rawDesc), len(file_tribbae_v1_exchange_proto_rawDesc)))
+	})
+	return file_tribbae_v1_exchange_proto_rawDescData
+}
+
+var file_tribbae_v1_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_tribbae_v1_exchange_proto_goTypes = []any{
+	(*ExchangeParticipant)(nil),             // 0: tribbae.v1.ExchangeParticipant
+	(*ExchangeExclusion)(nil),               // 1: tribbae.v1.ExchangeExclusion
+	(*GiftExchange)(nil),                    // 2: tribbae.v1.GiftExchange
+	(*ExchangeWish)(nil),                    // 3: tribbae.v1.ExchangeWish
+	(*CreateGiftExchangeRequest)(nil),       // 4: tribbae.v1.CreateGiftExchangeRequest
+	(*CreateGiftExchangeResponse)(nil),      // 5: tribbae.v1.CreateGiftExchangeResponse
+	(*GetGiftExchangeRequest)(nil),          // 6: tribbae.v1.GetGiftExchangeRequest
+	(*GetGiftExchangeResponse)(nil),         // 7: tribbae.v1.GetGiftExchangeResponse
+	(*ListGiftExchangesRequest)(nil),        // 8: tribbae.v1.ListGiftExchangesRequest
+	(*ListGiftExchangesResponse)(nil),       // 9: tribbae.v1.ListGiftExchangesResponse
+	(*DeleteGiftExchangeRequest)(nil),       // 10: tribbae.v1.DeleteGiftExchangeRequest
+	(*DeleteGiftExchangeResponse)(nil),      // 11: tribbae.v1.DeleteGiftExchangeResponse
+	(*DrawGiftExchangeRequest)(nil),         // 12: tribbae.v1.DrawGiftExchangeRequest
+	(*DrawGiftExchangeResponse)(nil),        // 13: tribbae.v1.DrawGiftExchangeResponse
+	(*SetExchangeWishlistRequest)(nil),      // 14: tribbae.v1.SetExchangeWishlistRequest
+	(*SetExchangeWishlistResponse)(nil),     // 15: tribbae.v1.SetExchangeWishlistResponse
+	(*GetMyExchangeAssignmentRequest)(nil),  // 16: tribbae.v1.GetMyExchangeAssignmentRequest
+	(*GetMyExchangeAssignmentResponse)(nil), // 17: tribbae.v1.GetMyExchangeAssignmentResponse
+	(*timestamppb.Timestamp)(nil),           // 18: google.protobuf.Timestamp
+}
+var file_tribbae_v1_exchange_proto_depIdxs = []int32{
+	0,  // 0: tribbae.v1.GiftExchange.participants:type_name -> tribbae.v1.ExchangeParticipant
+	1,  // 1: tribbae.v1.GiftExchange.exclusions:type_name -> tribbae.v1.ExchangeExclusion
+	18, // 2: tribbae.v1.GiftExchange.drawn_at:type_name -> google.protobuf.Timestamp
+	18, // 3: tribbae.v1.GiftExchange.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: tribbae.v1.CreateGiftExchangeRequest.participants:type_name -> tribbae.v1.ExchangeParticipant
+	1,  // 5: tribbae.v1.CreateGiftExchangeRequest.exclusions:type_name -> tribbae.v1.ExchangeExclusion
+	2,  // 6: tribbae.v1.CreateGiftExchangeResponse.exchange:type_name -> tribbae.v1.GiftExchange
+	2,  // 7: tribbae.v1.GetGiftExchangeResponse.exchange:type_name -> tribbae.v1.GiftExchange
+	2,  // 8: tribbae.v1.ListGiftExchangesResponse.exchanges:type_name -> tribbae.v1.GiftExchange
+	2,  // 9: tribbae.v1.DrawGiftExchangeResponse.exchange:type_name -> tribbae.v1.GiftExchange
+	0,  // 10: tribbae.v1.SetExchangeWishlistResponse.participant:type_name -> tribbae.v1.ExchangeParticipant
+	0,  // 11: tribbae.v1.GetMyExchangeAssignmentResponse.receiver:type_name -> tribbae.v1.ExchangeParticipant
+	3,  // 12: tribbae.v1.GetMyExchangeAssignmentResponse.wishes:type_name -> tribbae.v1.ExchangeWish
+	4,  // 13: tribbae.v1.GiftExchangeService.CreateGiftExchange:input_type -> tribbae.v1.CreateGiftExchangeRequest
+	6,  // 14: tribbae.v1.GiftExchangeService.GetGiftExchange:input_type -> tribbae.v1.GetGiftExchangeRequest
+	8,  // 15: tribbae.v1.GiftExchangeService.ListGiftExchanges:input_type -> tribbae.v1.ListGiftExchangesRequest
+	10, // 16: tribbae.v1.GiftExchangeService.DeleteGiftExchange:input_type -> tribbae.v1.DeleteGiftExchangeRequest
+	12, // 17: tribbae.v1.GiftExchangeService.DrawGiftExchange:input_type -> tribbae.v1.DrawGiftExchangeRequest
+	14, // 18: tribbae.v1.GiftExchangeService.SetExchangeWishlist:input_type -> tribbae.v1.SetExchangeWishlistRequest
+	16, // 19: tribbae.v1.GiftExchangeService.GetMyExchangeAssignment:input_type -> tribbae.v1.GetMyExchangeAssignmentRequest
+	5,  // 20: tribbae.v1.GiftExchangeService.CreateGiftExchange:output_type -> tribbae.v1.CreateGiftExchangeResponse
+	7,  // 21: tribbae.v1.GiftExchangeService.GetGiftExchange:output_type -> tribbae.v1.GetGiftExchangeResponse
+	9,  // 22: tribbae.v1.GiftExchangeService.ListGiftExchanges:output_type -> tribbae.v1.ListGiftExchangesResponse
+	11, // 23: tribbae.v1.GiftExchangeService.DeleteGiftExchange:output_type -> tribbae.v1.DeleteGiftExchangeResponse
+	13, // 24: tribbae.v1.GiftExchangeService.DrawGiftExchange:output_type -> tribbae.v1.DrawGiftExchangeResponse
+	15, // 25: tribbae.v1.GiftExchangeService.SetExchangeWishlist:output_type -> tribbae.v1.SetExchangeWishlistResponse
+	17, // 26: tribbae.v1.GiftExchangeService.GetMyExchangeAssignment:output_type -> tribbae.v1.GetMyExchangeAssignmentResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_exchange_proto_init() }
+func file_tribbae_v1_exchange_proto_init() {
+	if File_tribbae_v1_exchange_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_exchange_proto_rawDesc), len(file_tribbae_v1_exchange_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_exchange_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_exchange_proto_depIdxs,
+		MessageInfos:      file_tribbae_v1_exchange_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_exchange_proto = out.File
+	file_tribbae_v1_exchange_proto_goTypes = nil
+	file_tribbae_v1_exchange_proto_depIdxs = nil
+}
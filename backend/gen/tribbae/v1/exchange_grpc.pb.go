@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: tribbae/v1/exchange.proto
+
+package tribbaev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GiftExchangeService_CreateGiftExchange_FullMethodName      = "/tribbae.v1.GiftExchangeService/CreateGiftExchange"
+	GiftExchangeService_GetGiftExchange_FullMethodName         = "/tribbae.v1.GiftExchangeService/GetGiftExchange"
+	GiftExchangeService_ListGiftExchanges_FullMethodName       = "/tribbae.v1.GiftExchangeService/ListGiftExchanges"
+	GiftExchangeService_DeleteGiftExchange_FullMethodName      = "/tribbae.v1.GiftExchangeService/DeleteGiftExchange"
+	GiftExchangeService_DrawGiftExchange_FullMethodName        = "/tribbae.v1.GiftExchangeService/DrawGiftExchange"
+	GiftExchangeService_SetExchangeWishlist_FullMethodName     = "/tribbae.v1.GiftExchangeService/SetExchangeWishlist"
+	GiftExchangeService_GetMyExchangeAssignment_FullMethodName = "/tribbae.v1.GiftExchangeService/GetMyExchangeAssignment"
+)
+
+// GiftExchangeServiceClient is the client API for GiftExchangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GiftExchangeServiceClient interface {
+	CreateGiftExchange(ctx context.Context, in *CreateGiftExchangeRequest, opts ...grpc.CallOption) (*CreateGiftExchangeResponse, error)
+	GetGiftExchange(ctx context.Context, in *GetGiftExchangeRequest, opts ...grpc.CallOption) (*GetGiftExchangeResponse, error)
+	ListGiftExchanges(ctx context.Context, in *ListGiftExchangesRequest, opts ...grpc.CallOption) (*ListGiftExchangesResponse, error)
+	DeleteGiftExchange(ctx context.Context, in *DeleteGiftExchangeRequest, opts ...grpc.CallOption) (*DeleteGiftExchangeResponse, error)
+	DrawGiftExchange(ctx context.Context, in *DrawGiftExchangeRequest, opts ...grpc.CallOption) (*DrawGiftExchangeResponse, error)
+	SetExchangeWishlist(ctx context.Context, in *SetExchangeWishlistRequest, opts ...grpc.CallOption) (*SetExchangeWishlistResponse, error)
+	GetMyExchangeAssignment(ctx context.Context, in *GetMyExchangeAssignmentRequest, opts ...grpc.CallOption) (*GetMyExchangeAssignmentResponse, error)
+}
+
+type giftExchangeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGiftExchangeServiceClient(cc grpc.ClientConnInterface) GiftExchangeServiceClient {
+	return &giftExchangeServiceClient{cc}
+}
+
+func (c *giftExchangeServiceClient) CreateGiftExchange(ctx context.Context, in *CreateGiftExchangeRequest, opts ...grpc.CallOption) (*CreateGiftExchangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGiftExchangeResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_CreateGiftExchange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) GetGiftExchange(ctx context.Context, in *GetGiftExchangeRequest, opts ...grpc.CallOption) (*GetGiftExchangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGiftExchangeResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_GetGiftExchange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) ListGiftExchanges(ctx context.Context, in *ListGiftExchangesRequest, opts ...grpc.CallOption) (*ListGiftExchangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiftExchangesResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_ListGiftExchanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) DeleteGiftExchange(ctx context.Context, in *DeleteGiftExchangeRequest, opts ...grpc.CallOption) (*DeleteGiftExchangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGiftExchangeResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_DeleteGiftExchange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) DrawGiftExchange(ctx context.Context, in *DrawGiftExchangeRequest, opts ...grpc.CallOption) (*DrawGiftExchangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawGiftExchangeResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_DrawGiftExchange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) SetExchangeWishlist(ctx context.Context, in *SetExchangeWishlistRequest, opts ...grpc.CallOption) (*SetExchangeWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeWishlistResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_SetExchangeWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftExchangeServiceClient) GetMyExchangeAssignment(ctx context.Context, in *GetMyExchangeAssignmentRequest, opts ...grpc.CallOption) (*GetMyExchangeAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyExchangeAssignmentResponse)
+	err := c.cc.Invoke(ctx, GiftExchangeService_GetMyExchangeAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GiftExchangeServiceServer is the server API for GiftExchangeService service.
+// All implementations should embed UnimplementedGiftExchangeServiceServer
+// for forward compatibility.
+type GiftExchangeServiceServer interface {
+	CreateGiftExchange(context.Context, *CreateGiftExchangeRequest) (*CreateGiftExchangeResponse, error)
+	GetGiftExchange(context.Context, *GetGiftExchangeRequest) (*GetGiftExchangeResponse, error)
+	ListGiftExchanges(context.Context, *ListGiftExchangesRequest) (*ListGiftExchangesResponse, error)
+	DeleteGiftExchange(context.Context, *DeleteGiftExchangeRequest) (*DeleteGiftExchangeResponse, error)
+	DrawGiftExchange(context.Context, *DrawGiftExchangeRequest) (*DrawGiftExchangeResponse, error)
+	SetExchangeWishlist(context.Context, *SetExchangeWishlistRequest) (*SetExchangeWishlistResponse, error)
+	GetMyExchangeAssignment(context.Context, *GetMyExchangeAssignmentRequest) (*GetMyExchangeAssignmentResponse, error)
+}
+
+// UnimplementedGiftExchangeServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGiftExchangeServiceServer struct{}
+
+func (UnimplementedGiftExchangeServiceServer) CreateGiftExchange(context.Context, *CreateGiftExchangeRequest) (*CreateGiftExchangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGiftExchange not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) GetGiftExchange(context.Context, *GetGiftExchangeRequest) (*GetGiftExchangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGiftExchange not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) ListGiftExchanges(context.Context, *ListGiftExchangesRequest) (*ListGiftExchangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGiftExchanges not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) DeleteGiftExchange(context.Context, *DeleteGiftExchangeRequest) (*DeleteGiftExchangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGiftExchange not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) DrawGiftExchange(context.Context, *DrawGiftExchangeRequest) (*DrawGiftExchangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DrawGiftExchange not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) SetExchangeWishlist(context.Context, *SetExchangeWishlistRequest) (*SetExchangeWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeWishlist not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) GetMyExchangeAssignment(context.Context, *GetMyExchangeAssignmentRequest) (*GetMyExchangeAssignmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyExchangeAssignment not implemented")
+}
+func (UnimplementedGiftExchangeServiceServer) testEmbeddedByValue() {}
+
+// UnsafeGiftExchangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GiftExchangeServiceServer will
+// result in compilation errors.
+type UnsafeGiftExchangeServiceServer interface {
+	mustEmbedUnimplementedGiftExchangeServiceServer()
+}
+
+func RegisterGiftExchangeServiceServer(s grpc.ServiceRegistrar, srv GiftExchangeServiceServer) {
+	// If the following call panics, it indicates UnimplementedGiftExchangeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GiftExchangeService_ServiceDesc, srv)
+}
+
+func _GiftExchangeService_CreateGiftExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGiftExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).CreateGiftExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_CreateGiftExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).CreateGiftExchange(ctx, req.(*CreateGiftExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_GetGiftExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiftExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).GetGiftExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_GetGiftExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).GetGiftExchange(ctx, req.(*GetGiftExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_ListGiftExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).ListGiftExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_ListGiftExchanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).ListGiftExchanges(ctx, req.(*ListGiftExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_DeleteGiftExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGiftExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).DeleteGiftExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_DeleteGiftExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).DeleteGiftExchange(ctx, req.(*DeleteGiftExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_DrawGiftExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawGiftExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).DrawGiftExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_DrawGiftExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).DrawGiftExchange(ctx, req.(*DrawGiftExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_SetExchangeWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).SetExchangeWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_SetExchangeWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).SetExchangeWishlist(ctx, req.(*SetExchangeWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftExchangeService_GetMyExchangeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyExchangeAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftExchangeServiceServer).GetMyExchangeAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftExchangeService_GetMyExchangeAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftExchangeServiceServer).GetMyExchangeAssignment(ctx, req.(*GetMyExchangeAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GiftExchangeService_ServiceDesc is the grpc.ServiceDesc for GiftExchangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GiftExchangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tribbae.v1.GiftExchangeService",
+	HandlerType: (*GiftExchangeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGiftExchange",
+			Handler:    _GiftExchangeService_CreateGiftExchange_Handler,
+		},
+		{
+			MethodName: "GetGiftExchange",
+			Handler:    _GiftExchangeService_GetGiftExchange_Handler,
+		},
+		{
+			MethodName: "ListGiftExchanges",
+			Handler:    _GiftExchangeService_ListGiftExchanges_Handler,
+		},
+		{
+			MethodName: "DeleteGiftExchange",
+			Handler:    _GiftExchangeService_DeleteGiftExchange_Handler,
+		},
+		{
+			MethodName: "DrawGiftExchange",
+			Handler:    _GiftExchangeService_DrawGiftExchange_Handler,
+		},
+		{
+			MethodName: "SetExchangeWishlist",
+			Handler:    _GiftExchangeService_SetExchangeWishlist_Handler,
+		},
+		{
+			MethodName: "GetMyExchangeAssignment",
+			Handler:    _GiftExchangeService_GetMyExchangeAssignment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tribbae/v1/exchange.proto",
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -131,6 +132,7 @@ type Collaborator struct {
 	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	Role          CollaboratorRole       `protobuf:"varint,4,opt,name=role,proto3,enum=tribbae.v1.CollaboratorRole" json:"role,omitempty"`
 	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	HouseholdId   string                 `protobuf:"bytes,6,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // accès venu d'un foyer (voir HouseholdService)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -200,6 +202,13 @@ func (x *Collaborator) GetAddedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Collaborator) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
 type Folder struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -220,6 +229,11 @@ type Folder struct {
 	BannerUrl        string                 `protobuf:"bytes,16,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
 	Tags             []string               `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
 	OwnerIsAdmin     bool                   `protobuf:"varint,18,opt,name=owner_is_admin,json=ownerIsAdmin,proto3" json:"owner_is_admin,omitempty"`
+	Archived         bool                   `protobuf:"varint,19,opt,name=archived,proto3" json:"archived,omitempty"`                            // masqué de ListFolders par défaut
+	Frozen           bool                   `protobuf:"varint,20,opt,name=frozen,proto3" json:"frozen,omitempty"`                                // liens en lecture seule pour tous les collaborateurs
+	Etag             string                 `protobuf:"bytes,21,opt,name=etag,proto3" json:"etag,omitempty"`                                     // à renvoyer dans UpdateFolderRequest
+	ChildIds         []string               `protobuf:"bytes,22,rep,name=child_ids,json=childIds,proto3" json:"child_ids,omitempty"`             // enfants du propriétaire concernés (liste d'anniversaire…)
+	HouseholdIds     []string               `protobuf:"bytes,23,rep,name=household_ids,json=householdIds,proto3" json:"household_ids,omitempty"` // foyers avec lesquels le dossier est partagé
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -380,6 +394,41 @@ func (x *Folder) GetOwnerIsAdmin() bool {
 	return false
 }
 
+func (x *Folder) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Folder) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *Folder) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Folder) GetChildIds() []string {
+	if x != nil {
+		return x.ChildIds
+	}
+	return nil
+}
+
+func (x *Folder) GetHouseholdIds() []string {
+	if x != nil {
+		return x.HouseholdIds
+	}
+	return nil
+}
+
 type CreateFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -388,6 +437,7 @@ type CreateFolderRequest struct {
 	Visibility    Visibility             `protobuf:"varint,4,opt,name=visibility,proto3,enum=tribbae.v1.Visibility" json:"visibility,omitempty"`
 	BannerUrl     string                 `protobuf:"bytes,5,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
 	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ChildIds      []string               `protobuf:"bytes,7,rep,name=child_ids,json=childIds,proto3" json:"child_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -464,6 +514,13 @@ func (x *CreateFolderRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateFolderRequest) GetChildIds() []string {
+	if x != nil {
+		return x.ChildIds
+	}
+	return nil
+}
+
 type CreateFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
@@ -597,9 +654,10 @@ func (x *GetFolderResponse) GetFolder() *Folder {
 }
 
 type ListFoldersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListFoldersRequest) Reset() {
@@ -632,6 +690,13 @@ func (*ListFoldersRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *ListFoldersRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListFoldersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
@@ -677,14 +742,21 @@ func (x *ListFoldersResponse) GetFolders() []*Folder {
 }
 
 type UpdateFolderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Color         string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=tribbae.v1.Visibility" json:"visibility,omitempty"`
-	BannerUrl     string                 `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
-	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FolderId   string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon       string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color      string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Visibility Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=tribbae.v1.Visibility" json:"visibility,omitempty"`
+	BannerUrl  string                 `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	Tags       []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Champs à modifier (ex. "name", "tags") ; vide : tous
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// etag du dossier lu ; si renseigné, la modification échoue (ABORTED) quand
+	// quelqu'un d'autre a modifié le dossier entre-temps
+	Etag string `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Modifié seulement si "child_ids" figure dans update_mask
+	ChildIds      []string `protobuf:"bytes,10,rep,name=child_ids,json=childIds,proto3" json:"child_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -768,6 +840,27 @@ func (x *UpdateFolderRequest) GetTags() []string {
 	return nil
 }
 
+func (x *UpdateFolderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateFolderRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateFolderRequest) GetChildIds() []string {
+	if x != nil {
+		return x.ChildIds
+	}
+	return nil
+}
+
 type UpdateFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
@@ -892,27 +985,27 @@ func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{11}
 }
 
-type GenerateShareTokenRequest struct {
+type ArchiveFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateShareTokenRequest) Reset() {
-	*x = GenerateShareTokenRequest{}
+func (x *ArchiveFolderRequest) Reset() {
+	*x = ArchiveFolderRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateShareTokenRequest) String() string {
+func (x *ArchiveFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateShareTokenRequest) ProtoMessage() {}
+func (*ArchiveFolderRequest) ProtoMessage() {}
 
-func (x *GenerateShareTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *ArchiveFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,40 +1017,39 @@ func (x *GenerateShareTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateShareTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateShareTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveFolderRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveFolderRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GenerateShareTokenRequest) GetFolderId() string {
+func (x *ArchiveFolderRequest) GetFolderId() string {
 	if x != nil {
 		return x.FolderId
 	}
 	return ""
 }
 
-type GenerateShareTokenResponse struct {
+type ArchiveFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
-	ShareUrl      string                 `protobuf:"bytes,2,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateShareTokenResponse) Reset() {
-	*x = GenerateShareTokenResponse{}
+func (x *ArchiveFolderResponse) Reset() {
+	*x = ArchiveFolderResponse{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateShareTokenResponse) String() string {
+func (x *ArchiveFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateShareTokenResponse) ProtoMessage() {}
+func (*ArchiveFolderResponse) ProtoMessage() {}
 
-func (x *GenerateShareTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *ArchiveFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -969,46 +1061,39 @@ func (x *GenerateShareTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateShareTokenResponse.ProtoReflect.Descriptor instead.
-func (*GenerateShareTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveFolderResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveFolderResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GenerateShareTokenResponse) GetShareToken() string {
-	if x != nil {
-		return x.ShareToken
-	}
-	return ""
-}
-
-func (x *GenerateShareTokenResponse) GetShareUrl() string {
+func (x *ArchiveFolderResponse) GetFolder() *Folder {
 	if x != nil {
-		return x.ShareUrl
+		return x.Folder
 	}
-	return ""
+	return nil
 }
 
-type GetSharedFolderRequest struct {
+type UnarchiveFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharedFolderRequest) Reset() {
-	*x = GetSharedFolderRequest{}
+func (x *UnarchiveFolderRequest) Reset() {
+	*x = UnarchiveFolderRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharedFolderRequest) String() string {
+func (x *UnarchiveFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharedFolderRequest) ProtoMessage() {}
+func (*UnarchiveFolderRequest) ProtoMessage() {}
 
-func (x *GetSharedFolderRequest) ProtoReflect() protoreflect.Message {
+func (x *UnarchiveFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,40 +1105,39 @@ func (x *GetSharedFolderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharedFolderRequest.ProtoReflect.Descriptor instead.
-func (*GetSharedFolderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnarchiveFolderRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveFolderRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSharedFolderRequest) GetShareToken() string {
+func (x *UnarchiveFolderRequest) GetFolderId() string {
 	if x != nil {
-		return x.ShareToken
+		return x.FolderId
 	}
 	return ""
 }
 
-type GetSharedFolderResponse struct {
+type UnarchiveFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
-	Links         []*Link                `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharedFolderResponse) Reset() {
-	*x = GetSharedFolderResponse{}
+func (x *UnarchiveFolderResponse) Reset() {
+	*x = UnarchiveFolderResponse{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharedFolderResponse) String() string {
+func (x *UnarchiveFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharedFolderResponse) ProtoMessage() {}
+func (*UnarchiveFolderResponse) ProtoMessage() {}
 
-func (x *GetSharedFolderResponse) ProtoReflect() protoreflect.Message {
+func (x *UnarchiveFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,48 +1149,39 @@ func (x *GetSharedFolderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharedFolderResponse.ProtoReflect.Descriptor instead.
-func (*GetSharedFolderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnarchiveFolderResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveFolderResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetSharedFolderResponse) GetFolder() *Folder {
+func (x *UnarchiveFolderResponse) GetFolder() *Folder {
 	if x != nil {
 		return x.Folder
 	}
 	return nil
 }
 
-func (x *GetSharedFolderResponse) GetLinks() []*Link {
-	if x != nil {
-		return x.Links
-	}
-	return nil
-}
-
-type AddCollaboratorRequest struct {
+type FreezeFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          CollaboratorRole       `protobuf:"varint,3,opt,name=role,proto3,enum=tribbae.v1.CollaboratorRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCollaboratorRequest) Reset() {
-	*x = AddCollaboratorRequest{}
+func (x *FreezeFolderRequest) Reset() {
+	*x = FreezeFolderRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCollaboratorRequest) String() string {
+func (x *FreezeFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCollaboratorRequest) ProtoMessage() {}
+func (*FreezeFolderRequest) ProtoMessage() {}
 
-func (x *AddCollaboratorRequest) ProtoReflect() protoreflect.Message {
+func (x *FreezeFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,53 +1193,39 @@ func (x *AddCollaboratorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCollaboratorRequest.ProtoReflect.Descriptor instead.
-func (*AddCollaboratorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeFolderRequest.ProtoReflect.Descriptor instead.
+func (*FreezeFolderRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddCollaboratorRequest) GetFolderId() string {
+func (x *FreezeFolderRequest) GetFolderId() string {
 	if x != nil {
 		return x.FolderId
 	}
 	return ""
 }
 
-func (x *AddCollaboratorRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *AddCollaboratorRequest) GetRole() CollaboratorRole {
-	if x != nil {
-		return x.Role
-	}
-	return CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
-}
-
-type AddCollaboratorResponse struct {
+type FreezeFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCollaboratorResponse) Reset() {
-	*x = AddCollaboratorResponse{}
+func (x *FreezeFolderResponse) Reset() {
+	*x = FreezeFolderResponse{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCollaboratorResponse) String() string {
+func (x *FreezeFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCollaboratorResponse) ProtoMessage() {}
+func (*FreezeFolderResponse) ProtoMessage() {}
 
-func (x *AddCollaboratorResponse) ProtoReflect() protoreflect.Message {
+func (x *FreezeFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,40 +1237,39 @@ func (x *AddCollaboratorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCollaboratorResponse.ProtoReflect.Descriptor instead.
-func (*AddCollaboratorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeFolderResponse.ProtoReflect.Descriptor instead.
+func (*FreezeFolderResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AddCollaboratorResponse) GetFolder() *Folder {
+func (x *FreezeFolderResponse) GetFolder() *Folder {
 	if x != nil {
 		return x.Folder
 	}
 	return nil
 }
 
-type RemoveCollaboratorRequest struct {
+type UnfreezeFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCollaboratorRequest) Reset() {
-	*x = RemoveCollaboratorRequest{}
+func (x *UnfreezeFolderRequest) Reset() {
+	*x = UnfreezeFolderRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCollaboratorRequest) String() string {
+func (x *UnfreezeFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCollaboratorRequest) ProtoMessage() {}
+func (*UnfreezeFolderRequest) ProtoMessage() {}
 
-func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,46 +1281,39 @@ func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCollaboratorRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCollaboratorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeFolderRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeFolderRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RemoveCollaboratorRequest) GetFolderId() string {
+func (x *UnfreezeFolderRequest) GetFolderId() string {
 	if x != nil {
 		return x.FolderId
 	}
 	return ""
 }
 
-func (x *RemoveCollaboratorRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type RemoveCollaboratorResponse struct {
+type UnfreezeFolderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCollaboratorResponse) Reset() {
-	*x = RemoveCollaboratorResponse{}
+func (x *UnfreezeFolderResponse) Reset() {
+	*x = UnfreezeFolderResponse{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCollaboratorResponse) String() string {
+func (x *UnfreezeFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCollaboratorResponse) ProtoMessage() {}
+func (*UnfreezeFolderResponse) ProtoMessage() {}
 
-func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,41 +1325,39 @@ func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCollaboratorResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCollaboratorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeFolderResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeFolderResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RemoveCollaboratorResponse) GetFolder() *Folder {
+func (x *UnfreezeFolderResponse) GetFolder() *Folder {
 	if x != nil {
 		return x.Folder
 	}
 	return nil
 }
 
-type ListCommunityFoldersRequest struct {
+type GenerateShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunityFoldersRequest) Reset() {
-	*x = ListCommunityFoldersRequest{}
+func (x *GenerateShareTokenRequest) Reset() {
+	*x = GenerateShareTokenRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunityFoldersRequest) String() string {
+func (x *GenerateShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunityFoldersRequest) ProtoMessage() {}
+func (*GenerateShareTokenRequest) ProtoMessage() {}
 
-func (x *ListCommunityFoldersRequest) ProtoReflect() protoreflect.Message {
+func (x *GenerateShareTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,54 +1369,40 @@ func (x *ListCommunityFoldersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunityFoldersRequest.ProtoReflect.Descriptor instead.
-func (*ListCommunityFoldersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateShareTokenRequest) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListCommunityFoldersRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
-}
-
-func (x *ListCommunityFoldersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListCommunityFoldersRequest) GetPageToken() string {
+func (x *GenerateShareTokenRequest) GetFolderId() string {
 	if x != nil {
-		return x.PageToken
+		return x.FolderId
 	}
 	return ""
 }
 
-type ListCommunityFoldersResponse struct {
+type GenerateShareTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	ShareUrl      string                 `protobuf:"bytes,2,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunityFoldersResponse) Reset() {
-	*x = ListCommunityFoldersResponse{}
+func (x *GenerateShareTokenResponse) Reset() {
+	*x = GenerateShareTokenResponse{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunityFoldersResponse) String() string {
+func (x *GenerateShareTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunityFoldersResponse) ProtoMessage() {}
+func (*GenerateShareTokenResponse) ProtoMessage() {}
 
-func (x *ListCommunityFoldersResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateShareTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_folder_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1377,39 +1414,745 @@ func (x *ListCommunityFoldersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunityFoldersResponse.ProtoReflect.Descriptor instead.
-func (*ListCommunityFoldersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateShareTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateShareTokenResponse) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListCommunityFoldersResponse) GetFolders() []*Folder {
+func (x *GenerateShareTokenResponse) GetShareToken() string {
 	if x != nil {
-		return x.Folders
+		return x.ShareToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCommunityFoldersResponse) GetNextPageToken() string {
+func (x *GenerateShareTokenResponse) GetShareUrl() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.ShareUrl
 	}
 	return ""
 }
 
-type LikeFolderRequest struct {
+type GetSharedFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeFolderRequest) Reset() {
-	*x = LikeFolderRequest{}
+func (x *GetSharedFolderRequest) Reset() {
+	*x = GetSharedFolderRequest{}
 	mi := &file_tribbae_v1_folder_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *GetSharedFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedFolderRequest) ProtoMessage() {}
+
+func (x *GetSharedFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedFolderRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedFolderRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSharedFolderRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type GetSharedFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	Links         []*Link                `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Children      []*SharedChild         `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"` // enfants rattachés au dossier
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedFolderResponse) Reset() {
+	*x = GetSharedFolderResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedFolderResponse) ProtoMessage() {}
+
+func (x *GetSharedFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedFolderResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedFolderResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSharedFolderResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+func (x *GetSharedFolderResponse) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *GetSharedFolderResponse) GetChildren() []*SharedChild {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// Enfant tel que le voient les proches sur une page partagée : ni date de
+// naissance complète, ni autre information du profil
+type SharedChild struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AgeMonths         int32                  `protobuf:"varint,3,opt,name=age_months,json=ageMonths,proto3" json:"age_months,omitempty"`
+	NextBirthday      string                 `protobuf:"bytes,4,opt,name=next_birthday,json=nextBirthday,proto3" json:"next_birthday,omitempty"`                   // YYYY-MM-DD
+	Turning           int32                  `protobuf:"varint,5,opt,name=turning,proto3" json:"turning,omitempty"`                                                // âge fêté au prochain anniversaire
+	DaysUntilBirthday int32                  `protobuf:"varint,6,opt,name=days_until_birthday,json=daysUntilBirthday,proto3" json:"days_until_birthday,omitempty"` // 0 le jour même
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SharedChild) Reset() {
+	*x = SharedChild{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedChild) ProtoMessage() {}
+
+func (x *SharedChild) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedChild.ProtoReflect.Descriptor instead.
+func (*SharedChild) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SharedChild) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SharedChild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SharedChild) GetAgeMonths() int32 {
+	if x != nil {
+		return x.AgeMonths
+	}
+	return 0
+}
+
+func (x *SharedChild) GetNextBirthday() string {
+	if x != nil {
+		return x.NextBirthday
+	}
+	return ""
+}
+
+func (x *SharedChild) GetTurning() int32 {
+	if x != nil {
+		return x.Turning
+	}
+	return 0
+}
+
+func (x *SharedChild) GetDaysUntilBirthday() int32 {
+	if x != nil {
+		return x.DaysUntilBirthday
+	}
+	return 0
+}
+
+// Page d'un enfant, ouverte par le lien de partage d'un dossier qui lui est
+// rattaché : son âge, son prochain anniversaire et la liste du dossier
+type GetSharedChildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	ChildId       string                 `protobuf:"bytes,2,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedChildRequest) Reset() {
+	*x = GetSharedChildRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedChildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedChildRequest) ProtoMessage() {}
+
+func (x *GetSharedChildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedChildRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedChildRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSharedChildRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetSharedChildRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+type GetSharedChildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Child         *SharedChild           `protobuf:"bytes,1,opt,name=child,proto3" json:"child,omitempty"`
+	Folder        *Folder                `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	Links         []*Link                `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedChildResponse) Reset() {
+	*x = GetSharedChildResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedChildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedChildResponse) ProtoMessage() {}
+
+func (x *GetSharedChildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedChildResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedChildResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSharedChildResponse) GetChild() *SharedChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *GetSharedChildResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+func (x *GetSharedChildResponse) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ListChildFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildFoldersRequest) Reset() {
+	*x = ListChildFoldersRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildFoldersRequest) ProtoMessage() {}
+
+func (x *ListChildFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListChildFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListChildFoldersRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+type ListChildFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildFoldersResponse) Reset() {
+	*x = ListChildFoldersResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildFoldersResponse) ProtoMessage() {}
+
+func (x *ListChildFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListChildFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListChildFoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type AddCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          CollaboratorRole       `protobuf:"varint,3,opt,name=role,proto3,enum=tribbae.v1.CollaboratorRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollaboratorRequest) Reset() {
+	*x = AddCollaboratorRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollaboratorRequest) ProtoMessage() {}
+
+func (x *AddCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*AddCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddCollaboratorRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *AddCollaboratorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddCollaboratorRequest) GetRole() CollaboratorRole {
+	if x != nil {
+		return x.Role
+	}
+	return CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+}
+
+type AddCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollaboratorResponse) Reset() {
+	*x = AddCollaboratorResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollaboratorResponse) ProtoMessage() {}
+
+func (x *AddCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*AddCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddCollaboratorResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+// Un collaborateur venu d'un foyer (household_id) ne se retire pas ici :
+// FAILED_PRECONDITION, il faut le retirer du foyer ou départager le dossier
+type RemoveCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorRequest) Reset() {
+	*x = RemoveCollaboratorRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorRequest) ProtoMessage() {}
+
+func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveCollaboratorRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *RemoveCollaboratorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *Folder                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorResponse) Reset() {
+	*x = RemoveCollaboratorResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorResponse) ProtoMessage() {}
+
+func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveCollaboratorResponse) GetFolder() *Folder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type ListCommunityFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"` // plein texte sur le nom, les tags et le contenu des liens
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token de la page précédente
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommunityFoldersRequest) Reset() {
+	*x = ListCommunityFoldersRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommunityFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommunityFoldersRequest) ProtoMessage() {}
+
+func (x *ListCommunityFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommunityFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListCommunityFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCommunityFoldersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListCommunityFoldersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommunityFoldersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommunityFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommunityFoldersResponse) Reset() {
+	*x = ListCommunityFoldersResponse{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommunityFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommunityFoldersResponse) ProtoMessage() {}
+
+func (x *ListCommunityFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_folder_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommunityFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListCommunityFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCommunityFoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *ListCommunityFoldersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LikeFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeFolderRequest) Reset() {
+	*x = LikeFolderRequest{}
+	mi := &file_tribbae_v1_folder_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *LikeFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -1417,7 +2160,7 @@ func (x *LikeFolderRequest) String() string {
 func (*LikeFolderRequest) ProtoMessage() {}
 
 func (x *LikeFolderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[22]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,7 +2173,7 @@ func (x *LikeFolderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeFolderRequest.ProtoReflect.Descriptor instead.
 func (*LikeFolderRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{22}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *LikeFolderRequest) GetFolderId() string {
@@ -1449,7 +2192,7 @@ type LikeFolderResponse struct {
 
 func (x *LikeFolderResponse) Reset() {
 	*x = LikeFolderResponse{}
-	mi := &file_tribbae_v1_folder_proto_msgTypes[23]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1461,7 +2204,7 @@ func (x *LikeFolderResponse) String() string {
 func (*LikeFolderResponse) ProtoMessage() {}
 
 func (x *LikeFolderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[23]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +2217,7 @@ func (x *LikeFolderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeFolderResponse.ProtoReflect.Descriptor instead.
 func (*LikeFolderResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{23}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *LikeFolderResponse) GetLikeCount() int32 {
@@ -1493,7 +2236,7 @@ type UnlikeFolderRequest struct {
 
 func (x *UnlikeFolderRequest) Reset() {
 	*x = UnlikeFolderRequest{}
-	mi := &file_tribbae_v1_folder_proto_msgTypes[24]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1505,7 +2248,7 @@ func (x *UnlikeFolderRequest) String() string {
 func (*UnlikeFolderRequest) ProtoMessage() {}
 
 func (x *UnlikeFolderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[24]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,7 +2261,7 @@ func (x *UnlikeFolderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeFolderRequest.ProtoReflect.Descriptor instead.
 func (*UnlikeFolderRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{24}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UnlikeFolderRequest) GetFolderId() string {
@@ -1537,7 +2280,7 @@ type UnlikeFolderResponse struct {
 
 func (x *UnlikeFolderResponse) Reset() {
 	*x = UnlikeFolderResponse{}
-	mi := &file_tribbae_v1_folder_proto_msgTypes[25]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1549,7 +2292,7 @@ func (x *UnlikeFolderResponse) String() string {
 func (*UnlikeFolderResponse) ProtoMessage() {}
 
 func (x *UnlikeFolderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[25]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +2305,7 @@ func (x *UnlikeFolderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeFolderResponse.ProtoReflect.Descriptor instead.
 func (*UnlikeFolderResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{25}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UnlikeFolderResponse) GetLikeCount() int32 {
@@ -1581,7 +2324,7 @@ type ListTopFoldersRequest struct {
 
 func (x *ListTopFoldersRequest) Reset() {
 	*x = ListTopFoldersRequest{}
-	mi := &file_tribbae_v1_folder_proto_msgTypes[26]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1593,7 +2336,7 @@ func (x *ListTopFoldersRequest) String() string {
 func (*ListTopFoldersRequest) ProtoMessage() {}
 
 func (x *ListTopFoldersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[26]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,7 +2349,7 @@ func (x *ListTopFoldersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTopFoldersRequest.ProtoReflect.Descriptor instead.
 func (*ListTopFoldersRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{26}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListTopFoldersRequest) GetLimit() int32 {
@@ -1625,7 +2368,7 @@ type ListTopFoldersResponse struct {
 
 func (x *ListTopFoldersResponse) Reset() {
 	*x = ListTopFoldersResponse{}
-	mi := &file_tribbae_v1_folder_proto_msgTypes[27]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1637,7 +2380,7 @@ func (x *ListTopFoldersResponse) String() string {
 func (*ListTopFoldersResponse) ProtoMessage() {}
 
 func (x *ListTopFoldersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_folder_proto_msgTypes[27]
+	mi := &file_tribbae_v1_folder_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +2393,7 @@ func (x *ListTopFoldersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTopFoldersResponse.ProtoReflect.Descriptor instead.
 func (*ListTopFoldersResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{27}
+	return file_tribbae_v1_folder_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListTopFoldersResponse) GetFolders() []*Folder {
@@ -1665,13 +2408,14 @@ var File_tribbae_v1_folder_proto protoreflect.FileDescriptor
 const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"\n" +
 	"\x17tribbae/v1/folder.proto\x12\n" +
-	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15tribbae/v1/link.proto\"\xc9\x01\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15tribbae/v1/link.proto\"\xec\x01\n" +
 	"\fCollaborator\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
 	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x120\n" +
 	"\x04role\x18\x04 \x01(\x0e2\x1c.tribbae.v1.CollaboratorRoleR\x04role\x125\n" +
-	"\badded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\x88\x05\n" +
+	"\badded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12!\n" +
+	"\fhousehold_id\x18\x06 \x01(\tR\vhouseholdId\"\x92\x06\n" +
 	"\x06Folder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
@@ -1699,7 +2443,12 @@ const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"\n" +
 	"banner_url\x18\x10 \x01(\tR\tbannerUrl\x12\x12\n" +
 	"\x04tags\x18\x11 \x03(\tR\x04tags\x12$\n" +
-	"\x0eowner_is_admin\x18\x12 \x01(\bR\fownerIsAdmin\"\xbe\x01\n" +
+	"\x0eowner_is_admin\x18\x12 \x01(\bR\fownerIsAdmin\x12\x1a\n" +
+	"\barchived\x18\x13 \x01(\bR\barchived\x12\x16\n" +
+	"\x06frozen\x18\x14 \x01(\bR\x06frozen\x12\x12\n" +
+	"\x04etag\x18\x15 \x01(\tR\x04etag\x12\x1b\n" +
+	"\tchild_ids\x18\x16 \x03(\tR\bchildIds\x12#\n" +
+	"\rhousehold_ids\x18\x17 \x03(\tR\fhouseholdIds\"\xdb\x01\n" +
 	"\x13CreateFolderRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04icon\x18\x02 \x01(\tR\x04icon\x12\x14\n" +
@@ -1709,16 +2458,18 @@ const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"visibility\x12\x1d\n" +
 	"\n" +
 	"banner_url\x18\x05 \x01(\tR\tbannerUrl\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\"B\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tchild_ids\x18\a \x03(\tR\bchildIds\"B\n" +
 	"\x14CreateFolderResponse\x12*\n" +
 	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"/\n" +
 	"\x10GetFolderRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"?\n" +
 	"\x11GetFolderResponse\x12*\n" +
-	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"\x14\n" +
-	"\x12ListFoldersRequest\"C\n" +
+	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"?\n" +
+	"\x12ListFoldersRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"C\n" +
 	"\x13ListFoldersResponse\x12,\n" +
-	"\afolders\x18\x01 \x03(\v2\x12.tribbae.v1.FolderR\afolders\"\xdb\x01\n" +
+	"\afolders\x18\x01 \x03(\v2\x12.tribbae.v1.FolderR\afolders\"\xc9\x02\n" +
 	"\x13UpdateFolderRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1729,12 +2480,33 @@ const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"visibility\x12\x1d\n" +
 	"\n" +
 	"banner_url\x18\x06 \x01(\tR\tbannerUrl\x12\x12\n" +
-	"\x04tags\x18\a \x03(\tR\x04tags\"B\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\t \x01(\tR\x04etag\x12\x1b\n" +
+	"\tchild_ids\x18\n" +
+	" \x03(\tR\bchildIds\"B\n" +
 	"\x14UpdateFolderResponse\x12*\n" +
 	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"2\n" +
 	"\x13DeleteFolderRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\x16\n" +
-	"\x14DeleteFolderResponse\"8\n" +
+	"\x14DeleteFolderResponse\"3\n" +
+	"\x14ArchiveFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"C\n" +
+	"\x15ArchiveFolderResponse\x12*\n" +
+	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"5\n" +
+	"\x16UnarchiveFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"E\n" +
+	"\x17UnarchiveFolderResponse\x12*\n" +
+	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"2\n" +
+	"\x13FreezeFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"B\n" +
+	"\x14FreezeFolderResponse\x12*\n" +
+	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"4\n" +
+	"\x15UnfreezeFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"D\n" +
+	"\x16UnfreezeFolderResponse\x12*\n" +
+	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\"8\n" +
 	"\x19GenerateShareTokenRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"Z\n" +
 	"\x1aGenerateShareTokenResponse\x12\x1f\n" +
@@ -1743,10 +2515,31 @@ const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"\tshare_url\x18\x02 \x01(\tR\bshareUrl\"9\n" +
 	"\x16GetSharedFolderRequest\x12\x1f\n" +
 	"\vshare_token\x18\x01 \x01(\tR\n" +
-	"shareToken\"m\n" +
+	"shareToken\"\xa2\x01\n" +
 	"\x17GetSharedFolderResponse\x12*\n" +
 	"\x06folder\x18\x01 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\x12&\n" +
-	"\x05links\x18\x02 \x03(\v2\x10.tribbae.v1.LinkR\x05links\"}\n" +
+	"\x05links\x18\x02 \x03(\v2\x10.tribbae.v1.LinkR\x05links\x123\n" +
+	"\bchildren\x18\x03 \x03(\v2\x17.tribbae.v1.SharedChildR\bchildren\"\xbf\x01\n" +
+	"\vSharedChild\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"age_months\x18\x03 \x01(\x05R\tageMonths\x12#\n" +
+	"\rnext_birthday\x18\x04 \x01(\tR\fnextBirthday\x12\x18\n" +
+	"\aturning\x18\x05 \x01(\x05R\aturning\x12.\n" +
+	"\x13days_until_birthday\x18\x06 \x01(\x05R\x11daysUntilBirthday\"S\n" +
+	"\x15GetSharedChildRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\x12\x19\n" +
+	"\bchild_id\x18\x02 \x01(\tR\achildId\"\x9b\x01\n" +
+	"\x16GetSharedChildResponse\x12-\n" +
+	"\x05child\x18\x01 \x01(\v2\x17.tribbae.v1.SharedChildR\x05child\x12*\n" +
+	"\x06folder\x18\x02 \x01(\v2\x12.tribbae.v1.FolderR\x06folder\x12&\n" +
+	"\x05links\x18\x03 \x03(\v2\x10.tribbae.v1.LinkR\x05links\"4\n" +
+	"\x17ListChildFoldersRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\"H\n" +
+	"\x18ListChildFoldersResponse\x12,\n" +
+	"\afolders\x18\x01 \x03(\v2\x12.tribbae.v1.FolderR\afolders\"}\n" +
 	"\x16AddCollaboratorRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x120\n" +
@@ -1789,15 +2582,21 @@ const file_tribbae_v1_folder_proto_rawDesc = "" +
 	"\x10CollaboratorRole\x12!\n" +
 	"\x1dCOLLABORATOR_ROLE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18COLLABORATOR_ROLE_VIEWER\x10\x01\x12\x1c\n" +
-	"\x18COLLABORATOR_ROLE_EDITOR\x10\x022\xde\f\n" +
+	"\x18COLLABORATOR_ROLE_EDITOR\x10\x022\xfe\x12\n" +
 	"\rFolderService\x12i\n" +
 	"\fCreateFolder\x12\x1f.tribbae.v1.CreateFolderRequest\x1a .tribbae.v1.CreateFolderResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/folders\x12i\n" +
 	"\tGetFolder\x12\x1c.tribbae.v1.GetFolderRequest\x1a\x1d.tribbae.v1.GetFolderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/folders/{folder_id}\x12c\n" +
 	"\vListFolders\x12\x1e.tribbae.v1.ListFoldersRequest\x1a\x1f.tribbae.v1.ListFoldersResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/folders\x12u\n" +
 	"\fUpdateFolder\x12\x1f.tribbae.v1.UpdateFolderRequest\x1a .tribbae.v1.UpdateFolderResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/folders/{folder_id}\x12r\n" +
-	"\fDeleteFolder\x12\x1f.tribbae.v1.DeleteFolderRequest\x1a .tribbae.v1.DeleteFolderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/folders/{folder_id}\x12\x8d\x01\n" +
+	"\fDeleteFolder\x12\x1f.tribbae.v1.DeleteFolderRequest\x1a .tribbae.v1.DeleteFolderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/folders/{folder_id}\x12\x80\x01\n" +
+	"\rArchiveFolder\x12 .tribbae.v1.ArchiveFolderRequest\x1a!.tribbae.v1.ArchiveFolderResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/folders/{folder_id}/archive\x12\x83\x01\n" +
+	"\x0fUnarchiveFolder\x12\".tribbae.v1.UnarchiveFolderRequest\x1a#.tribbae.v1.UnarchiveFolderResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/folders/{folder_id}/archive\x12|\n" +
+	"\fFreezeFolder\x12\x1f.tribbae.v1.FreezeFolderRequest\x1a .tribbae.v1.FreezeFolderResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/folders/{folder_id}/freeze\x12\x7f\n" +
+	"\x0eUnfreezeFolder\x12!.tribbae.v1.UnfreezeFolderRequest\x1a\".tribbae.v1.UnfreezeFolderResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/folders/{folder_id}/freeze\x12\x8d\x01\n" +
 	"\x12GenerateShareToken\x12%.tribbae.v1.GenerateShareTokenRequest\x1a&.tribbae.v1.GenerateShareTokenResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/folders/{folder_id}/share\x12{\n" +
 	"\x0fGetSharedFolder\x12\".tribbae.v1.GetSharedFolderRequest\x1a#.tribbae.v1.GetSharedFolderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/share/{share_token}\x12\x8c\x01\n" +
+	"\x0eGetSharedChild\x12!.tribbae.v1.GetSharedChildRequest\x1a\".tribbae.v1.GetSharedChildResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/share/{share_token}/children/{child_id}\x12\x86\x01\n" +
+	"\x10ListChildFolders\x12#.tribbae.v1.ListChildFoldersRequest\x1a$.tribbae.v1.ListChildFoldersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/children/{child_id}/folders\x12\x8c\x01\n" +
 	"\x0fAddCollaborator\x12\".tribbae.v1.AddCollaboratorRequest\x1a#.tribbae.v1.AddCollaboratorResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/folders/{folder_id}/collaborators\x12\x9c\x01\n" +
 	"\x12RemoveCollaborator\x12%.tribbae.v1.RemoveCollaboratorRequest\x1a&.tribbae.v1.RemoveCollaboratorResponse\"7\x82\xd3\xe4\x93\x021*//v1/folders/{folder_id}/collaborators/{user_id}\x12\x88\x01\n" +
 	"\x14ListCommunityFolders\x12'.tribbae.v1.ListCommunityFoldersRequest\x1a(.tribbae.v1.ListCommunityFoldersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/community/folders\x12t\n" +
@@ -1819,7 +2618,7 @@ func file_tribbae_v1_folder_proto_rawDescGZIP() []byte {
 }
 
 var file_tribbae_v1_folder_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_tribbae_v1_folder_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_tribbae_v1_folder_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_tribbae_v1_folder_proto_goTypes = []any{
 	(Visibility)(0),                      // 0: tribbae.v1.Visibility
 	(CollaboratorRole)(0),                // 1: tribbae.v1.CollaboratorRole
@@ -1835,76 +2634,112 @@ var file_tribbae_v1_folder_proto_goTypes = []any{
 	(*UpdateFolderResponse)(nil),         // 11: tribbae.v1.UpdateFolderResponse
 	(*DeleteFolderRequest)(nil),          // 12: tribbae.v1.DeleteFolderRequest
 	(*DeleteFolderResponse)(nil),         // 13: tribbae.v1.DeleteFolderResponse
-	(*GenerateShareTokenRequest)(nil),    // 14: tribbae.v1.GenerateShareTokenRequest
-	(*GenerateShareTokenResponse)(nil),   // 15: tribbae.v1.GenerateShareTokenResponse
-	(*GetSharedFolderRequest)(nil),       // 16: tribbae.v1.GetSharedFolderRequest
-	(*GetSharedFolderResponse)(nil),      // 17: tribbae.v1.GetSharedFolderResponse
-	(*AddCollaboratorRequest)(nil),       // 18: tribbae.v1.AddCollaboratorRequest
-	(*AddCollaboratorResponse)(nil),      // 19: tribbae.v1.AddCollaboratorResponse
-	(*RemoveCollaboratorRequest)(nil),    // 20: tribbae.v1.RemoveCollaboratorRequest
-	(*RemoveCollaboratorResponse)(nil),   // 21: tribbae.v1.RemoveCollaboratorResponse
-	(*ListCommunityFoldersRequest)(nil),  // 22: tribbae.v1.ListCommunityFoldersRequest
-	(*ListCommunityFoldersResponse)(nil), // 23: tribbae.v1.ListCommunityFoldersResponse
-	(*LikeFolderRequest)(nil),            // 24: tribbae.v1.LikeFolderRequest
-	(*LikeFolderResponse)(nil),           // 25: tribbae.v1.LikeFolderResponse
-	(*UnlikeFolderRequest)(nil),          // 26: tribbae.v1.UnlikeFolderRequest
-	(*UnlikeFolderResponse)(nil),         // 27: tribbae.v1.UnlikeFolderResponse
-	(*ListTopFoldersRequest)(nil),        // 28: tribbae.v1.ListTopFoldersRequest
-	(*ListTopFoldersResponse)(nil),       // 29: tribbae.v1.ListTopFoldersResponse
-	(*timestamppb.Timestamp)(nil),        // 30: google.protobuf.Timestamp
-	(*Link)(nil),                         // 31: tribbae.v1.Link
+	(*ArchiveFolderRequest)(nil),         // 14: tribbae.v1.ArchiveFolderRequest
+	(*ArchiveFolderResponse)(nil),        // 15: tribbae.v1.ArchiveFolderResponse
+	(*UnarchiveFolderRequest)(nil),       // 16: tribbae.v1.UnarchiveFolderRequest
+	(*UnarchiveFolderResponse)(nil),      // 17: tribbae.v1.UnarchiveFolderResponse
+	(*FreezeFolderRequest)(nil),          // 18: tribbae.v1.FreezeFolderRequest
+	(*FreezeFolderResponse)(nil),         // 19: tribbae.v1.FreezeFolderResponse
+	(*UnfreezeFolderRequest)(nil),        // 20: tribbae.v1.UnfreezeFolderRequest
+	(*UnfreezeFolderResponse)(nil),       // 21: tribbae.v1.UnfreezeFolderResponse
+	(*GenerateShareTokenRequest)(nil),    // 22: tribbae.v1.GenerateShareTokenRequest
+	(*GenerateShareTokenResponse)(nil),   // 23: tribbae.v1.GenerateShareTokenResponse
+	(*GetSharedFolderRequest)(nil),       // 24: tribbae.v1.GetSharedFolderRequest
+	(*GetSharedFolderResponse)(nil),      // 25: tribbae.v1.GetSharedFolderResponse
+	(*SharedChild)(nil),                  // 26: tribbae.v1.SharedChild
+	(*GetSharedChildRequest)(nil),        // 27: tribbae.v1.GetSharedChildRequest
+	(*GetSharedChildResponse)(nil),       // 28: tribbae.v1.GetSharedChildResponse
+	(*ListChildFoldersRequest)(nil),      // 29: tribbae.v1.ListChildFoldersRequest
+	(*ListChildFoldersResponse)(nil),     // 30: tribbae.v1.ListChildFoldersResponse
+	(*AddCollaboratorRequest)(nil),       // 31: tribbae.v1.AddCollaboratorRequest
+	(*AddCollaboratorResponse)(nil),      // 32: tribbae.v1.AddCollaboratorResponse
+	(*RemoveCollaboratorRequest)(nil),    // 33: tribbae.v1.RemoveCollaboratorRequest
+	(*RemoveCollaboratorResponse)(nil),   // 34: tribbae.v1.RemoveCollaboratorResponse
+	(*ListCommunityFoldersRequest)(nil),  // 35: tribbae.v1.ListCommunityFoldersRequest
+	(*ListCommunityFoldersResponse)(nil), // 36: tribbae.v1.ListCommunityFoldersResponse
+	(*LikeFolderRequest)(nil),            // 37: tribbae.v1.LikeFolderRequest
+	(*LikeFolderResponse)(nil),           // 38: tribbae.v1.LikeFolderResponse
+	(*UnlikeFolderRequest)(nil),          // 39: tribbae.v1.UnlikeFolderRequest
+	(*UnlikeFolderResponse)(nil),         // 40: tribbae.v1.UnlikeFolderResponse
+	(*ListTopFoldersRequest)(nil),        // 41: tribbae.v1.ListTopFoldersRequest
+	(*ListTopFoldersResponse)(nil),       // 42: tribbae.v1.ListTopFoldersResponse
+	(*timestamppb.Timestamp)(nil),        // 43: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 44: google.protobuf.FieldMask
+	(*Link)(nil),                         // 45: tribbae.v1.Link
 }
 var file_tribbae_v1_folder_proto_depIdxs = []int32{
 	1,  // 0: tribbae.v1.Collaborator.role:type_name -> tribbae.v1.CollaboratorRole
-	30, // 1: tribbae.v1.Collaborator.added_at:type_name -> google.protobuf.Timestamp
+	43, // 1: tribbae.v1.Collaborator.added_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: tribbae.v1.Folder.visibility:type_name -> tribbae.v1.Visibility
-	30, // 3: tribbae.v1.Folder.created_at:type_name -> google.protobuf.Timestamp
-	30, // 4: tribbae.v1.Folder.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 3: tribbae.v1.Folder.created_at:type_name -> google.protobuf.Timestamp
+	43, // 4: tribbae.v1.Folder.updated_at:type_name -> google.protobuf.Timestamp
 	2,  // 5: tribbae.v1.Folder.collaborators:type_name -> tribbae.v1.Collaborator
 	0,  // 6: tribbae.v1.CreateFolderRequest.visibility:type_name -> tribbae.v1.Visibility
 	3,  // 7: tribbae.v1.CreateFolderResponse.folder:type_name -> tribbae.v1.Folder
 	3,  // 8: tribbae.v1.GetFolderResponse.folder:type_name -> tribbae.v1.Folder
 	3,  // 9: tribbae.v1.ListFoldersResponse.folders:type_name -> tribbae.v1.Folder
 	0,  // 10: tribbae.v1.UpdateFolderRequest.visibility:type_name -> tribbae.v1.Visibility
-	3,  // 11: tribbae.v1.UpdateFolderResponse.folder:type_name -> tribbae.v1.Folder
-	3,  // 12: tribbae.v1.GetSharedFolderResponse.folder:type_name -> tribbae.v1.Folder
-	31, // 13: tribbae.v1.GetSharedFolderResponse.links:type_name -> tribbae.v1.Link
-	1,  // 14: tribbae.v1.AddCollaboratorRequest.role:type_name -> tribbae.v1.CollaboratorRole
-	3,  // 15: tribbae.v1.AddCollaboratorResponse.folder:type_name -> tribbae.v1.Folder
-	3,  // 16: tribbae.v1.RemoveCollaboratorResponse.folder:type_name -> tribbae.v1.Folder
-	3,  // 17: tribbae.v1.ListCommunityFoldersResponse.folders:type_name -> tribbae.v1.Folder
-	3,  // 18: tribbae.v1.ListTopFoldersResponse.folders:type_name -> tribbae.v1.Folder
-	4,  // 19: tribbae.v1.FolderService.CreateFolder:input_type -> tribbae.v1.CreateFolderRequest
-	6,  // 20: tribbae.v1.FolderService.GetFolder:input_type -> tribbae.v1.GetFolderRequest
-	8,  // 21: tribbae.v1.FolderService.ListFolders:input_type -> tribbae.v1.ListFoldersRequest
-	10, // 22: tribbae.v1.FolderService.UpdateFolder:input_type -> tribbae.v1.UpdateFolderRequest
-	12, // 23: tribbae.v1.FolderService.DeleteFolder:input_type -> tribbae.v1.DeleteFolderRequest
-	14, // 24: tribbae.v1.FolderService.GenerateShareToken:input_type -> tribbae.v1.GenerateShareTokenRequest
-	16, // 25: tribbae.v1.FolderService.GetSharedFolder:input_type -> tribbae.v1.GetSharedFolderRequest
-	18, // 26: tribbae.v1.FolderService.AddCollaborator:input_type -> tribbae.v1.AddCollaboratorRequest
-	20, // 27: tribbae.v1.FolderService.RemoveCollaborator:input_type -> tribbae.v1.RemoveCollaboratorRequest
-	22, // 28: tribbae.v1.FolderService.ListCommunityFolders:input_type -> tribbae.v1.ListCommunityFoldersRequest
-	24, // 29: tribbae.v1.FolderService.LikeFolder:input_type -> tribbae.v1.LikeFolderRequest
-	26, // 30: tribbae.v1.FolderService.UnlikeFolder:input_type -> tribbae.v1.UnlikeFolderRequest
-	28, // 31: tribbae.v1.FolderService.ListTopFolders:input_type -> tribbae.v1.ListTopFoldersRequest
-	5,  // 32: tribbae.v1.FolderService.CreateFolder:output_type -> tribbae.v1.CreateFolderResponse
-	7,  // 33: tribbae.v1.FolderService.GetFolder:output_type -> tribbae.v1.GetFolderResponse
-	9,  // 34: tribbae.v1.FolderService.ListFolders:output_type -> tribbae.v1.ListFoldersResponse
-	11, // 35: tribbae.v1.FolderService.UpdateFolder:output_type -> tribbae.v1.UpdateFolderResponse
-	13, // 36: tribbae.v1.FolderService.DeleteFolder:output_type -> tribbae.v1.DeleteFolderResponse
-	15, // 37: tribbae.v1.FolderService.GenerateShareToken:output_type -> tribbae.v1.GenerateShareTokenResponse
-	17, // 38: tribbae.v1.FolderService.GetSharedFolder:output_type -> tribbae.v1.GetSharedFolderResponse
-	19, // 39: tribbae.v1.FolderService.AddCollaborator:output_type -> tribbae.v1.AddCollaboratorResponse
-	21, // 40: tribbae.v1.FolderService.RemoveCollaborator:output_type -> tribbae.v1.RemoveCollaboratorResponse
-	23, // 41: tribbae.v1.FolderService.ListCommunityFolders:output_type -> tribbae.v1.ListCommunityFoldersResponse
-	25, // 42: tribbae.v1.FolderService.LikeFolder:output_type -> tribbae.v1.LikeFolderResponse
-	27, // 43: tribbae.v1.FolderService.UnlikeFolder:output_type -> tribbae.v1.UnlikeFolderResponse
-	29, // 44: tribbae.v1.FolderService.ListTopFolders:output_type -> tribbae.v1.ListTopFoldersResponse
-	32, // [32:45] is the sub-list for method output_type
-	19, // [19:32] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	44, // 11: tribbae.v1.UpdateFolderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 12: tribbae.v1.UpdateFolderResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 13: tribbae.v1.ArchiveFolderResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 14: tribbae.v1.UnarchiveFolderResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 15: tribbae.v1.FreezeFolderResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 16: tribbae.v1.UnfreezeFolderResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 17: tribbae.v1.GetSharedFolderResponse.folder:type_name -> tribbae.v1.Folder
+	45, // 18: tribbae.v1.GetSharedFolderResponse.links:type_name -> tribbae.v1.Link
+	26, // 19: tribbae.v1.GetSharedFolderResponse.children:type_name -> tribbae.v1.SharedChild
+	26, // 20: tribbae.v1.GetSharedChildResponse.child:type_name -> tribbae.v1.SharedChild
+	3,  // 21: tribbae.v1.GetSharedChildResponse.folder:type_name -> tribbae.v1.Folder
+	45, // 22: tribbae.v1.GetSharedChildResponse.links:type_name -> tribbae.v1.Link
+	3,  // 23: tribbae.v1.ListChildFoldersResponse.folders:type_name -> tribbae.v1.Folder
+	1,  // 24: tribbae.v1.AddCollaboratorRequest.role:type_name -> tribbae.v1.CollaboratorRole
+	3,  // 25: tribbae.v1.AddCollaboratorResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 26: tribbae.v1.RemoveCollaboratorResponse.folder:type_name -> tribbae.v1.Folder
+	3,  // 27: tribbae.v1.ListCommunityFoldersResponse.folders:type_name -> tribbae.v1.Folder
+	3,  // 28: tribbae.v1.ListTopFoldersResponse.folders:type_name -> tribbae.v1.Folder
+	4,  // 29: tribbae.v1.FolderService.CreateFolder:input_type -> tribbae.v1.CreateFolderRequest
+	6,  // 30: tribbae.v1.FolderService.GetFolder:input_type -> tribbae.v1.GetFolderRequest
+	8,  // 31: tribbae.v1.FolderService.ListFolders:input_type -> tribbae.v1.ListFoldersRequest
+	10, // 32: tribbae.v1.FolderService.UpdateFolder:input_type -> tribbae.v1.UpdateFolderRequest
+	12, // 33: tribbae.v1.FolderService.DeleteFolder:input_type -> tribbae.v1.DeleteFolderRequest
+	14, // 34: tribbae.v1.FolderService.ArchiveFolder:input_type -> tribbae.v1.ArchiveFolderRequest
+	16, // 35: tribbae.v1.FolderService.UnarchiveFolder:input_type -> tribbae.v1.UnarchiveFolderRequest
+	18, // 36: tribbae.v1.FolderService.FreezeFolder:input_type -> tribbae.v1.FreezeFolderRequest
+	20, // 37: tribbae.v1.FolderService.UnfreezeFolder:input_type -> tribbae.v1.UnfreezeFolderRequest
+	22, // 38: tribbae.v1.FolderService.GenerateShareToken:input_type -> tribbae.v1.GenerateShareTokenRequest
+	24, // 39: tribbae.v1.FolderService.GetSharedFolder:input_type -> tribbae.v1.GetSharedFolderRequest
+	27, // 40: tribbae.v1.FolderService.GetSharedChild:input_type -> tribbae.v1.GetSharedChildRequest
+	29, // 41: tribbae.v1.FolderService.ListChildFolders:input_type -> tribbae.v1.ListChildFoldersRequest
+	31, // 42: tribbae.v1.FolderService.AddCollaborator:input_type -> tribbae.v1.AddCollaboratorRequest
+	33, // 43: tribbae.v1.FolderService.RemoveCollaborator:input_type -> tribbae.v1.RemoveCollaboratorRequest
+	35, // 44: tribbae.v1.FolderService.ListCommunityFolders:input_type -> tribbae.v1.ListCommunityFoldersRequest
+	37, // 45: tribbae.v1.FolderService.LikeFolder:input_type -> tribbae.v1.LikeFolderRequest
+	39, // 46: tribbae.v1.FolderService.UnlikeFolder:input_type -> tribbae.v1.UnlikeFolderRequest
+	41, // 47: tribbae.v1.FolderService.ListTopFolders:input_type -> tribbae.v1.ListTopFoldersRequest
+	5,  // 48: tribbae.v1.FolderService.CreateFolder:output_type -> tribbae.v1.CreateFolderResponse
+	7,  // 49: tribbae.v1.FolderService.GetFolder:output_type -> tribbae.v1.GetFolderResponse
+	9,  // 50: tribbae.v1.FolderService.ListFolders:output_type -> tribbae.v1.ListFoldersResponse
+	11, // 51: tribbae.v1.FolderService.UpdateFolder:output_type -> tribbae.v1.UpdateFolderResponse
+	13, // 52: tribbae.v1.FolderService.DeleteFolder:output_type -> tribbae.v1.DeleteFolderResponse
+	15, // 53: tribbae.v1.FolderService.ArchiveFolder:output_type -> tribbae.v1.ArchiveFolderResponse
+	17, // 54: tribbae.v1.FolderService.UnarchiveFolder:output_type -> tribbae.v1.UnarchiveFolderResponse
+	19, // 55: tribbae.v1.FolderService.FreezeFolder:output_type -> tribbae.v1.FreezeFolderResponse
+	21, // 56: tribbae.v1.FolderService.UnfreezeFolder:output_type -> tribbae.v1.UnfreezeFolderResponse
+	23, // 57: tribbae.v1.FolderService.GenerateShareToken:output_type -> tribbae.v1.GenerateShareTokenResponse
+	25, // 58: tribbae.v1.FolderService.GetSharedFolder:output_type -> tribbae.v1.GetSharedFolderResponse
+	28, // 59: tribbae.v1.FolderService.GetSharedChild:output_type -> tribbae.v1.GetSharedChildResponse
+	30, // 60: tribbae.v1.FolderService.ListChildFolders:output_type -> tribbae.v1.ListChildFoldersResponse
+	32, // 61: tribbae.v1.FolderService.AddCollaborator:output_type -> tribbae.v1.AddCollaboratorResponse
+	34, // 62: tribbae.v1.FolderService.RemoveCollaborator:output_type -> tribbae.v1.RemoveCollaboratorResponse
+	36, // 63: tribbae.v1.FolderService.ListCommunityFolders:output_type -> tribbae.v1.ListCommunityFoldersResponse
+	38, // 64: tribbae.v1.FolderService.LikeFolder:output_type -> tribbae.v1.LikeFolderResponse
+	40, // 65: tribbae.v1.FolderService.UnlikeFolder:output_type -> tribbae.v1.UnlikeFolderResponse
+	42, // 66: tribbae.v1.FolderService.ListTopFolders:output_type -> tribbae.v1.ListTopFoldersResponse
+	48, // [48:67] is the sub-list for method output_type
+	29, // [29:48] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_tribbae_v1_folder_proto_init() }
@@ -1919,7 +2754,7 @@ func file_tribbae_v1_folder_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_folder_proto_rawDesc), len(file_tribbae_v1_folder_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   28,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,8 +24,14 @@ const (
 	FolderService_ListFolders_FullMethodName          = "/tribbae.v1.FolderService/ListFolders"
 	FolderService_UpdateFolder_FullMethodName         = "/tribbae.v1.FolderService/UpdateFolder"
 	FolderService_DeleteFolder_FullMethodName         = "/tribbae.v1.FolderService/DeleteFolder"
+	FolderService_ArchiveFolder_FullMethodName        = "/tribbae.v1.FolderService/ArchiveFolder"
+	FolderService_UnarchiveFolder_FullMethodName      = "/tribbae.v1.FolderService/UnarchiveFolder"
+	FolderService_FreezeFolder_FullMethodName         = "/tribbae.v1.FolderService/FreezeFolder"
+	FolderService_UnfreezeFolder_FullMethodName       = "/tribbae.v1.FolderService/UnfreezeFolder"
 	FolderService_GenerateShareToken_FullMethodName   = "/tribbae.v1.FolderService/GenerateShareToken"
 	FolderService_GetSharedFolder_FullMethodName      = "/tribbae.v1.FolderService/GetSharedFolder"
+	FolderService_GetSharedChild_FullMethodName       = "/tribbae.v1.FolderService/GetSharedChild"
+	FolderService_ListChildFolders_FullMethodName     = "/tribbae.v1.FolderService/ListChildFolders"
 	FolderService_AddCollaborator_FullMethodName      = "/tribbae.v1.FolderService/AddCollaborator"
 	FolderService_RemoveCollaborator_FullMethodName   = "/tribbae.v1.FolderService/RemoveCollaborator"
 	FolderService_ListCommunityFolders_FullMethodName = "/tribbae.v1.FolderService/ListCommunityFolders"
@@ -43,8 +49,14 @@ type FolderServiceClient interface {
 	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
 	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error)
 	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	ArchiveFolder(ctx context.Context, in *ArchiveFolderRequest, opts ...grpc.CallOption) (*ArchiveFolderResponse, error)
+	UnarchiveFolder(ctx context.Context, in *UnarchiveFolderRequest, opts ...grpc.CallOption) (*UnarchiveFolderResponse, error)
+	FreezeFolder(ctx context.Context, in *FreezeFolderRequest, opts ...grpc.CallOption) (*FreezeFolderResponse, error)
+	UnfreezeFolder(ctx context.Context, in *UnfreezeFolderRequest, opts ...grpc.CallOption) (*UnfreezeFolderResponse, error)
 	GenerateShareToken(ctx context.Context, in *GenerateShareTokenRequest, opts ...grpc.CallOption) (*GenerateShareTokenResponse, error)
 	GetSharedFolder(ctx context.Context, in *GetSharedFolderRequest, opts ...grpc.CallOption) (*GetSharedFolderResponse, error)
+	GetSharedChild(ctx context.Context, in *GetSharedChildRequest, opts ...grpc.CallOption) (*GetSharedChildResponse, error)
+	ListChildFolders(ctx context.Context, in *ListChildFoldersRequest, opts ...grpc.CallOption) (*ListChildFoldersResponse, error)
 	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
 	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
 	ListCommunityFolders(ctx context.Context, in *ListCommunityFoldersRequest, opts ...grpc.CallOption) (*ListCommunityFoldersResponse, error)
@@ -111,6 +123,46 @@ func (c *folderServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolder
 	return out, nil
 }
 
+func (c *folderServiceClient) ArchiveFolder(ctx context.Context, in *ArchiveFolderRequest, opts ...grpc.CallOption) (*ArchiveFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveFolderResponse)
+	err := c.cc.Invoke(ctx, FolderService_ArchiveFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderServiceClient) UnarchiveFolder(ctx context.Context, in *UnarchiveFolderRequest, opts ...grpc.CallOption) (*UnarchiveFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveFolderResponse)
+	err := c.cc.Invoke(ctx, FolderService_UnarchiveFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderServiceClient) FreezeFolder(ctx context.Context, in *FreezeFolderRequest, opts ...grpc.CallOption) (*FreezeFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeFolderResponse)
+	err := c.cc.Invoke(ctx, FolderService_FreezeFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderServiceClient) UnfreezeFolder(ctx context.Context, in *UnfreezeFolderRequest, opts ...grpc.CallOption) (*UnfreezeFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeFolderResponse)
+	err := c.cc.Invoke(ctx, FolderService_UnfreezeFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *folderServiceClient) GenerateShareToken(ctx context.Context, in *GenerateShareTokenRequest, opts ...grpc.CallOption) (*GenerateShareTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateShareTokenResponse)
@@ -131,6 +183,26 @@ func (c *folderServiceClient) GetSharedFolder(ctx context.Context, in *GetShared
 	return out, nil
 }
 
+func (c *folderServiceClient) GetSharedChild(ctx context.Context, in *GetSharedChildRequest, opts ...grpc.CallOption) (*GetSharedChildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedChildResponse)
+	err := c.cc.Invoke(ctx, FolderService_GetSharedChild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderServiceClient) ListChildFolders(ctx context.Context, in *ListChildFoldersRequest, opts ...grpc.CallOption) (*ListChildFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChildFoldersResponse)
+	err := c.cc.Invoke(ctx, FolderService_ListChildFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *folderServiceClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddCollaboratorResponse)
@@ -200,8 +272,14 @@ type FolderServiceServer interface {
 	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
 	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
 	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	ArchiveFolder(context.Context, *ArchiveFolderRequest) (*ArchiveFolderResponse, error)
+	UnarchiveFolder(context.Context, *UnarchiveFolderRequest) (*UnarchiveFolderResponse, error)
+	FreezeFolder(context.Context, *FreezeFolderRequest) (*FreezeFolderResponse, error)
+	UnfreezeFolder(context.Context, *UnfreezeFolderRequest) (*UnfreezeFolderResponse, error)
 	GenerateShareToken(context.Context, *GenerateShareTokenRequest) (*GenerateShareTokenResponse, error)
 	GetSharedFolder(context.Context, *GetSharedFolderRequest) (*GetSharedFolderResponse, error)
+	GetSharedChild(context.Context, *GetSharedChildRequest) (*GetSharedChildResponse, error)
+	ListChildFolders(context.Context, *ListChildFoldersRequest) (*ListChildFoldersResponse, error)
 	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
 	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
 	ListCommunityFolders(context.Context, *ListCommunityFoldersRequest) (*ListCommunityFoldersResponse, error)
@@ -232,12 +310,30 @@ func (UnimplementedFolderServiceServer) UpdateFolder(context.Context, *UpdateFol
 func (UnimplementedFolderServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFolder not implemented")
 }
+func (UnimplementedFolderServiceServer) ArchiveFolder(context.Context, *ArchiveFolderRequest) (*ArchiveFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveFolder not implemented")
+}
+func (UnimplementedFolderServiceServer) UnarchiveFolder(context.Context, *UnarchiveFolderRequest) (*UnarchiveFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnarchiveFolder not implemented")
+}
+func (UnimplementedFolderServiceServer) FreezeFolder(context.Context, *FreezeFolderRequest) (*FreezeFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeFolder not implemented")
+}
+func (UnimplementedFolderServiceServer) UnfreezeFolder(context.Context, *UnfreezeFolderRequest) (*UnfreezeFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfreezeFolder not implemented")
+}
 func (UnimplementedFolderServiceServer) GenerateShareToken(context.Context, *GenerateShareTokenRequest) (*GenerateShareTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateShareToken not implemented")
 }
 func (UnimplementedFolderServiceServer) GetSharedFolder(context.Context, *GetSharedFolderRequest) (*GetSharedFolderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSharedFolder not implemented")
 }
+func (UnimplementedFolderServiceServer) GetSharedChild(context.Context, *GetSharedChildRequest) (*GetSharedChildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedChild not implemented")
+}
+func (UnimplementedFolderServiceServer) ListChildFolders(context.Context, *ListChildFoldersRequest) (*ListChildFoldersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChildFolders not implemented")
+}
 func (UnimplementedFolderServiceServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddCollaborator not implemented")
 }
@@ -366,6 +462,78 @@ func _FolderService_DeleteFolder_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FolderService_ArchiveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).ArchiveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_ArchiveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).ArchiveFolder(ctx, req.(*ArchiveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderService_UnarchiveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).UnarchiveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_UnarchiveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).UnarchiveFolder(ctx, req.(*UnarchiveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderService_FreezeFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).FreezeFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_FreezeFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).FreezeFolder(ctx, req.(*FreezeFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderService_UnfreezeFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).UnfreezeFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_UnfreezeFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).UnfreezeFolder(ctx, req.(*UnfreezeFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FolderService_GenerateShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateShareTokenRequest)
 	if err := dec(in); err != nil {
@@ -402,6 +570,42 @@ func _FolderService_GetSharedFolder_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FolderService_GetSharedChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedChildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).GetSharedChild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_GetSharedChild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).GetSharedChild(ctx, req.(*GetSharedChildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderService_ListChildFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).ListChildFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_ListChildFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).ListChildFolders(ctx, req.(*ListChildFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FolderService_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCollaboratorRequest)
 	if err := dec(in); err != nil {
@@ -537,6 +741,22 @@ var FolderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFolder",
 			Handler:    _FolderService_DeleteFolder_Handler,
 		},
+		{
+			MethodName: "ArchiveFolder",
+			Handler:    _FolderService_ArchiveFolder_Handler,
+		},
+		{
+			MethodName: "UnarchiveFolder",
+			Handler:    _FolderService_UnarchiveFolder_Handler,
+		},
+		{
+			MethodName: "FreezeFolder",
+			Handler:    _FolderService_FreezeFolder_Handler,
+		},
+		{
+			MethodName: "UnfreezeFolder",
+			Handler:    _FolderService_UnfreezeFolder_Handler,
+		},
 		{
 			MethodName: "GenerateShareToken",
 			Handler:    _FolderService_GenerateShareToken_Handler,
@@ -545,6 +765,14 @@ var FolderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSharedFolder",
 			Handler:    _FolderService_GetSharedFolder_Handler,
 		},
+		{
+			MethodName: "GetSharedChild",
+			Handler:    _FolderService_GetSharedChild_Handler,
+		},
+		{
+			MethodName: "ListChildFolders",
+			Handler:    _FolderService_ListChildFolders_Handler,
+		},
 		{
 			MethodName: "AddCollaborator",
 			Handler:    _FolderService_AddCollaborator_Handler,
@@ -0,0 +1,710 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/gift.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Réservation d'un cadeau (« je l'achète ») d'une liste partagée. Les
+// réservations sont visibles des proches connectés, sauf du propriétaire de
+// la liste tant qu'il n'a pas choisi de les voir après la fête. Un visiteur
+// venu seulement par le lien de partage ne voit que les cadeaux réservés.
+type GiftClaim struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`  // qui a réservé
+	Mine          bool                   `protobuf:"varint,3,opt,name=mine,proto3" json:"mine,omitempty"` // réservé par l'utilisateur connecté
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftClaim) Reset() {
+	*x = GiftClaim{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftClaim) ProtoMessage() {}
+
+func (x *GiftClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftClaim.ProtoReflect.Descriptor instead.
+func (*GiftClaim) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GiftClaim) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *GiftClaim) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GiftClaim) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *GiftClaim) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GiftSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventDate        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`                         // date de la fête
+	RevealAfterEvent bool                   `protobuf:"varint,2,opt,name=reveal_after_event,json=revealAfterEvent,proto3" json:"reveal_after_event,omitempty"` // montrer les réservations au propriétaire après la fête
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GiftSettings) Reset() {
+	*x = GiftSettings{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftSettings) ProtoMessage() {}
+
+func (x *GiftSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftSettings.ProtoReflect.Descriptor instead.
+func (*GiftSettings) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GiftSettings) GetEventDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventDate
+	}
+	return nil
+}
+
+func (x *GiftSettings) GetRevealAfterEvent() bool {
+	if x != nil {
+		return x.RevealAfterEvent
+	}
+	return false
+}
+
+// Connecté, ou avec le jeton du lien de partage du dossier (et un nom)
+type ClaimGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // obligatoire pour un visiteur anonyme
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimGiftRequest) Reset() {
+	*x = ClaimGiftRequest{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGiftRequest) ProtoMessage() {}
+
+func (x *ClaimGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimGiftRequest.ProtoReflect.Descriptor instead.
+func (*ClaimGiftRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimGiftRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ClaimGiftRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ClaimGiftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ClaimGiftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Claim *GiftClaim             `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// Clé d'annulation remise au visiteur anonyme, à conserver
+	GuestKey      string `protobuf:"bytes,2,opt,name=guest_key,json=guestKey,proto3" json:"guest_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimGiftResponse) Reset() {
+	*x = ClaimGiftResponse{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimGiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGiftResponse) ProtoMessage() {}
+
+func (x *ClaimGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimGiftResponse.ProtoReflect.Descriptor instead.
+func (*ClaimGiftResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClaimGiftResponse) GetClaim() *GiftClaim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+func (x *ClaimGiftResponse) GetGuestKey() string {
+	if x != nil {
+		return x.GuestKey
+	}
+	return ""
+}
+
+type UnclaimGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	GuestKey      string                 `protobuf:"bytes,3,opt,name=guest_key,json=guestKey,proto3" json:"guest_key,omitempty"` // pour un visiteur anonyme
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnclaimGiftRequest) Reset() {
+	*x = UnclaimGiftRequest{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnclaimGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnclaimGiftRequest) ProtoMessage() {}
+
+func (x *UnclaimGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnclaimGiftRequest.ProtoReflect.Descriptor instead.
+func (*UnclaimGiftRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnclaimGiftRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *UnclaimGiftRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *UnclaimGiftRequest) GetGuestKey() string {
+	if x != nil {
+		return x.GuestKey
+	}
+	return ""
+}
+
+type UnclaimGiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnclaimGiftResponse) Reset() {
+	*x = UnclaimGiftResponse{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnclaimGiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnclaimGiftResponse) ProtoMessage() {}
+
+func (x *UnclaimGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnclaimGiftResponse.ProtoReflect.Descriptor instead.
+func (*UnclaimGiftResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{5}
+}
+
+type ListGiftClaimsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FolderId   string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	ShareToken string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // remplace folder_id pour un visiteur du lien de partage
+	// Visiteur sans compte : clé d'une de ses réservations (ClaimGiftResponse),
+	// sans laquelle les réservations lui sont cachées
+	GuestKey      string `protobuf:"bytes,3,opt,name=guest_key,json=guestKey,proto3" json:"guest_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftClaimsRequest) Reset() {
+	*x = ListGiftClaimsRequest{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftClaimsRequest) ProtoMessage() {}
+
+func (x *ListGiftClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftClaimsRequest.ProtoReflect.Descriptor instead.
+func (*ListGiftClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGiftClaimsRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ListGiftClaimsRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ListGiftClaimsRequest) GetGuestKey() string {
+	if x != nil {
+		return x.GuestKey
+	}
+	return ""
+}
+
+type ListGiftClaimsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Claims   []*GiftClaim           `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	Hidden   bool                   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`    // réservations cachées au visiteur
+	Settings *GiftSettings          `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"` // propriétaire seulement
+	// Visiteur sans compte : claims ne donne que les cadeaux réservés, sans
+	// nom ni date, et seulement avec guest_key
+	NamesHidden   bool `protobuf:"varint,4,opt,name=names_hidden,json=namesHidden,proto3" json:"names_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftClaimsResponse) Reset() {
+	*x = ListGiftClaimsResponse{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftClaimsResponse) ProtoMessage() {}
+
+func (x *ListGiftClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftClaimsResponse.ProtoReflect.Descriptor instead.
+func (*ListGiftClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGiftClaimsResponse) GetClaims() []*GiftClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *ListGiftClaimsResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *ListGiftClaimsResponse) GetSettings() *GiftSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ListGiftClaimsResponse) GetNamesHidden() bool {
+	if x != nil {
+		return x.NamesHidden
+	}
+	return false
+}
+
+type UpdateGiftSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Settings      *GiftSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGiftSettingsRequest) Reset() {
+	*x = UpdateGiftSettingsRequest{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGiftSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGiftSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateGiftSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGiftSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGiftSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateGiftSettingsRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *UpdateGiftSettingsRequest) GetSettings() *GiftSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateGiftSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *GiftSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGiftSettingsResponse) Reset() {
+	*x = UpdateGiftSettingsResponse{}
+	mi := &file_tribbae_v1_gift_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGiftSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGiftSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateGiftSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_gift_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGiftSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGiftSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_gift_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateGiftSettingsResponse) GetSettings() *GiftSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_tribbae_v1_gift_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_gift_proto_rawDesc = "" +
+	"\n" +
+	"\x15tribbae/v1/gift.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x01\n" +
+	"\tGiftClaim\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mine\x18\x03 \x01(\bR\x04mine\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"w\n" +
+	"\fGiftSettings\x129\n" +
+	"\n" +
+	"event_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\teventDate\x12,\n" +
+	"\x12reveal_after_event\x18\x02 \x01(\bR\x10revealAfterEvent\"`\n" +
+	"\x10ClaimGiftRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"]\n" +
+	"\x11ClaimGiftResponse\x12+\n" +
+	"\x05claim\x18\x01 \x01(\v2\x15.tribbae.v1.GiftClaimR\x05claim\x12\x1b\n" +
+	"\tguest_key\x18\x02 \x01(\tR\bguestKey\"k\n" +
+	"\x12UnclaimGiftRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12\x1b\n" +
+	"\tguest_key\x18\x03 \x01(\tR\bguestKey\"\x15\n" +
+	"\x13UnclaimGiftResponse\"r\n" +
+	"\x15ListGiftClaimsRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12\x1b\n" +
+	"\tguest_key\x18\x03 \x01(\tR\bguestKey\"\xb8\x01\n" +
+	"\x16ListGiftClaimsResponse\x12-\n" +
+	"\x06claims\x18\x01 \x03(\v2\x15.tribbae.v1.GiftClaimR\x06claims\x12\x16\n" +
+	"\x06hidden\x18\x02 \x01(\bR\x06hidden\x124\n" +
+	"\bsettings\x18\x03 \x01(\v2\x18.tribbae.v1.GiftSettingsR\bsettings\x12!\n" +
+	"\fnames_hidden\x18\x04 \x01(\bR\vnamesHidden\"n\n" +
+	"\x19UpdateGiftSettingsRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x124\n" +
+	"\bsettings\x18\x02 \x01(\v2\x18.tribbae.v1.GiftSettingsR\bsettings\"R\n" +
+	"\x1aUpdateGiftSettingsResponse\x124\n" +
+	"\bsettings\x18\x01 \x01(\v2\x18.tribbae.v1.GiftSettingsR\bsettings2\xac\x04\n" +
+	"\vGiftService\x12n\n" +
+	"\tClaimGift\x12\x1c.tribbae.v1.ClaimGiftRequest\x1a\x1d.tribbae.v1.ClaimGiftResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/links/{link_id}/claim\x12q\n" +
+	"\vUnclaimGift\x12\x1e.tribbae.v1.UnclaimGiftRequest\x1a\x1f.tribbae.v1.UnclaimGiftResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/links/{link_id}/claim\x12\xa1\x01\n" +
+	"\x0eListGiftClaims\x12!.tribbae.v1.ListGiftClaimsRequest\x1a\".tribbae.v1.ListGiftClaimsResponse\"H\x82\xd3\xe4\x93\x02BZ \x12\x1e/v1/share/{share_token}/claims\x12\x1e/v1/folders/{folder_id}/claims\x12\x95\x01\n" +
+	"\x12UpdateGiftSettings\x12%.tribbae.v1.UpdateGiftSettingsRequest\x1a&.tribbae.v1.UpdateGiftSettingsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/v1/folders/{folder_id}/gift-settingsB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_gift_proto_rawDescOnce sync.Once
+	file_tribbae_v1_gift_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_gift_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_gift_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_gift_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_gift_proto_rawDesc), len(file_tribbae_v1_gift_proto_rawDesc)))
+	})
+	return file_tribbae_v1_gift_proto_rawDescData
+}
+
+var file_tribbae_v1_gift_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_tribbae_v1_gift_proto_goTypes = []any{
+	(*GiftClaim)(nil),                  // 0: tribbae.v1.GiftClaim
+	(*GiftSettings)(nil),               // 1: tribbae.v1.GiftSettings
+	(*ClaimGiftRequest)(nil),           // 2: tribbae.v1.ClaimGiftRequest
+	(*ClaimGiftResponse)(nil),          // 3: tribbae.v1.ClaimGiftResponse
+	(*UnclaimGiftRequest)(nil),         // 4: tribbae.v1.UnclaimGiftRequest
+	(*UnclaimGiftResponse)(nil),        // 5: tribbae.v1.UnclaimGiftResponse
+	(*ListGiftClaimsRequest)(nil),      // 6: tribbae.v1.ListGiftClaimsRequest
+	(*ListGiftClaimsResponse)(nil),     // 7: tribbae.v1.ListGiftClaimsResponse
+	(*UpdateGiftSettingsRequest)(nil),  // 8: tribbae.v1.UpdateGiftSettingsRequest
+	(*UpdateGiftSettingsResponse)(nil), // 9: tribbae.v1.UpdateGiftSettingsResponse
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+}
+var file_tribbae_v1_gift_proto_depIdxs = []int32{
+	10, // 0: tribbae.v1.GiftClaim.created_at:type_name -> google.protobuf.Timestamp
+	10, // 1: tribbae.v1.GiftSettings.event_date:type_name -> google.protobuf.Timestamp
+	0,  // 2: tribbae.v1.ClaimGiftResponse.claim:type_name -> tribbae.v1.GiftClaim
+	0,  // 3: tribbae.v1.ListGiftClaimsResponse.claims:type_name -> tribbae.v1.GiftClaim
+	1,  // 4: tribbae.v1.ListGiftClaimsResponse.settings:type_name -> tribbae.v1.GiftSettings
+	1,  // 5: tribbae.v1.UpdateGiftSettingsRequest.settings:type_name -> tribbae.v1.GiftSettings
+	1,  // 6: tribbae.v1.UpdateGiftSettingsResponse.settings:type_name -> tribbae.v1.GiftSettings
+	2,  // 7: tribbae.v1.GiftService.ClaimGift:input_type -> tribbae.v1.ClaimGiftRequest
+	4,  // 8: tribbae.v1.GiftService.UnclaimGift:input_type -> tribbae.v1.UnclaimGiftRequest
+	6,  // 9: tribbae.v1.GiftService.ListGiftClaims:input_type -> tribbae.v1.ListGiftClaimsRequest
+	8,  // 10: tribbae.v1.GiftService.UpdateGiftSettings:input_type -> tribbae.v1.UpdateGiftSettingsRequest
+	3,  // 11: tribbae.v1.GiftService.ClaimGift:output_type -> tribbae.v1.ClaimGiftResponse
+	5,  // 12: tribbae.v1.GiftService.UnclaimGift:output_type -> tribbae.v1.UnclaimGiftResponse
+	7,  // 13: tribbae.v1.GiftService.ListGiftClaims:output_type -> tribbae.v1.ListGiftClaimsResponse
+	9,  // 14: tribbae.v1.GiftService.UpdateGiftSettings:output_type -> tribbae.v1.UpdateGiftSettingsResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_gift_proto_init() }
+func file_tribbae_v1_gift_proto_init() {
+	if File_tribbae_v1_gift_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_gift_proto_rawDesc), len(file_tribbae_v1_gift_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_gift_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_gift_proto_depIdxs,
+		MessageInfos:      file_tribbae_v1_gift_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_gift_proto = out.File
+	file_tribbae_v1_gift_proto_goTypes = nil
+	file_tribbae_v1_gift_proto_depIdxs = nil
+}
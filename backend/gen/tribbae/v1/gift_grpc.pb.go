@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: tribbae/v1/gift.proto
+
+package tribbaev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GiftService_ClaimGift_FullMethodName          = "/tribbae.v1.GiftService/ClaimGift"
+	GiftService_UnclaimGift_FullMethodName        = "/tribbae.v1.GiftService/UnclaimGift"
+	GiftService_ListGiftClaims_FullMethodName     = "/tribbae.v1.GiftService/ListGiftClaims"
+	GiftService_UpdateGiftSettings_FullMethodName = "/tribbae.v1.GiftService/UpdateGiftSettings"
+)
+
+// GiftServiceClient is the client API for GiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GiftServiceClient interface {
+	ClaimGift(ctx context.Context, in *ClaimGiftRequest, opts ...grpc.CallOption) (*ClaimGiftResponse, error)
+	UnclaimGift(ctx context.Context, in *UnclaimGiftRequest, opts ...grpc.CallOption) (*UnclaimGiftResponse, error)
+	ListGiftClaims(ctx context.Context, in *ListGiftClaimsRequest, opts ...grpc.CallOption) (*ListGiftClaimsResponse, error)
+	UpdateGiftSettings(ctx context.Context, in *UpdateGiftSettingsRequest, opts ...grpc.CallOption) (*UpdateGiftSettingsResponse, error)
+}
+
+type giftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGiftServiceClient(cc grpc.ClientConnInterface) GiftServiceClient {
+	return &giftServiceClient{cc}
+}
+
+func (c *giftServiceClient) ClaimGift(ctx context.Context, in *ClaimGiftRequest, opts ...grpc.CallOption) (*ClaimGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimGiftResponse)
+	err := c.cc.Invoke(ctx, GiftService_ClaimGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) UnclaimGift(ctx context.Context, in *UnclaimGiftRequest, opts ...grpc.CallOption) (*UnclaimGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnclaimGiftResponse)
+	err := c.cc.Invoke(ctx, GiftService_UnclaimGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) ListGiftClaims(ctx context.Context, in *ListGiftClaimsRequest, opts ...grpc.CallOption) (*ListGiftClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiftClaimsResponse)
+	err := c.cc.Invoke(ctx, GiftService_ListGiftClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftServiceClient) UpdateGiftSettings(ctx context.Context, in *UpdateGiftSettingsRequest, opts ...grpc.CallOption) (*UpdateGiftSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGiftSettingsResponse)
+	err := c.cc.Invoke(ctx, GiftService_UpdateGiftSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GiftServiceServer is the server API for GiftService service.
+// All implementations should embed UnimplementedGiftServiceServer
+// for forward compatibility.
+type GiftServiceServer interface {
+	ClaimGift(context.Context, *ClaimGiftRequest) (*ClaimGiftResponse, error)
+	UnclaimGift(context.Context, *UnclaimGiftRequest) (*UnclaimGiftResponse, error)
+	ListGiftClaims(context.Context, *ListGiftClaimsRequest) (*ListGiftClaimsResponse, error)
+	UpdateGiftSettings(context.Context, *UpdateGiftSettingsRequest) (*UpdateGiftSettingsResponse, error)
+}
+
+// UnimplementedGiftServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGiftServiceServer struct{}
+
+func (UnimplementedGiftServiceServer) ClaimGift(context.Context, *ClaimGiftRequest) (*ClaimGiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimGift not implemented")
+}
+func (UnimplementedGiftServiceServer) UnclaimGift(context.Context, *UnclaimGiftRequest) (*UnclaimGiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnclaimGift not implemented")
+}
+func (UnimplementedGiftServiceServer) ListGiftClaims(context.Context, *ListGiftClaimsRequest) (*ListGiftClaimsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGiftClaims not implemented")
+}
+func (UnimplementedGiftServiceServer) UpdateGiftSettings(context.Context, *UpdateGiftSettingsRequest) (*UpdateGiftSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGiftSettings not implemented")
+}
+func (UnimplementedGiftServiceServer) testEmbeddedByValue() {}
+
+// UnsafeGiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GiftServiceServer will
+// result in compilation errors.
+type UnsafeGiftServiceServer interface {
+	mustEmbedUnimplementedGiftServiceServer()
+}
+
+func RegisterGiftServiceServer(s grpc.ServiceRegistrar, srv GiftServiceServer) {
+	// If the following call panics, it indicates UnimplementedGiftServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GiftService_ServiceDesc, srv)
+}
+
+func _GiftService_ClaimGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).ClaimGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_ClaimGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).ClaimGift(ctx, req.(*ClaimGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_UnclaimGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnclaimGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).UnclaimGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_UnclaimGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).UnclaimGift(ctx, req.(*UnclaimGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_ListGiftClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).ListGiftClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_ListGiftClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).ListGiftClaims(ctx, req.(*ListGiftClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftService_UpdateGiftSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGiftSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftServiceServer).UpdateGiftSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftService_UpdateGiftSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftServiceServer).UpdateGiftSettings(ctx, req.(*UpdateGiftSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GiftService_ServiceDesc is the grpc.ServiceDesc for GiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tribbae.v1.GiftService",
+	HandlerType: (*GiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimGift",
+			Handler:    _GiftService_ClaimGift_Handler,
+		},
+		{
+			MethodName: "UnclaimGift",
+			Handler:    _GiftService_UnclaimGift_Handler,
+		},
+		{
+			MethodName: "ListGiftClaims",
+			Handler:    _GiftService_ListGiftClaims_Handler,
+		},
+		{
+			MethodName: "UpdateGiftSettings",
+			Handler:    _GiftService_UpdateGiftSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tribbae/v1/gift.proto",
+}
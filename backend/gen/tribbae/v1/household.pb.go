@@ -0,0 +1,1568 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/household.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Rôle d'un membre du foyer. Les admins gèrent les membres ; sur les dossiers
+// partagés avec le foyer, admin et editor modifient, viewer consulte. Admin et
+// editor sont les parents du foyer : ils partagent leurs enfants (ChildService)
+// comme des co-parents.
+type HouseholdRole int32
+
+const (
+	HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED HouseholdRole = 0
+	HouseholdRole_HOUSEHOLD_ROLE_ADMIN       HouseholdRole = 1
+	HouseholdRole_HOUSEHOLD_ROLE_EDITOR      HouseholdRole = 2
+	HouseholdRole_HOUSEHOLD_ROLE_VIEWER      HouseholdRole = 3
+)
+
+// Enum value maps for HouseholdRole.
+var (
+	HouseholdRole_name = map[int32]string{
+		0: "HOUSEHOLD_ROLE_UNSPECIFIED",
+		1: "HOUSEHOLD_ROLE_ADMIN",
+		2: "HOUSEHOLD_ROLE_EDITOR",
+		3: "HOUSEHOLD_ROLE_VIEWER",
+	}
+	HouseholdRole_value = map[string]int32{
+		"HOUSEHOLD_ROLE_UNSPECIFIED": 0,
+		"HOUSEHOLD_ROLE_ADMIN":       1,
+		"HOUSEHOLD_ROLE_EDITOR":      2,
+		"HOUSEHOLD_ROLE_VIEWER":      3,
+	}
+)
+
+func (x HouseholdRole) Enum() *HouseholdRole {
+	p := new(HouseholdRole)
+	*p = x
+	return p
+}
+
+func (x HouseholdRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HouseholdRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_tribbae_v1_household_proto_enumTypes[0].Descriptor()
+}
+
+func (HouseholdRole) Type() protoreflect.EnumType {
+	return &file_tribbae_v1_household_proto_enumTypes[0]
+}
+
+func (x HouseholdRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HouseholdRole.Descriptor instead.
+func (HouseholdRole) EnumDescriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{0}
+}
+
+type HouseholdMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,4,opt,name=role,proto3,enum=tribbae.v1.HouseholdRole" json:"role,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Owner         bool                   `protobuf:"varint,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMember) ProtoMessage() {}
+
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HouseholdMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+func (x *HouseholdMember) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *HouseholdMember) GetOwner() bool {
+	if x != nil {
+		return x.Owner
+	}
+	return false
+}
+
+// Foyer : un groupe familial avec lequel partager des dossiers en une fois
+type Household struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Members   []*HouseholdMember     `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	FolderIds []string               `protobuf:"bytes,5,rep,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"` // dossiers partagés avec le foyer
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Invitations en attente, avec le rôle proposé ; added_at est la date de
+	// l'invitation
+	Invites       []*HouseholdMember `protobuf:"bytes,8,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Household) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Household) ProtoMessage() {}
+
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Household) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Household) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Household) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Household) GetFolderIds() []string {
+	if x != nil {
+		return x.FolderIds
+	}
+	return nil
+}
+
+func (x *Household) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Household) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Household) GetInvites() []*HouseholdMember {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type CreateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdRequest) Reset() {
+	*x = CreateHouseholdRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdResponse) Reset() {
+	*x = CreateHouseholdResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type GetHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdRequest) Reset() {
+	*x = GetHouseholdRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdRequest) ProtoMessage() {}
+
+func (x *GetHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetHouseholdRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+type GetHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdResponse) Reset() {
+	*x = GetHouseholdResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdResponse) ProtoMessage() {}
+
+func (x *GetHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*GetHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type ListHouseholdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsRequest) Reset() {
+	*x = ListHouseholdsRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsRequest) ProtoMessage() {}
+
+func (x *ListHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{6}
+}
+
+type ListHouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsResponse) Reset() {
+	*x = ListHouseholdsResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsResponse) ProtoMessage() {}
+
+func (x *ListHouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListHouseholdsResponse) GetHouseholds() []*Household {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+type DeleteHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHouseholdRequest) Reset() {
+	*x = DeleteHouseholdRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHouseholdRequest) ProtoMessage() {}
+
+func (x *DeleteHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteHouseholdRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+type DeleteHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHouseholdResponse) Reset() {
+	*x = DeleteHouseholdResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHouseholdResponse) ProtoMessage() {}
+
+func (x *DeleteHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{9}
+}
+
+// Invite l'utilisateur d'adresse email : il ne devient membre qu'en
+// acceptant (AcceptHouseholdInvite)
+type AddHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,3,opt,name=role,proto3,enum=tribbae.v1.HouseholdRole" json:"role,omitempty"` // editor par défaut
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddHouseholdMemberRequest) Reset() {
+	*x = AddHouseholdMemberRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *AddHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddHouseholdMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *AddHouseholdMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddHouseholdMemberRequest) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+type AddHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddHouseholdMemberResponse) Reset() {
+	*x = AddHouseholdMemberResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *AddHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddHouseholdMemberResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type UpdateHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,3,opt,name=role,proto3,enum=tribbae.v1.HouseholdRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberRequest) Reset() {
+	*x = UpdateHouseholdMemberRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateHouseholdMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdMemberRequest) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+type UpdateHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberResponse) Reset() {
+	*x = UpdateHouseholdMemberResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateHouseholdMemberResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type RemoveHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // soi-même pour quitter le foyer ; un invité pour annuler son invitation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberRequest) Reset() {
+	*x = RemoveHouseholdMemberRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveHouseholdMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *RemoveHouseholdMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"` // absent quand on a quitté le foyer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberResponse) Reset() {
+	*x = RemoveHouseholdMemberResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveHouseholdMemberResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type ShareFolderWithHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareFolderWithHouseholdRequest) Reset() {
+	*x = ShareFolderWithHouseholdRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareFolderWithHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareFolderWithHouseholdRequest) ProtoMessage() {}
+
+func (x *ShareFolderWithHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareFolderWithHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*ShareFolderWithHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShareFolderWithHouseholdRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ShareFolderWithHouseholdRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type ShareFolderWithHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareFolderWithHouseholdResponse) Reset() {
+	*x = ShareFolderWithHouseholdResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareFolderWithHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareFolderWithHouseholdResponse) ProtoMessage() {}
+
+func (x *ShareFolderWithHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareFolderWithHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*ShareFolderWithHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareFolderWithHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type UnshareFolderFromHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareFolderFromHouseholdRequest) Reset() {
+	*x = UnshareFolderFromHouseholdRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareFolderFromHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareFolderFromHouseholdRequest) ProtoMessage() {}
+
+func (x *UnshareFolderFromHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareFolderFromHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*UnshareFolderFromHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnshareFolderFromHouseholdRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *UnshareFolderFromHouseholdRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type UnshareFolderFromHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareFolderFromHouseholdResponse) Reset() {
+	*x = UnshareFolderFromHouseholdResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareFolderFromHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareFolderFromHouseholdResponse) ProtoMessage() {}
+
+func (x *UnshareFolderFromHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareFolderFromHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*UnshareFolderFromHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnshareFolderFromHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type ListHouseholdInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdInvitesRequest) Reset() {
+	*x = ListHouseholdInvitesRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdInvitesRequest) ProtoMessage() {}
+
+func (x *ListHouseholdInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{20}
+}
+
+// Foyers auxquels l'utilisateur est invité, réduits à id, name et owner_id
+type ListHouseholdInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdInvitesResponse) Reset() {
+	*x = ListHouseholdInvitesResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdInvitesResponse) ProtoMessage() {}
+
+func (x *ListHouseholdInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListHouseholdInvitesResponse) GetHouseholds() []*Household {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+type AcceptHouseholdInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInviteRequest) Reset() {
+	*x = AcceptHouseholdInviteRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInviteRequest) ProtoMessage() {}
+
+func (x *AcceptHouseholdInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInviteRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcceptHouseholdInviteRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+type AcceptHouseholdInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInviteResponse) Reset() {
+	*x = AcceptHouseholdInviteResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInviteResponse) ProtoMessage() {}
+
+func (x *AcceptHouseholdInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInviteResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AcceptHouseholdInviteResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type DeclineHouseholdInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineHouseholdInviteRequest) Reset() {
+	*x = DeclineHouseholdInviteRequest{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineHouseholdInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineHouseholdInviteRequest) ProtoMessage() {}
+
+func (x *DeclineHouseholdInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineHouseholdInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineHouseholdInviteRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeclineHouseholdInviteRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+type DeclineHouseholdInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineHouseholdInviteResponse) Reset() {
+	*x = DeclineHouseholdInviteResponse{}
+	mi := &file_tribbae_v1_household_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineHouseholdInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineHouseholdInviteResponse) ProtoMessage() {}
+
+func (x *DeclineHouseholdInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_household_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineHouseholdInviteResponse.ProtoReflect.Descriptor instead.
+func (*DeclineHouseholdInviteResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_household_proto_rawDescGZIP(), []int{25}
+}
+
+var File_tribbae_v1_household_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_household_proto_rawDesc = "" +
+	"\n" +
+	"\x1atribbae/v1/household.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x01\n" +
+	"\x0fHouseholdMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12-\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x19.tribbae.v1.HouseholdRoleR\x04role\x125\n" +
+	"\badded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\bR\x05owner\"\xcd\x02\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x125\n" +
+	"\amembers\x18\x04 \x03(\v2\x1b.tribbae.v1.HouseholdMemberR\amembers\x12\x1d\n" +
+	"\n" +
+	"folder_ids\x18\x05 \x03(\tR\tfolderIds\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\ainvites\x18\b \x03(\v2\x1b.tribbae.v1.HouseholdMemberR\ainvites\",\n" +
+	"\x16CreateHouseholdRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x17CreateHouseholdResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"8\n" +
+	"\x13GetHouseholdRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"K\n" +
+	"\x14GetHouseholdResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"\x17\n" +
+	"\x15ListHouseholdsRequest\"O\n" +
+	"\x16ListHouseholdsResponse\x125\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x15.tribbae.v1.HouseholdR\n" +
+	"households\";\n" +
+	"\x16DeleteHouseholdRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"\x19\n" +
+	"\x17DeleteHouseholdResponse\"\x83\x01\n" +
+	"\x19AddHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12-\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x19.tribbae.v1.HouseholdRoleR\x04role\"Q\n" +
+	"\x1aAddHouseholdMemberResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"\x89\x01\n" +
+	"\x1cUpdateHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x19.tribbae.v1.HouseholdRoleR\x04role\"T\n" +
+	"\x1dUpdateHouseholdMemberResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"Z\n" +
+	"\x1cRemoveHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"T\n" +
+	"\x1dRemoveHouseholdMemberResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"a\n" +
+	"\x1fShareFolderWithHouseholdRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\"W\n" +
+	" ShareFolderWithHouseholdResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"c\n" +
+	"!UnshareFolderFromHouseholdRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\"Y\n" +
+	"\"UnshareFolderFromHouseholdResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"\x1d\n" +
+	"\x1bListHouseholdInvitesRequest\"U\n" +
+	"\x1cListHouseholdInvitesResponse\x125\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x15.tribbae.v1.HouseholdR\n" +
+	"households\"A\n" +
+	"\x1cAcceptHouseholdInviteRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"T\n" +
+	"\x1dAcceptHouseholdInviteResponse\x123\n" +
+	"\thousehold\x18\x01 \x01(\v2\x15.tribbae.v1.HouseholdR\thousehold\"B\n" +
+	"\x1dDeclineHouseholdInviteRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\" \n" +
+	"\x1eDeclineHouseholdInviteResponse*\x7f\n" +
+	"\rHouseholdRole\x12\x1e\n" +
+	"\x1aHOUSEHOLD_ROLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HOUSEHOLD_ROLE_ADMIN\x10\x01\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_EDITOR\x10\x02\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_VIEWER\x10\x032\xa4\x0e\n" +
+	"\x10HouseholdService\x12u\n" +
+	"\x0fCreateHousehold\x12\".tribbae.v1.CreateHouseholdRequest\x1a#.tribbae.v1.CreateHouseholdResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/households\x12x\n" +
+	"\fGetHousehold\x12\x1f.tribbae.v1.GetHouseholdRequest\x1a .tribbae.v1.GetHouseholdResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/households/{household_id}\x12o\n" +
+	"\x0eListHouseholds\x12!.tribbae.v1.ListHouseholdsRequest\x1a\".tribbae.v1.ListHouseholdsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/households\x12\x81\x01\n" +
+	"\x0fDeleteHousehold\x12\".tribbae.v1.DeleteHouseholdRequest\x1a#.tribbae.v1.DeleteHouseholdResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/households/{household_id}\x12\x95\x01\n" +
+	"\x12AddHouseholdMember\x12%.tribbae.v1.AddHouseholdMemberRequest\x1a&.tribbae.v1.AddHouseholdMemberResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/households/{household_id}/members\x12\xa8\x01\n" +
+	"\x15UpdateHouseholdMember\x12(.tribbae.v1.UpdateHouseholdMemberRequest\x1a).tribbae.v1.UpdateHouseholdMemberResponse\":\x82\xd3\xe4\x93\x024:\x01*2//v1/households/{household_id}/members/{user_id}\x12\xa5\x01\n" +
+	"\x15RemoveHouseholdMember\x12(.tribbae.v1.RemoveHouseholdMemberRequest\x1a).tribbae.v1.RemoveHouseholdMemberResponse\"7\x82\xd3\xe4\x93\x021*//v1/households/{household_id}/members/{user_id}\x12\xb3\x01\n" +
+	"\x18ShareFolderWithHousehold\x12+.tribbae.v1.ShareFolderWithHouseholdRequest\x1a,.tribbae.v1.ShareFolderWithHouseholdResponse\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/v1/households/{household_id}/folders/{folder_id}\x12\xb6\x01\n" +
+	"\x1aUnshareFolderFromHousehold\x12-.tribbae.v1.UnshareFolderFromHouseholdRequest\x1a..tribbae.v1.UnshareFolderFromHouseholdResponse\"9\x82\xd3\xe4\x93\x023*1/v1/households/{household_id}/folders/{folder_id}\x12\x88\x01\n" +
+	"\x14ListHouseholdInvites\x12'.tribbae.v1.ListHouseholdInvitesRequest\x1a(.tribbae.v1.ListHouseholdInvitesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/household-invites\x12\xa4\x01\n" +
+	"\x15AcceptHouseholdInvite\x12(.tribbae.v1.AcceptHouseholdInviteRequest\x1a).tribbae.v1.AcceptHouseholdInviteResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/household-invites/{household_id}/accept\x12\x9d\x01\n" +
+	"\x16DeclineHouseholdInvite\x12).tribbae.v1.DeclineHouseholdInviteRequest\x1a*.tribbae.v1.DeclineHouseholdInviteResponse\",\x82\xd3\xe4\x93\x02&*$/v1/household-invites/{household_id}B5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_household_proto_rawDescOnce sync.Once
+	file_tribbae_v1_household_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_household_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_household_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_household_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_household_proto_rawDesc), len(file_tribbae_v1_household_proto_rawDesc)))
+	})
+	return file_tribbae_v1_household_proto_rawDescData
+}
+
+var file_tribbae_v1_household_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tribbae_v1_household_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_tribbae_v1_household_proto_goTypes = []any{
+	(HouseholdRole)(0),                         // 0: tribbae.v1.HouseholdRole
+	(*HouseholdMember)(nil),                    // 1: tribbae.v1.HouseholdMember
+	(*Household)(nil),                          // 2: tribbae.v1.Household
+	(*CreateHouseholdRequest)(nil),             // 3: tribbae.v1.CreateHouseholdRequest
+	(*CreateHouseholdResponse)(nil),            // 4: tribbae.v1.CreateHouseholdResponse
+	(*GetHouseholdRequest)(nil),                // 5: tribbae.v1.GetHouseholdRequest
+	(*GetHouseholdResponse)(nil),               // 6: tribbae.v1.GetHouseholdResponse
+	(*ListHouseholdsRequest)(nil),              // 7: tribbae.v1.ListHouseholdsRequest
+	(*ListHouseholdsResponse)(nil),             // 8: tribbae.v1.ListHouseholdsResponse
+	(*DeleteHouseholdRequest)(nil),             // 9: tribbae.v1.DeleteHouseholdRequest
+	(*DeleteHouseholdResponse)(nil),            // 10: tribbae.v1.DeleteHouseholdResponse
+	(*AddHouseholdMemberRequest)(nil),          // 11: tribbae.v1.AddHouseholdMemberRequest
+	(*AddHouseholdMemberResponse)(nil),         // 12: tribbae.v1.AddHouseholdMemberResponse
+	(*UpdateHouseholdMemberRequest)(nil),       // 13: tribbae.v1.UpdateHouseholdMemberRequest
+	(*UpdateHouseholdMemberResponse)(nil),      // 14: tribbae.v1.UpdateHouseholdMemberResponse
+	(*RemoveHouseholdMemberRequest)(nil),       // 15: tribbae.v1.RemoveHouseholdMemberRequest
+	(*RemoveHouseholdMemberResponse)(nil),      // 16: tribbae.v1.RemoveHouseholdMemberResponse
+	(*ShareFolderWithHouseholdRequest)(nil),    // 17: tribbae.v1.ShareFolderWithHouseholdRequest
+	(*ShareFolderWithHouseholdResponse)(nil),   // 18: tribbae.v1.ShareFolderWithHouseholdResponse
+	(*UnshareFolderFromHouseholdRequest)(nil),  // 19: tribbae.v1.UnshareFolderFromHouseholdRequest
+	(*UnshareFolderFromHouseholdResponse)(nil), // 20: tribbae.v1.UnshareFolderFromHouseholdResponse
+	(*ListHouseholdInvitesRequest)(nil),        // 21: tribbae.v1.ListHouseholdInvitesRequest
+	(*ListHouseholdInvitesResponse)(nil),       // 22: tribbae.v1.ListHouseholdInvitesResponse
+	(*AcceptHouseholdInviteRequest)(nil),       // 23: tribbae.v1.AcceptHouseholdInviteRequest
+	(*AcceptHouseholdInviteResponse)(nil),      // 24: tribbae.v1.AcceptHouseholdInviteResponse
+	(*DeclineHouseholdInviteRequest)(nil),      // 25: tribbae.v1.DeclineHouseholdInviteRequest
+	(*DeclineHouseholdInviteResponse)(nil),     // 26: tribbae.v1.DeclineHouseholdInviteResponse
+	(*timestamppb.Timestamp)(nil),              // 27: google.protobuf.Timestamp
+}
+var file_tribbae_v1_household_proto_depIdxs = []int32{
+	0,  // 0: tribbae.v1.HouseholdMember.role:type_name -> tribbae.v1.HouseholdRole
+	27, // 1: tribbae.v1.HouseholdMember.added_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: tribbae.v1.Household.members:type_name -> tribbae.v1.HouseholdMember
+	27, // 3: tribbae.v1.Household.created_at:type_name -> google.protobuf.Timestamp
+	27, // 4: tribbae.v1.Household.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: tribbae.v1.Household.invites:type_name -> tribbae.v1.HouseholdMember
+	2,  // 6: tribbae.v1.CreateHouseholdResponse.household:type_name -> tribbae.v1.Household
+	2,  // 7: tribbae.v1.GetHouseholdResponse.household:type_name -> tribbae.v1.Household
+	2,  // 8: tribbae.v1.ListHouseholdsResponse.households:type_name -> tribbae.v1.Household
+	0,  // 9: tribbae.v1.AddHouseholdMemberRequest.role:type_name -> tribbae.v1.HouseholdRole
+	2,  // 10: tribbae.v1.AddHouseholdMemberResponse.household:type_name -> tribbae.v1.Household
+	0,  // 11: tribbae.v1.UpdateHouseholdMemberRequest.role:type_name -> tribbae.v1.HouseholdRole
+	2,  // 12: tribbae.v1.UpdateHouseholdMemberResponse.household:type_name -> tribbae.v1.Household
+	2,  // 13: tribbae.v1.RemoveHouseholdMemberResponse.household:type_name -> tribbae.v1.Household
+	2,  // 14: tribbae.v1.ShareFolderWithHouseholdResponse.household:type_name -> tribbae.v1.Household
+	2,  // 15: tribbae.v1.UnshareFolderFromHouseholdResponse.household:type_name -> tribbae.v1.Household
+	2,  // 16: tribbae.v1.ListHouseholdInvitesResponse.households:type_name -> tribbae.v1.Household
+	2,  // 17: tribbae.v1.AcceptHouseholdInviteResponse.household:type_name -> tribbae.v1.Household
+	3,  // 18: tribbae.v1.HouseholdService.CreateHousehold:input_type -> tribbae.v1.CreateHouseholdRequest
+	5,  // 19: tribbae.v1.HouseholdService.GetHousehold:input_type -> tribbae.v1.GetHouseholdRequest
+	7,  // 20: tribbae.v1.HouseholdService.ListHouseholds:input_type -> tribbae.v1.ListHouseholdsRequest
+	9,  // 21: tribbae.v1.HouseholdService.DeleteHousehold:input_type -> tribbae.v1.DeleteHouseholdRequest
+	11, // 22: tribbae.v1.HouseholdService.AddHouseholdMember:input_type -> tribbae.v1.AddHouseholdMemberRequest
+	13, // 23: tribbae.v1.HouseholdService.UpdateHouseholdMember:input_type -> tribbae.v1.UpdateHouseholdMemberRequest
+	15, // 24: tribbae.v1.HouseholdService.RemoveHouseholdMember:input_type -> tribbae.v1.RemoveHouseholdMemberRequest
+	17, // 25: tribbae.v1.HouseholdService.ShareFolderWithHousehold:input_type -> tribbae.v1.ShareFolderWithHouseholdRequest
+	19, // 26: tribbae.v1.HouseholdService.UnshareFolderFromHousehold:input_type -> tribbae.v1.UnshareFolderFromHouseholdRequest
+	21, // 27: tribbae.v1.HouseholdService.ListHouseholdInvites:input_type -> tribbae.v1.ListHouseholdInvitesRequest
+	23, // 28: tribbae.v1.HouseholdService.AcceptHouseholdInvite:input_type -> tribbae.v1.AcceptHouseholdInviteRequest
+	25, // 29: tribbae.v1.HouseholdService.DeclineHouseholdInvite:input_type -> tribbae.v1.DeclineHouseholdInviteRequest
+	4,  // 30: tribbae.v1.HouseholdService.CreateHousehold:output_type -> tribbae.v1.CreateHouseholdResponse
+	6,  // 31: tribbae.v1.HouseholdService.GetHousehold:output_type -> tribbae.v1.GetHouseholdResponse
+	8,  // 32: tribbae.v1.HouseholdService.ListHouseholds:output_type -> tribbae.v1.ListHouseholdsResponse
+	10, // 33: tribbae.v1.HouseholdService.DeleteHousehold:output_type -> tribbae.v1.DeleteHouseholdResponse
+	12, // 34: tribbae.v1.HouseholdService.AddHouseholdMember:output_type -> tribbae.v1.AddHouseholdMemberResponse
+	14, // 35: tribbae.v1.HouseholdService.UpdateHouseholdMember:output_type -> tribbae.v1.UpdateHouseholdMemberResponse
+	16, // 36: tribbae.v1.HouseholdService.RemoveHouseholdMember:output_type -> tribbae.v1.RemoveHouseholdMemberResponse
+	18, // 37: tribbae.v1.HouseholdService.ShareFolderWithHousehold:output_type -> tribbae.v1.ShareFolderWithHouseholdResponse
+	20, // 38: tribbae.v1.HouseholdService.UnshareFolderFromHousehold:output_type -> tribbae.v1.UnshareFolderFromHouseholdResponse
+	22, // 39: tribbae.v1.HouseholdService.ListHouseholdInvites:output_type -> tribbae.v1.ListHouseholdInvitesResponse
+	24, // 40: tribbae.v1.HouseholdService.AcceptHouseholdInvite:output_type -> tribbae.v1.AcceptHouseholdInviteResponse
+	26, // 41: tribbae.v1.HouseholdService.DeclineHouseholdInvite:output_type -> tribbae.v1.DeclineHouseholdInviteResponse
+	30, // [30:42] is the sub-list for method output_type
+	18, // [18:30] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_household_proto_init() }
+func file_tribbae_v1_household_proto_init() {
+	if File_tribbae_v1_household_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_household_proto_rawDesc), len(file_tribbae_v1_household_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_household_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_household_proto_depIdxs,
+		EnumInfos:         file_tribbae_v1_household_proto_enumTypes,
+		MessageInfos:      file_tribbae_v1_household_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_household_proto = out.File
+	file_tribbae_v1_household_proto_goTypes = nil
+	file_tribbae_v1_household_proto_depIdxs = nil
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: tribbae/v1/household.proto
+
+package tribbaev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HouseholdService_CreateHousehold_FullMethodName            = "/tribbae.v1.HouseholdService/CreateHousehold"
+	HouseholdService_GetHousehold_FullMethodName               = "/tribbae.v1.HouseholdService/GetHousehold"
+	HouseholdService_ListHouseholds_FullMethodName             = "/tribbae.v1.HouseholdService/ListHouseholds"
+	HouseholdService_DeleteHousehold_FullMethodName            = "/tribbae.v1.HouseholdService/DeleteHousehold"
+	HouseholdService_AddHouseholdMember_FullMethodName         = "/tribbae.v1.HouseholdService/AddHouseholdMember"
+	HouseholdService_UpdateHouseholdMember_FullMethodName      = "/tribbae.v1.HouseholdService/UpdateHouseholdMember"
+	HouseholdService_RemoveHouseholdMember_FullMethodName      = "/tribbae.v1.HouseholdService/RemoveHouseholdMember"
+	HouseholdService_ShareFolderWithHousehold_FullMethodName   = "/tribbae.v1.HouseholdService/ShareFolderWithHousehold"
+	HouseholdService_UnshareFolderFromHousehold_FullMethodName = "/tribbae.v1.HouseholdService/UnshareFolderFromHousehold"
+	HouseholdService_ListHouseholdInvites_FullMethodName       = "/tribbae.v1.HouseholdService/ListHouseholdInvites"
+	HouseholdService_AcceptHouseholdInvite_FullMethodName      = "/tribbae.v1.HouseholdService/AcceptHouseholdInvite"
+	HouseholdService_DeclineHouseholdInvite_FullMethodName     = "/tribbae.v1.HouseholdService/DeclineHouseholdInvite"
+)
+
+// HouseholdServiceClient is the client API for HouseholdService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HouseholdServiceClient interface {
+	CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error)
+	GetHousehold(ctx context.Context, in *GetHouseholdRequest, opts ...grpc.CallOption) (*GetHouseholdResponse, error)
+	ListHouseholds(ctx context.Context, in *ListHouseholdsRequest, opts ...grpc.CallOption) (*ListHouseholdsResponse, error)
+	DeleteHousehold(ctx context.Context, in *DeleteHouseholdRequest, opts ...grpc.CallOption) (*DeleteHouseholdResponse, error)
+	AddHouseholdMember(ctx context.Context, in *AddHouseholdMemberRequest, opts ...grpc.CallOption) (*AddHouseholdMemberResponse, error)
+	UpdateHouseholdMember(ctx context.Context, in *UpdateHouseholdMemberRequest, opts ...grpc.CallOption) (*UpdateHouseholdMemberResponse, error)
+	RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*RemoveHouseholdMemberResponse, error)
+	ShareFolderWithHousehold(ctx context.Context, in *ShareFolderWithHouseholdRequest, opts ...grpc.CallOption) (*ShareFolderWithHouseholdResponse, error)
+	UnshareFolderFromHousehold(ctx context.Context, in *UnshareFolderFromHouseholdRequest, opts ...grpc.CallOption) (*UnshareFolderFromHouseholdResponse, error)
+	// Invitations reçues par l'utilisateur
+	ListHouseholdInvites(ctx context.Context, in *ListHouseholdInvitesRequest, opts ...grpc.CallOption) (*ListHouseholdInvitesResponse, error)
+	AcceptHouseholdInvite(ctx context.Context, in *AcceptHouseholdInviteRequest, opts ...grpc.CallOption) (*AcceptHouseholdInviteResponse, error)
+	DeclineHouseholdInvite(ctx context.Context, in *DeclineHouseholdInviteRequest, opts ...grpc.CallOption) (*DeclineHouseholdInviteResponse, error)
+}
+
+type householdServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHouseholdServiceClient(cc grpc.ClientConnInterface) HouseholdServiceClient {
+	return &householdServiceClient{cc}
+}
+
+func (c *householdServiceClient) CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_CreateHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) GetHousehold(ctx context.Context, in *GetHouseholdRequest, opts ...grpc.CallOption) (*GetHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_GetHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) ListHouseholds(ctx context.Context, in *ListHouseholdsRequest, opts ...grpc.CallOption) (*ListHouseholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHouseholdsResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_ListHouseholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) DeleteHousehold(ctx context.Context, in *DeleteHouseholdRequest, opts ...grpc.CallOption) (*DeleteHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_DeleteHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) AddHouseholdMember(ctx context.Context, in *AddHouseholdMemberRequest, opts ...grpc.CallOption) (*AddHouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddHouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_AddHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) UpdateHouseholdMember(ctx context.Context, in *UpdateHouseholdMemberRequest, opts ...grpc.CallOption) (*UpdateHouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_UpdateHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*RemoveHouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveHouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_RemoveHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) ShareFolderWithHousehold(ctx context.Context, in *ShareFolderWithHouseholdRequest, opts ...grpc.CallOption) (*ShareFolderWithHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareFolderWithHouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_ShareFolderWithHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) UnshareFolderFromHousehold(ctx context.Context, in *UnshareFolderFromHouseholdRequest, opts ...grpc.CallOption) (*UnshareFolderFromHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareFolderFromHouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_UnshareFolderFromHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) ListHouseholdInvites(ctx context.Context, in *ListHouseholdInvitesRequest, opts ...grpc.CallOption) (*ListHouseholdInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHouseholdInvitesResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_ListHouseholdInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) AcceptHouseholdInvite(ctx context.Context, in *AcceptHouseholdInviteRequest, opts ...grpc.CallOption) (*AcceptHouseholdInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptHouseholdInviteResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_AcceptHouseholdInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) DeclineHouseholdInvite(ctx context.Context, in *DeclineHouseholdInviteRequest, opts ...grpc.CallOption) (*DeclineHouseholdInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineHouseholdInviteResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_DeclineHouseholdInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HouseholdServiceServer is the server API for HouseholdService service.
+// All implementations should embed UnimplementedHouseholdServiceServer
+// for forward compatibility.
+type HouseholdServiceServer interface {
+	CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error)
+	GetHousehold(context.Context, *GetHouseholdRequest) (*GetHouseholdResponse, error)
+	ListHouseholds(context.Context, *ListHouseholdsRequest) (*ListHouseholdsResponse, error)
+	DeleteHousehold(context.Context, *DeleteHouseholdRequest) (*DeleteHouseholdResponse, error)
+	AddHouseholdMember(context.Context, *AddHouseholdMemberRequest) (*AddHouseholdMemberResponse, error)
+	UpdateHouseholdMember(context.Context, *UpdateHouseholdMemberRequest) (*UpdateHouseholdMemberResponse, error)
+	RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*RemoveHouseholdMemberResponse, error)
+	ShareFolderWithHousehold(context.Context, *ShareFolderWithHouseholdRequest) (*ShareFolderWithHouseholdResponse, error)
+	UnshareFolderFromHousehold(context.Context, *UnshareFolderFromHouseholdRequest) (*UnshareFolderFromHouseholdResponse, error)
+	// Invitations reçues par l'utilisateur
+	ListHouseholdInvites(context.Context, *ListHouseholdInvitesRequest) (*ListHouseholdInvitesResponse, error)
+	AcceptHouseholdInvite(context.Context, *AcceptHouseholdInviteRequest) (*AcceptHouseholdInviteResponse, error)
+	DeclineHouseholdInvite(context.Context, *DeclineHouseholdInviteRequest) (*DeclineHouseholdInviteResponse, error)
+}
+
+// UnimplementedHouseholdServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHouseholdServiceServer struct{}
+
+func (UnimplementedHouseholdServiceServer) CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) GetHousehold(context.Context, *GetHouseholdRequest) (*GetHouseholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) ListHouseholds(context.Context, *ListHouseholdsRequest) (*ListHouseholdsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHouseholds not implemented")
+}
+func (UnimplementedHouseholdServiceServer) DeleteHousehold(context.Context, *DeleteHouseholdRequest) (*DeleteHouseholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) AddHouseholdMember(context.Context, *AddHouseholdMemberRequest) (*AddHouseholdMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdServiceServer) UpdateHouseholdMember(context.Context, *UpdateHouseholdMemberRequest) (*UpdateHouseholdMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdServiceServer) RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*RemoveHouseholdMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdServiceServer) ShareFolderWithHousehold(context.Context, *ShareFolderWithHouseholdRequest) (*ShareFolderWithHouseholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareFolderWithHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) UnshareFolderFromHousehold(context.Context, *UnshareFolderFromHouseholdRequest) (*UnshareFolderFromHouseholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnshareFolderFromHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) ListHouseholdInvites(context.Context, *ListHouseholdInvitesRequest) (*ListHouseholdInvitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHouseholdInvites not implemented")
+}
+func (UnimplementedHouseholdServiceServer) AcceptHouseholdInvite(context.Context, *AcceptHouseholdInviteRequest) (*AcceptHouseholdInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptHouseholdInvite not implemented")
+}
+func (UnimplementedHouseholdServiceServer) DeclineHouseholdInvite(context.Context, *DeclineHouseholdInviteRequest) (*DeclineHouseholdInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineHouseholdInvite not implemented")
+}
+func (UnimplementedHouseholdServiceServer) testEmbeddedByValue() {}
+
+// UnsafeHouseholdServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HouseholdServiceServer will
+// result in compilation errors.
+type UnsafeHouseholdServiceServer interface {
+	mustEmbedUnimplementedHouseholdServiceServer()
+}
+
+func RegisterHouseholdServiceServer(s grpc.ServiceRegistrar, srv HouseholdServiceServer) {
+	// If the following call panics, it indicates UnimplementedHouseholdServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HouseholdService_ServiceDesc, srv)
+}
+
+func _HouseholdService_CreateHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).CreateHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_CreateHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).CreateHousehold(ctx, req.(*CreateHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_GetHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).GetHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_GetHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).GetHousehold(ctx, req.(*GetHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_ListHouseholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHouseholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).ListHouseholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_ListHouseholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).ListHouseholds(ctx, req.(*ListHouseholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_DeleteHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).DeleteHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_DeleteHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).DeleteHousehold(ctx, req.(*DeleteHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_AddHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).AddHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_AddHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).AddHouseholdMember(ctx, req.(*AddHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_UpdateHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).UpdateHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_UpdateHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).UpdateHouseholdMember(ctx, req.(*UpdateHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_RemoveHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).RemoveHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_RemoveHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).RemoveHouseholdMember(ctx, req.(*RemoveHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_ShareFolderWithHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareFolderWithHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).ShareFolderWithHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_ShareFolderWithHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).ShareFolderWithHousehold(ctx, req.(*ShareFolderWithHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_UnshareFolderFromHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareFolderFromHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).UnshareFolderFromHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_UnshareFolderFromHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).UnshareFolderFromHousehold(ctx, req.(*UnshareFolderFromHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_ListHouseholdInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHouseholdInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).ListHouseholdInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_ListHouseholdInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).ListHouseholdInvites(ctx, req.(*ListHouseholdInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_AcceptHouseholdInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptHouseholdInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).AcceptHouseholdInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_AcceptHouseholdInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).AcceptHouseholdInvite(ctx, req.(*AcceptHouseholdInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_DeclineHouseholdInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineHouseholdInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).DeclineHouseholdInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_DeclineHouseholdInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).DeclineHouseholdInvite(ctx, req.(*DeclineHouseholdInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HouseholdService_ServiceDesc is the grpc.ServiceDesc for HouseholdService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HouseholdService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tribbae.v1.HouseholdService",
+	HandlerType: (*HouseholdServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHousehold",
+			Handler:    _HouseholdService_CreateHousehold_Handler,
+		},
+		{
+			MethodName: "GetHousehold",
+			Handler:    _HouseholdService_GetHousehold_Handler,
+		},
+		{
+			MethodName: "ListHouseholds",
+			Handler:    _HouseholdService_ListHouseholds_Handler,
+		},
+		{
+			MethodName: "DeleteHousehold",
+			Handler:    _HouseholdService_DeleteHousehold_Handler,
+		},
+		{
+			MethodName: "AddHouseholdMember",
+			Handler:    _HouseholdService_AddHouseholdMember_Handler,
+		},
+		{
+			MethodName: "UpdateHouseholdMember",
+			Handler:    _HouseholdService_UpdateHouseholdMember_Handler,
+		},
+		{
+			MethodName: "RemoveHouseholdMember",
+			Handler:    _HouseholdService_RemoveHouseholdMember_Handler,
+		},
+		{
+			MethodName: "ShareFolderWithHousehold",
+			Handler:    _HouseholdService_ShareFolderWithHousehold_Handler,
+		},
+		{
+			MethodName: "UnshareFolderFromHousehold",
+			Handler:    _HouseholdService_UnshareFolderFromHousehold_Handler,
+		},
+		{
+			MethodName: "ListHouseholdInvites",
+			Handler:    _HouseholdService_ListHouseholdInvites_Handler,
+		},
+		{
+			MethodName: "AcceptHouseholdInvite",
+			Handler:    _HouseholdService_AcceptHouseholdInvite_Handler,
+		},
+		{
+			MethodName: "DeclineHouseholdInvite",
+			Handler:    _HouseholdService_DeclineHouseholdInvite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tribbae/v1/household.proto",
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -87,50 +88,90 @@ func (LinkCategory) EnumDescriptor() ([]byte, []int) {
 	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{0}
 }
 
-type Link struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId          string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	FolderId         string                 `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Url              string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
-	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Category         LinkCategory           `protobuf:"varint,7,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
-	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
-	AgeRange         string                 `protobuf:"bytes,9,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
-	Location         string                 `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
-	Price            string                 `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl         string                 `protobuf:"bytes,12,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	EventDate        int64                  `protobuf:"varint,13,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
-	ReminderEnabled  bool                   `protobuf:"varint,14,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
-	Rating           int32                  `protobuf:"varint,15,opt,name=rating,proto3" json:"rating,omitempty"`
-	Ingredients      []string               `protobuf:"bytes,16,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LikeCount        int32                  `protobuf:"varint,19,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	LikedByMe        bool                   `protobuf:"varint,20,opt,name=liked_by_me,json=likedByMe,proto3" json:"liked_by_me,omitempty"`
-	Favorite         bool                   `protobuf:"varint,21,opt,name=favorite,proto3" json:"favorite,omitempty"`
-	OwnerDisplayName string                 `protobuf:"bytes,22,opt,name=owner_display_name,json=ownerDisplayName,proto3" json:"owner_display_name,omitempty"`
-	OwnerIsAdmin     bool                   `protobuf:"varint,23,opt,name=owner_is_admin,json=ownerIsAdmin,proto3" json:"owner_is_admin,omitempty"`
-	Visibility       string                 `protobuf:"bytes,24,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public"
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type LinkSortField int32
+
+const (
+	LinkSortField_LINK_SORT_FIELD_UNSPECIFIED LinkSortField = 0 // created_at décroissant
+	LinkSortField_LINK_SORT_FIELD_CREATED_AT  LinkSortField = 1
+	LinkSortField_LINK_SORT_FIELD_UPDATED_AT  LinkSortField = 2
+	LinkSortField_LINK_SORT_FIELD_RATING      LinkSortField = 3
+	LinkSortField_LINK_SORT_FIELD_EVENT_DATE  LinkSortField = 4 // les liens sans date sont en premier en croissant, en dernier en décroissant
+	LinkSortField_LINK_SORT_FIELD_TITLE       LinkSortField = 5
+)
+
+// Enum value maps for LinkSortField.
+var (
+	LinkSortField_name = map[int32]string{
+		0: "LINK_SORT_FIELD_UNSPECIFIED",
+		1: "LINK_SORT_FIELD_CREATED_AT",
+		2: "LINK_SORT_FIELD_UPDATED_AT",
+		3: "LINK_SORT_FIELD_RATING",
+		4: "LINK_SORT_FIELD_EVENT_DATE",
+		5: "LINK_SORT_FIELD_TITLE",
+	}
+	LinkSortField_value = map[string]int32{
+		"LINK_SORT_FIELD_UNSPECIFIED": 0,
+		"LINK_SORT_FIELD_CREATED_AT":  1,
+		"LINK_SORT_FIELD_UPDATED_AT":  2,
+		"LINK_SORT_FIELD_RATING":      3,
+		"LINK_SORT_FIELD_EVENT_DATE":  4,
+		"LINK_SORT_FIELD_TITLE":       5,
+	}
+)
+
+func (x LinkSortField) Enum() *LinkSortField {
+	p := new(LinkSortField)
+	*p = x
+	return p
 }
 
-func (x *Link) Reset() {
-	*x = Link{}
+func (x LinkSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_tribbae_v1_link_proto_enumTypes[1].Descriptor()
+}
+
+func (LinkSortField) Type() protoreflect.EnumType {
+	return &file_tribbae_v1_link_proto_enumTypes[1]
+}
+
+func (x LinkSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkSortField.Descriptor instead.
+func (LinkSortField) EnumDescriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{1}
+}
+
+// Résultat de la dernière vérification de l'URL d'un lien
+type LinkHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HttpStatus    int32                  `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	FinalUrl      string                 `protobuf:"bytes,2,opt,name=final_url,json=finalUrl,proto3" json:"final_url,omitempty"` // après redirections
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	Broken        bool                   `protobuf:"varint,4,opt,name=broken,proto3" json:"broken,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // "http_error" | "unreachable" | "redirected_to_home"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkHealth) Reset() {
+	*x = LinkHealth{}
 	mi := &file_tribbae_v1_link_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Link) String() string {
+func (x *LinkHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Link) ProtoMessage() {}
+func (*LinkHealth) ProtoMessage() {}
 
-func (x *Link) ProtoReflect() protoreflect.Message {
+func (x *LinkHealth) ProtoReflect() protoreflect.Message {
 	mi := &file_tribbae_v1_link_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,216 +183,276 @@ func (x *Link) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Link.ProtoReflect.Descriptor instead.
-func (*Link) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkHealth.ProtoReflect.Descriptor instead.
+func (*LinkHealth) Descriptor() ([]byte, []int) {
 	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Link) GetId() string {
+func (x *LinkHealth) GetHttpStatus() int32 {
 	if x != nil {
-		return x.Id
+		return x.HttpStatus
 	}
-	return ""
+	return 0
 }
 
-func (x *Link) GetOwnerId() string {
+func (x *LinkHealth) GetFinalUrl() string {
 	if x != nil {
-		return x.OwnerId
+		return x.FinalUrl
 	}
 	return ""
 }
 
-func (x *Link) GetFolderId() string {
+func (x *LinkHealth) GetCheckedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FolderId
+		return x.CheckedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Link) GetTitle() string {
+func (x *LinkHealth) GetBroken() bool {
 	if x != nil {
-		return x.Title
+		return x.Broken
 	}
-	return ""
+	return false
 }
 
-func (x *Link) GetUrl() string {
+func (x *LinkHealth) GetReason() string {
 	if x != nil {
-		return x.Url
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Link) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Fiche d'une recette importée de la page (schema.org Recipe)
+type Recipe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instructions  []string               `protobuf:"bytes,1,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	Yield         string                 `protobuf:"bytes,2,opt,name=yield,proto3" json:"yield,omitempty"`        // tel qu'annoncé : "6 parts"
+	Servings      int32                  `protobuf:"varint,3,opt,name=servings,proto3" json:"servings,omitempty"` // nombre de parts lu dans yield, 0 si inconnu
+	PrepMinutes   int32                  `protobuf:"varint,4,opt,name=prep_minutes,json=prepMinutes,proto3" json:"prep_minutes,omitempty"`
+	CookMinutes   int32                  `protobuf:"varint,5,opt,name=cook_minutes,json=cookMinutes,proto3" json:"cook_minutes,omitempty"`
+	TotalMinutes  int32                  `protobuf:"varint,6,opt,name=total_minutes,json=totalMinutes,proto3" json:"total_minutes,omitempty"`
+	Nutrition     *Nutrition             `protobuf:"bytes,7,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Link) GetCategory() LinkCategory {
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[1]
 	if x != nil {
-		return x.Category
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Link) GetTags() []string {
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recipe) GetInstructions() []string {
 	if x != nil {
-		return x.Tags
+		return x.Instructions
 	}
 	return nil
 }
 
-func (x *Link) GetAgeRange() string {
+func (x *Recipe) GetYield() string {
 	if x != nil {
-		return x.AgeRange
+		return x.Yield
 	}
 	return ""
 }
 
-func (x *Link) GetLocation() string {
+func (x *Recipe) GetServings() int32 {
 	if x != nil {
-		return x.Location
+		return x.Servings
 	}
-	return ""
+	return 0
 }
 
-func (x *Link) GetPrice() string {
+func (x *Recipe) GetPrepMinutes() int32 {
 	if x != nil {
-		return x.Price
+		return x.PrepMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *Link) GetImageUrl() string {
+func (x *Recipe) GetCookMinutes() int32 {
 	if x != nil {
-		return x.ImageUrl
+		return x.CookMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *Link) GetEventDate() int64 {
+func (x *Recipe) GetTotalMinutes() int32 {
 	if x != nil {
-		return x.EventDate
+		return x.TotalMinutes
 	}
 	return 0
 }
 
-func (x *Link) GetReminderEnabled() bool {
+func (x *Recipe) GetNutrition() *Nutrition {
 	if x != nil {
-		return x.ReminderEnabled
+		return x.Nutrition
 	}
-	return false
+	return nil
 }
 
-func (x *Link) GetRating() int32 {
+// Valeurs nutritionnelles telles qu'écrites par le site ("250 kcal", "12 g")
+type Nutrition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServingSize   string                 `protobuf:"bytes,1,opt,name=serving_size,json=servingSize,proto3" json:"serving_size,omitempty"`
+	Calories      string                 `protobuf:"bytes,2,opt,name=calories,proto3" json:"calories,omitempty"`
+	Fat           string                 `protobuf:"bytes,3,opt,name=fat,proto3" json:"fat,omitempty"`
+	SaturatedFat  string                 `protobuf:"bytes,4,opt,name=saturated_fat,json=saturatedFat,proto3" json:"saturated_fat,omitempty"`
+	Carbohydrate  string                 `protobuf:"bytes,5,opt,name=carbohydrate,proto3" json:"carbohydrate,omitempty"`
+	Sugar         string                 `protobuf:"bytes,6,opt,name=sugar,proto3" json:"sugar,omitempty"`
+	Fiber         string                 `protobuf:"bytes,7,opt,name=fiber,proto3" json:"fiber,omitempty"`
+	Protein       string                 `protobuf:"bytes,8,opt,name=protein,proto3" json:"protein,omitempty"`
+	Sodium        string                 `protobuf:"bytes,9,opt,name=sodium,proto3" json:"sodium,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nutrition) Reset() {
+	*x = Nutrition{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nutrition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nutrition) ProtoMessage() {}
+
+func (x *Nutrition) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[2]
 	if x != nil {
-		return x.Rating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Link) GetIngredients() []string {
+// Deprecated: Use Nutrition.ProtoReflect.Descriptor instead.
+func (*Nutrition) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Nutrition) GetServingSize() string {
 	if x != nil {
-		return x.Ingredients
+		return x.ServingSize
 	}
-	return nil
+	return ""
 }
 
-func (x *Link) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Nutrition) GetCalories() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Calories
 	}
-	return nil
+	return ""
 }
 
-func (x *Link) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Nutrition) GetFat() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Fat
 	}
-	return nil
+	return ""
 }
 
-func (x *Link) GetLikeCount() int32 {
+func (x *Nutrition) GetSaturatedFat() string {
 	if x != nil {
-		return x.LikeCount
+		return x.SaturatedFat
 	}
-	return 0
+	return ""
 }
 
-func (x *Link) GetLikedByMe() bool {
+func (x *Nutrition) GetCarbohydrate() string {
 	if x != nil {
-		return x.LikedByMe
+		return x.Carbohydrate
 	}
-	return false
+	return ""
 }
 
-func (x *Link) GetFavorite() bool {
+func (x *Nutrition) GetSugar() string {
 	if x != nil {
-		return x.Favorite
+		return x.Sugar
 	}
-	return false
+	return ""
 }
 
-func (x *Link) GetOwnerDisplayName() string {
+func (x *Nutrition) GetFiber() string {
 	if x != nil {
-		return x.OwnerDisplayName
+		return x.Fiber
 	}
 	return ""
 }
 
-func (x *Link) GetOwnerIsAdmin() bool {
+func (x *Nutrition) GetProtein() string {
 	if x != nil {
-		return x.OwnerIsAdmin
+		return x.Protein
 	}
-	return false
+	return ""
 }
 
-func (x *Link) GetVisibility() string {
+func (x *Nutrition) GetSodium() string {
 	if x != nil {
-		return x.Visibility
+		return x.Sodium
 	}
 	return ""
 }
 
-type CreateLinkRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	FolderId        string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Url             string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Category        LinkCategory           `protobuf:"varint,5,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
-	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	AgeRange        string                 `protobuf:"bytes,7,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
-	Location        string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
-	Price           string                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl        string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	EventDate       int64                  `protobuf:"varint,11,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
-	ReminderEnabled bool                   `protobuf:"varint,12,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
-	Rating          int32                  `protobuf:"varint,13,opt,name=rating,proto3" json:"rating,omitempty"`
-	Ingredients     []string               `protobuf:"bytes,14,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	Favorite        bool                   `protobuf:"varint,15,opt,name=favorite,proto3" json:"favorite,omitempty"`
-	Visibility      string                 `protobuf:"bytes,16,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public"
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Ligne d'ingrédient analysée : "1/2 c. à soupe d'huile"
+type Ingredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raw           string                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`                                      // ligne d'origine
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                          // 0 si la ligne n'a pas de quantité
+	QuantityMax   float64                `protobuf:"fixed64,3,opt,name=quantity_max,json=quantityMax,proto3" json:"quantity_max,omitempty"` // borne haute d'une fourchette ("2 à 3 pommes")
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                                    // "g", "cl", "c. à soupe", "gousse"… vide pour des pièces
+	Item          string                 `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
+	Text          string                 `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"` // ligne réécrite avec les quantités : "1½ c. à soupe d'huile"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLinkRequest) Reset() {
-	*x = CreateLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[1]
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLinkRequest) String() string {
+func (x *Ingredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLinkRequest) ProtoMessage() {}
+func (*Ingredient) ProtoMessage() {}
 
-func (x *CreateLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[1]
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,145 +463,2124 @@ func (x *CreateLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLinkRequest.ProtoReflect.Descriptor instead.
-func (*CreateLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateLinkRequest) GetFolderId() string {
+func (x *Ingredient) GetRaw() string {
 	if x != nil {
-		return x.FolderId
+		return x.Raw
 	}
 	return ""
 }
 
-func (x *CreateLinkRequest) GetTitle() string {
+func (x *Ingredient) GetQuantity() float64 {
 	if x != nil {
-		return x.Title
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateLinkRequest) GetUrl() string {
+func (x *Ingredient) GetQuantityMax() float64 {
 	if x != nil {
-		return x.Url
+		return x.QuantityMax
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateLinkRequest) GetDescription() string {
+func (x *Ingredient) GetUnit() string {
 	if x != nil {
-		return x.Description
+		return x.Unit
 	}
 	return ""
 }
 
-func (x *CreateLinkRequest) GetCategory() LinkCategory {
+func (x *Ingredient) GetItem() string {
 	if x != nil {
-		return x.Category
+		return x.Item
 	}
-	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateLinkRequest) GetTags() []string {
+func (x *Ingredient) GetText() string {
 	if x != nil {
-		return x.Tags
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateLinkRequest) GetAgeRange() string {
-	if x != nil {
-		return x.AgeRange
-	}
-	return ""
+// Tranche d'âge conseillée, bornes incluses, lue dans age_range
+type AgeBounds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinMonths     int32                  `protobuf:"varint,1,opt,name=min_months,json=minMonths,proto3" json:"min_months,omitempty"`
+	MaxMonths     int32                  `protobuf:"varint,2,opt,name=max_months,json=maxMonths,proto3" json:"max_months,omitempty"` // 1200 : sans limite
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLinkRequest) GetLocation() string {
+func (x *AgeBounds) Reset() {
+	*x = AgeBounds{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgeBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgeBounds) ProtoMessage() {}
+
+func (x *AgeBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[4]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateLinkRequest) GetPrice() string {
+// Deprecated: Use AgeBounds.ProtoReflect.Descriptor instead.
+func (*AgeBounds) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AgeBounds) GetMinMonths() int32 {
 	if x != nil {
-		return x.Price
+		return x.MinMonths
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateLinkRequest) GetImageUrl() string {
+func (x *AgeBounds) GetMaxMonths() int32 {
 	if x != nil {
-		return x.ImageUrl
+		return x.MaxMonths
+	}
+	return 0
+}
+
+// Montant lu dans price, en centimes
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmountCents   int64                  `protobuf:"varint,1,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 : "EUR", "USD"…
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Money) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Suivi du prix de la page d'un lien
+type PriceWatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // destinataire des alertes
+	ThresholdCents int64                  `protobuf:"varint,2,opt,name=threshold_cents,json=thresholdCents,proto3" json:"threshold_cents,omitempty"` // alerte à ce prix ou moins ; 0 : à chaque baisse
+	Current        *Money                 `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`                                      // dernier prix relevé, absent avant le premier relevé
+	CheckedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceWatch) Reset() {
+	*x = PriceWatch{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceWatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceWatch) ProtoMessage() {}
+
+func (x *PriceWatch) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceWatch.ProtoReflect.Descriptor instead.
+func (*PriceWatch) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceWatch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PriceWatch) GetThresholdCents() int64 {
+	if x != nil {
+		return x.ThresholdCents
+	}
+	return 0
+}
+
+func (x *PriceWatch) GetCurrent() *Money {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *PriceWatch) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *PriceWatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Coordonnées WGS 84
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Link struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId           string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FolderId          string                 `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Url               string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Description       string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Category          LinkCategory           `protobuf:"varint,7,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Tags              []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	AgeRange          string                 `protobuf:"bytes,9,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
+	Location          string                 `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	Price             string                 `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl          string                 `protobuf:"bytes,12,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	EventDate         int64                  `protobuf:"varint,13,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	ReminderEnabled   bool                   `protobuf:"varint,14,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
+	Rating            int32                  `protobuf:"varint,15,opt,name=rating,proto3" json:"rating,omitempty"`
+	Ingredients       []string               `protobuf:"bytes,16,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LikeCount         int32                  `protobuf:"varint,19,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	LikedByMe         bool                   `protobuf:"varint,20,opt,name=liked_by_me,json=likedByMe,proto3" json:"liked_by_me,omitempty"`
+	Favorite          bool                   `protobuf:"varint,21,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	OwnerDisplayName  string                 `protobuf:"bytes,22,opt,name=owner_display_name,json=ownerDisplayName,proto3" json:"owner_display_name,omitempty"`
+	OwnerIsAdmin      bool                   `protobuf:"varint,23,opt,name=owner_is_admin,json=ownerIsAdmin,proto3" json:"owner_is_admin,omitempty"`
+	Visibility        string                 `protobuf:"bytes,24,opt,name=visibility,proto3" json:"visibility,omitempty"`                                        // "private" | "public"
+	Etag              string                 `protobuf:"bytes,25,opt,name=etag,proto3" json:"etag,omitempty"`                                                    // à renvoyer dans UpdateLinkRequest
+	CanonicalUrl      string                 `protobuf:"bytes,26,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`                // URL canonique annoncée par la page
+	Health            *LinkHealth            `protobuf:"bytes,27,opt,name=health,proto3" json:"health,omitempty"`                                                // absent tant que l'URL n'a pas été vérifiée
+	Recipe            *Recipe                `protobuf:"bytes,28,opt,name=recipe,proto3" json:"recipe,omitempty"`                                                // fiche importée du schema.org Recipe de la page
+	ParsedIngredients []*Ingredient          `protobuf:"bytes,29,rep,name=parsed_ingredients,json=parsedIngredients,proto3" json:"parsed_ingredients,omitempty"` // ingredients analysés
+	Geo               *GeoPoint              `protobuf:"bytes,30,opt,name=geo,proto3" json:"geo,omitempty"`                                                      // coordonnées de location, absentes si le lieu n'est pas reconnu
+	Ages              *AgeBounds             `protobuf:"bytes,31,opt,name=ages,proto3" json:"ages,omitempty"`                                                    // absent si age_range n'est pas reconnu
+	PriceAmount       *Money                 `protobuf:"bytes,32,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`                   // absent si price n'est pas reconnu ; 0 pour "gratuit"
+	Purchased         bool                   `protobuf:"varint,33,opt,name=purchased,proto3" json:"purchased,omitempty"`                                         // cadeau ou article déjà acheté
+	PriceWatch        *PriceWatch            `protobuf:"bytes,34,opt,name=price_watch,json=priceWatch,proto3" json:"price_watch,omitempty"`                      // absent si le prix n'est pas suivi
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Link) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Link) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Link) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *Link) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Link) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Link) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Link) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *Link) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Link) GetAgeRange() string {
+	if x != nil {
+		return x.AgeRange
+	}
+	return ""
+}
+
+func (x *Link) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Link) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Link) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Link) GetEventDate() int64 {
+	if x != nil {
+		return x.EventDate
+	}
+	return 0
+}
+
+func (x *Link) GetReminderEnabled() bool {
+	if x != nil {
+		return x.ReminderEnabled
+	}
+	return false
+}
+
+func (x *Link) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Link) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *Link) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Link) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Link) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Link) GetLikedByMe() bool {
+	if x != nil {
+		return x.LikedByMe
+	}
+	return false
+}
+
+func (x *Link) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *Link) GetOwnerDisplayName() string {
+	if x != nil {
+		return x.OwnerDisplayName
+	}
+	return ""
+}
+
+func (x *Link) GetOwnerIsAdmin() bool {
+	if x != nil {
+		return x.OwnerIsAdmin
+	}
+	return false
+}
+
+func (x *Link) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Link) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Link) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *Link) GetHealth() *LinkHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Link) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *Link) GetParsedIngredients() []*Ingredient {
+	if x != nil {
+		return x.ParsedIngredients
+	}
+	return nil
+}
+
+func (x *Link) GetGeo() *GeoPoint {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *Link) GetAges() *AgeBounds {
+	if x != nil {
+		return x.Ages
+	}
+	return nil
+}
+
+func (x *Link) GetPriceAmount() *Money {
+	if x != nil {
+		return x.PriceAmount
+	}
+	return nil
+}
+
+func (x *Link) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *Link) GetPriceWatch() *PriceWatch {
+	if x != nil {
+		return x.PriceWatch
+	}
+	return nil
+}
+
+type CreateLinkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FolderId        string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Url             string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Category        LinkCategory           `protobuf:"varint,5,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	AgeRange        string                 `protobuf:"bytes,7,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
+	Location        string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Price           string                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	EventDate       int64                  `protobuf:"varint,11,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	ReminderEnabled bool                   `protobuf:"varint,12,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
+	Rating          int32                  `protobuf:"varint,13,opt,name=rating,proto3" json:"rating,omitempty"`
+	Ingredients     []string               `protobuf:"bytes,14,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Favorite        bool                   `protobuf:"varint,15,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Visibility      string                 `protobuf:"bytes,16,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public"
+	// Ne pas chercher si la page est déjà enregistrée (pas de
+	// duplicate_link_ids dans la réponse)
+	AllowDuplicate bool `protobuf:"varint,17,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"`
+	Purchased      bool `protobuf:"varint,18,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateLinkRequest) Reset() {
+	*x = CreateLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest) ProtoMessage() {}
+
+func (x *CreateLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateLinkRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *CreateLinkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateLinkRequest) GetAgeRange() string {
+	if x != nil {
+		return x.AgeRange
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetEventDate() int64 {
+	if x != nil {
+		return x.EventDate
+	}
+	return 0
+}
+
+func (x *CreateLinkRequest) GetReminderEnabled() bool {
+	if x != nil {
+		return x.ReminderEnabled
+	}
+	return false
+}
+
+func (x *CreateLinkRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateLinkRequest) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *CreateLinkRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *CreateLinkRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest) GetAllowDuplicate() bool {
+	if x != nil {
+		return x.AllowDuplicate
+	}
+	return false
+}
+
+func (x *CreateLinkRequest) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+type CreateLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Link  *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// Avertissement : liens accessibles qui pointent déjà vers la même page.
+	// Le lien est créé quand même.
+	DuplicateLinkIds []string `protobuf:"bytes,2,rep,name=duplicate_link_ids,json=duplicateLinkIds,proto3" json:"duplicate_link_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateLinkResponse) Reset() {
+	*x = CreateLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkResponse) ProtoMessage() {}
+
+func (x *CreateLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateLinkResponse) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *CreateLinkResponse) GetDuplicateLinkIds() []string {
+	if x != nil {
+		return x.DuplicateLinkIds
+	}
+	return nil
+}
+
+type GetLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkRequest) Reset() {
+	*x = GetLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkRequest) ProtoMessage() {}
+
+func (x *GetLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type GetLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkResponse) Reset() {
+	*x = GetLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkResponse) ProtoMessage() {}
+
+func (x *GetLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLinkResponse) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type ListLinksRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FolderId          string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Category          LinkCategory           `protobuf:"varint,2,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Tags              []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	MatchAllTags      bool                   `protobuf:"varint,4,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"` // false : au moins un des tags ; true : tous les tags
+	FavoritesOnly     bool                   `protobuf:"varint,5,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`
+	MinRating         int32                  `protobuf:"varint,6,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`      // 0 : pas de borne
+	MaxRating         int32                  `protobuf:"varint,7,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`      // 0 : pas de borne
+	EventAfter        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=event_after,json=eventAfter,proto3" json:"event_after,omitempty"`    // inclus
+	EventBefore       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=event_before,json=eventBefore,proto3" json:"event_before,omitempty"` // exclu
+	Visibility        string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`                     // "private" | "public"
+	OwnerId           string                 `protobuf:"bytes,11,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`            // liens créés par cet utilisateur
+	SortBy            LinkSortField          `protobuf:"varint,12,opt,name=sort_by,json=sortBy,proto3,enum=tribbae.v1.LinkSortField" json:"sort_by,omitempty"`
+	Descending        bool                   `protobuf:"varint,13,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize          int32                  `protobuf:"varint,14,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                // 500 au maximum ; 0 : tous les liens, sans page
+	PageToken         string                 `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                              // next_page_token de la page précédente, avec le même tri et les mêmes filtres
+	BrokenOnly        bool                   `protobuf:"varint,16,opt,name=broken_only,json=brokenOnly,proto3" json:"broken_only,omitempty"`                          // liens dont l'URL ne répond plus
+	SuitableForMyKids bool                   `protobuf:"varint,17,opt,name=suitable_for_my_kids,json=suitableForMyKids,proto3" json:"suitable_for_my_kids,omitempty"` // adaptés à l'âge d'un de ses enfants, ou sans âge conseillé
+	MinPriceCents     int64                  `protobuf:"varint,18,opt,name=min_price_cents,json=minPriceCents,proto3" json:"min_price_cents,omitempty"`               // 0 : pas de borne
+	MaxPriceCents     int64                  `protobuf:"varint,19,opt,name=max_price_cents,json=maxPriceCents,proto3" json:"max_price_cents,omitempty"`               // 0 : pas de borne
+	Currency          string                 `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`                                                 // seulement les prix dans cette devise ("EUR")
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListLinksRequest) Reset() {
+	*x = ListLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinksRequest) ProtoMessage() {}
+
+func (x *ListLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLinksRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ListLinksRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *ListLinksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListLinksRequest) GetMatchAllTags() bool {
+	if x != nil {
+		return x.MatchAllTags
+	}
+	return false
+}
+
+func (x *ListLinksRequest) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *ListLinksRequest) GetMinRating() int32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *ListLinksRequest) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *ListLinksRequest) GetEventAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventAfter
+	}
+	return nil
+}
+
+func (x *ListLinksRequest) GetEventBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventBefore
+	}
+	return nil
+}
+
+func (x *ListLinksRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *ListLinksRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListLinksRequest) GetSortBy() LinkSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return LinkSortField_LINK_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListLinksRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListLinksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLinksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLinksRequest) GetBrokenOnly() bool {
+	if x != nil {
+		return x.BrokenOnly
+	}
+	return false
+}
+
+func (x *ListLinksRequest) GetSuitableForMyKids() bool {
+	if x != nil {
+		return x.SuitableForMyKids
+	}
+	return false
+}
+
+func (x *ListLinksRequest) GetMinPriceCents() int64 {
+	if x != nil {
+		return x.MinPriceCents
+	}
+	return 0
+}
+
+func (x *ListLinksRequest) GetMaxPriceCents() int64 {
+	if x != nil {
+		return x.MaxPriceCents
+	}
+	return 0
+}
+
+func (x *ListLinksRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinksResponse) Reset() {
+	*x = ListLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinksResponse) ProtoMessage() {}
+
+func (x *ListLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLinksResponse) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ListLinksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateLinkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LinkId          string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	FolderId        string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Url             string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Category        LinkCategory           `protobuf:"varint,6,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	AgeRange        string                 `protobuf:"bytes,8,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
+	Location        string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Price           string                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,11,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	EventDate       int64                  `protobuf:"varint,12,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	ReminderEnabled bool                   `protobuf:"varint,13,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
+	Rating          int32                  `protobuf:"varint,14,opt,name=rating,proto3" json:"rating,omitempty"`
+	Ingredients     []string               `protobuf:"bytes,15,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Favorite        bool                   `protobuf:"varint,16,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Visibility      string                 `protobuf:"bytes,17,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public"
+	// Champs à modifier (ex. "title", "tags") ; vide : tous sauf favorite et purchased
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,18,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// etag du lien lu ; si renseigné, la modification échoue (ABORTED) quand
+	// quelqu'un d'autre a modifié le lien entre-temps
+	Etag           string `protobuf:"bytes,19,opt,name=etag,proto3" json:"etag,omitempty"`
+	AllowDuplicate bool   `protobuf:"varint,20,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"` // voir CreateLinkRequest
+	Purchased      bool   `protobuf:"varint,21,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateLinkRequest) Reset() {
+	*x = UpdateLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLinkRequest) ProtoMessage() {}
+
+func (x *UpdateLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpdateLinkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateLinkRequest) GetAgeRange() string {
+	if x != nil {
+		return x.AgeRange
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetEventDate() int64 {
+	if x != nil {
+		return x.EventDate
+	}
+	return 0
+}
+
+func (x *UpdateLinkRequest) GetReminderEnabled() bool {
+	if x != nil {
+		return x.ReminderEnabled
+	}
+	return false
+}
+
+func (x *UpdateLinkRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateLinkRequest) GetIngredients() []string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *UpdateLinkRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *UpdateLinkRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateLinkRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateLinkRequest) GetAllowDuplicate() bool {
+	if x != nil {
+		return x.AllowDuplicate
+	}
+	return false
+}
+
+func (x *UpdateLinkRequest) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+type UpdateLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Link  *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// Voir CreateLinkResponse ; renseigné seulement quand la page a changé
+	DuplicateLinkIds []string `protobuf:"bytes,2,rep,name=duplicate_link_ids,json=duplicateLinkIds,proto3" json:"duplicate_link_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateLinkResponse) Reset() {
+	*x = UpdateLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLinkResponse) ProtoMessage() {}
+
+func (x *UpdateLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLinkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateLinkResponse) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *UpdateLinkResponse) GetDuplicateLinkIds() []string {
+	if x != nil {
+		return x.DuplicateLinkIds
+	}
+	return nil
+}
+
+type DeleteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLinkRequest) Reset() {
+	*x = DeleteLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLinkRequest) ProtoMessage() {}
+
+func (x *DeleteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type DeleteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLinkResponse) Reset() {
+	*x = DeleteLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLinkResponse) ProtoMessage() {}
+
+func (x *DeleteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{18}
+}
+
+type LikeLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeLinkRequest) Reset() {
+	*x = LikeLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeLinkRequest) ProtoMessage() {}
+
+func (x *LikeLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeLinkRequest.ProtoReflect.Descriptor instead.
+func (*LikeLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LikeLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type LikeLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount     int32                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeLinkResponse) Reset() {
+	*x = LikeLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeLinkResponse) ProtoMessage() {}
+
+func (x *LikeLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeLinkResponse.ProtoReflect.Descriptor instead.
+func (*LikeLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LikeLinkResponse) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type UnlikeLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeLinkRequest) Reset() {
+	*x = UnlikeLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeLinkRequest) ProtoMessage() {}
+
+func (x *UnlikeLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeLinkRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnlikeLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type UnlikeLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount     int32                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeLinkResponse) Reset() {
+	*x = UnlikeLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeLinkResponse) ProtoMessage() {}
+
+func (x *UnlikeLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeLinkResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnlikeLinkResponse) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type ToggleFavoriteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteLinkRequest) Reset() {
+	*x = ToggleFavoriteLinkRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteLinkRequest) ProtoMessage() {}
+
+func (x *ToggleFavoriteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteLinkRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ToggleFavoriteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type ToggleFavoriteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorite      bool                   `protobuf:"varint,1,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteLinkResponse) Reset() {
+	*x = ToggleFavoriteLinkResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteLinkResponse) ProtoMessage() {}
+
+func (x *ToggleFavoriteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteLinkResponse.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ToggleFavoriteLinkResponse) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type ListCommunityLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // optionnel, filtre par catégorie
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`      // max par catégorie, défaut 6
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommunityLinksRequest) Reset() {
+	*x = ListCommunityLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommunityLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommunityLinksRequest) ProtoMessage() {}
+
+func (x *ListCommunityLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommunityLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListCommunityLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCommunityLinksRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
 	return ""
 }
 
-func (x *CreateLinkRequest) GetEventDate() int64 {
+func (x *ListCommunityLinksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCommunityLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommunityLinksResponse) Reset() {
+	*x = ListCommunityLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommunityLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommunityLinksResponse) ProtoMessage() {}
+
+func (x *ListCommunityLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommunityLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListCommunityLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCommunityLinksResponse) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ListNewLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // nombre de nouveautés, défaut 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewLinksRequest) Reset() {
+	*x = ListNewLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewLinksRequest) ProtoMessage() {}
+
+func (x *ListNewLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[27]
 	if x != nil {
-		return x.EventDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListNewLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListNewLinksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *CreateLinkRequest) GetReminderEnabled() bool {
+type ListNewLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewLinksResponse) Reset() {
+	*x = ListNewLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewLinksResponse) ProtoMessage() {}
+
+func (x *ListNewLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[28]
 	if x != nil {
-		return x.ReminderEnabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListNewLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListNewLinksResponse) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Résultat d'une opération groupée pour un lien
+type BatchLinkResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"` // code gRPC de l'échec (0 si succès)
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchLinkResult) Reset() {
+	*x = BatchLinkResult{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchLinkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLinkResult) ProtoMessage() {}
+
+func (x *BatchLinkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLinkResult.ProtoReflect.Descriptor instead.
+func (*BatchLinkResult) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchLinkResult) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *BatchLinkResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-func (x *CreateLinkRequest) GetRating() int32 {
+func (x *BatchLinkResult) GetCode() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Code
 	}
 	return 0
 }
 
-func (x *CreateLinkRequest) GetIngredients() []string {
+func (x *BatchLinkResult) GetMessage() string {
 	if x != nil {
-		return x.Ingredients
+		return x.Message
+	}
+	return ""
+}
+
+type BatchUpdateLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkIds       []string               `protobuf:"bytes,1,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"` // 500 au maximum
+	AddTags       []string               `protobuf:"bytes,2,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags    []string               `protobuf:"bytes,3,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	Visibility    string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public", vide : inchangée
+	Atomic        bool                   `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`        // tout ou rien, dans une transaction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateLinksRequest) Reset() {
+	*x = BatchUpdateLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateLinksRequest) ProtoMessage() {}
+
+func (x *BatchUpdateLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchUpdateLinksRequest) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
 	}
 	return nil
 }
 
-func (x *CreateLinkRequest) GetFavorite() bool {
+func (x *BatchUpdateLinksRequest) GetAddTags() []string {
 	if x != nil {
-		return x.Favorite
+		return x.AddTags
 	}
-	return false
+	return nil
 }
 
-func (x *CreateLinkRequest) GetVisibility() string {
+func (x *BatchUpdateLinksRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *BatchUpdateLinksRequest) GetVisibility() string {
 	if x != nil {
 		return x.Visibility
 	}
 	return ""
 }
 
-type CreateLinkResponse struct {
+func (x *BatchUpdateLinksRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchUpdateLinksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Link          *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Results       []*BatchLinkResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLinkResponse) Reset() {
-	*x = CreateLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[2]
+func (x *BatchUpdateLinksResponse) Reset() {
+	*x = BatchUpdateLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLinkResponse) String() string {
+func (x *BatchUpdateLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLinkResponse) ProtoMessage() {}
+func (*BatchUpdateLinksResponse) ProtoMessage() {}
 
-func (x *CreateLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[2]
+func (x *BatchUpdateLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,40 +2591,196 @@ func (x *CreateLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLinkResponse.ProtoReflect.Descriptor instead.
-func (*CreateLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BatchUpdateLinksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchUpdateLinksResponse) GetResults() []*BatchLinkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchMoveLinksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LinkIds        []string               `protobuf:"bytes,1,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"`
+	TargetFolderId string                 `protobuf:"bytes,2,opt,name=target_folder_id,json=targetFolderId,proto3" json:"target_folder_id,omitempty"`
+	Atomic         bool                   `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchMoveLinksRequest) Reset() {
+	*x = BatchMoveLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMoveLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMoveLinksRequest) ProtoMessage() {}
+
+func (x *BatchMoveLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMoveLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchMoveLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchMoveLinksRequest) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
+	}
+	return nil
+}
+
+func (x *BatchMoveLinksRequest) GetTargetFolderId() string {
+	if x != nil {
+		return x.TargetFolderId
+	}
+	return ""
+}
+
+func (x *BatchMoveLinksRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchMoveLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchLinkResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMoveLinksResponse) Reset() {
+	*x = BatchMoveLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMoveLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMoveLinksResponse) ProtoMessage() {}
+
+func (x *BatchMoveLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMoveLinksResponse.ProtoReflect.Descriptor instead.
+func (*BatchMoveLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchMoveLinksResponse) GetResults() []*BatchLinkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkIds       []string               `protobuf:"bytes,1,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"`
+	Atomic        bool                   `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteLinksRequest) Reset() {
+	*x = BatchDeleteLinksRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteLinksRequest) ProtoMessage() {}
+
+func (x *BatchDeleteLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateLinkResponse) GetLink() *Link {
+// Deprecated: Use BatchDeleteLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchDeleteLinksRequest) GetLinkIds() []string {
 	if x != nil {
-		return x.Link
+		return x.LinkIds
 	}
 	return nil
 }
 
-type GetLinkRequest struct {
+func (x *BatchDeleteLinksRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchDeleteLinksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Results       []*BatchLinkResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLinkRequest) Reset() {
-	*x = GetLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[3]
+func (x *BatchDeleteLinksResponse) Reset() {
+	*x = BatchDeleteLinksResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLinkRequest) String() string {
+func (x *BatchDeleteLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLinkRequest) ProtoMessage() {}
+func (*BatchDeleteLinksResponse) ProtoMessage() {}
 
-func (x *GetLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[3]
+func (x *BatchDeleteLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,40 +2791,40 @@ func (x *GetLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLinkRequest.ProtoReflect.Descriptor instead.
-func (*GetLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BatchDeleteLinksResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteLinksResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetLinkRequest) GetLinkId() string {
+func (x *BatchDeleteLinksResponse) GetResults() []*BatchLinkResult {
 	if x != nil {
-		return x.LinkId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type GetLinkResponse struct {
+type GetFolderHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Link          *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLinkResponse) Reset() {
-	*x = GetLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[4]
+func (x *GetFolderHealthRequest) Reset() {
+	*x = GetFolderHealthRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLinkResponse) String() string {
+func (x *GetFolderHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLinkResponse) ProtoMessage() {}
+func (*GetFolderHealthRequest) ProtoMessage() {}
 
-func (x *GetLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[4]
+func (x *GetFolderHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,40 +2835,44 @@ func (x *GetLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLinkResponse.ProtoReflect.Descriptor instead.
-func (*GetLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetFolderHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderHealthRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetLinkResponse) GetLink() *Link {
+func (x *GetFolderHealthRequest) GetFolderId() string {
 	if x != nil {
-		return x.Link
+		return x.FolderId
 	}
-	return nil
+	return ""
 }
 
-type ListLinksRequest struct {
+type GetFolderHealthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Checked       int32                  `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Unchecked     int32                  `protobuf:"varint,3,opt,name=unchecked,proto3" json:"unchecked,omitempty"`
+	Redirected    int32                  `protobuf:"varint,4,opt,name=redirected,proto3" json:"redirected,omitempty"`
+	BrokenLinks   []*Link                `protobuf:"bytes,5,rep,name=broken_links,json=brokenLinks,proto3" json:"broken_links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLinksRequest) Reset() {
-	*x = ListLinksRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[5]
+func (x *GetFolderHealthResponse) Reset() {
+	*x = GetFolderHealthResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLinksRequest) String() string {
+func (x *GetFolderHealthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLinksRequest) ProtoMessage() {}
+func (*GetFolderHealthResponse) ProtoMessage() {}
 
-func (x *ListLinksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[5]
+func (x *GetFolderHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,40 +2883,68 @@ func (x *ListLinksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLinksRequest.ProtoReflect.Descriptor instead.
-func (*ListLinksRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetFolderHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetFolderHealthResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListLinksRequest) GetFolderId() string {
+func (x *GetFolderHealthResponse) GetTotal() int32 {
 	if x != nil {
-		return x.FolderId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type ListLinksResponse struct {
+func (x *GetFolderHealthResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *GetFolderHealthResponse) GetUnchecked() int32 {
+	if x != nil {
+		return x.Unchecked
+	}
+	return 0
+}
+
+func (x *GetFolderHealthResponse) GetRedirected() int32 {
+	if x != nil {
+		return x.Redirected
+	}
+	return 0
+}
+
+func (x *GetFolderHealthResponse) GetBrokenLinks() []*Link {
+	if x != nil {
+		return x.BrokenLinks
+	}
+	return nil
+}
+
+type GetFolderBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLinksResponse) Reset() {
-	*x = ListLinksResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[6]
+func (x *GetFolderBudgetRequest) Reset() {
+	*x = GetFolderBudgetRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLinksResponse) String() string {
+func (x *GetFolderBudgetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLinksResponse) ProtoMessage() {}
+func (*GetFolderBudgetRequest) ProtoMessage() {}
 
-func (x *ListLinksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[6]
+func (x *GetFolderBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,56 +2955,46 @@ func (x *ListLinksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLinksResponse.ProtoReflect.Descriptor instead.
-func (*ListLinksResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetFolderBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListLinksResponse) GetLinks() []*Link {
+func (x *GetFolderBudgetRequest) GetFolderId() string {
 	if x != nil {
-		return x.Links
+		return x.FolderId
 	}
-	return nil
+	return ""
 }
 
-type UpdateLinkRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	LinkId          string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
-	FolderId        string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Url             string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Category        LinkCategory           `protobuf:"varint,6,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
-	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	AgeRange        string                 `protobuf:"bytes,8,opt,name=age_range,json=ageRange,proto3" json:"age_range,omitempty"`
-	Location        string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
-	Price           string                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl        string                 `protobuf:"bytes,11,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	EventDate       int64                  `protobuf:"varint,12,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
-	ReminderEnabled bool                   `protobuf:"varint,13,opt,name=reminder_enabled,json=reminderEnabled,proto3" json:"reminder_enabled,omitempty"`
-	Rating          int32                  `protobuf:"varint,14,opt,name=rating,proto3" json:"rating,omitempty"`
-	Ingredients     []string               `protobuf:"bytes,15,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	Favorite        bool                   `protobuf:"varint,16,opt,name=favorite,proto3" json:"favorite,omitempty"`
-	Visibility      string                 `protobuf:"bytes,17,opt,name=visibility,proto3" json:"visibility,omitempty"` // "private" | "public"
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Budget d'un dossier dans une devise, en centimes
+type BudgetTotal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalCents     int64                  `protobuf:"varint,2,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	SpentCents     int64                  `protobuf:"varint,3,opt,name=spent_cents,json=spentCents,proto3" json:"spent_cents,omitempty"`       // liens achetés
+	PlannedCents   int64                  `protobuf:"varint,4,opt,name=planned_cents,json=plannedCents,proto3" json:"planned_cents,omitempty"` // liens pas encore achetés
+	Count          int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	PurchasedCount int32                  `protobuf:"varint,6,opt,name=purchased_count,json=purchasedCount,proto3" json:"purchased_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateLinkRequest) Reset() {
-	*x = UpdateLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[7]
+func (x *BudgetTotal) Reset() {
+	*x = BudgetTotal{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLinkRequest) String() string {
+func (x *BudgetTotal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLinkRequest) ProtoMessage() {}
+func (*BudgetTotal) ProtoMessage() {}
 
-func (x *UpdateLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[7]
+func (x *BudgetTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,152 +3005,180 @@ func (x *UpdateLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLinkRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BudgetTotal.ProtoReflect.Descriptor instead.
+func (*BudgetTotal) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateLinkRequest) GetLinkId() string {
+func (x *BudgetTotal) GetCurrency() string {
 	if x != nil {
-		return x.LinkId
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *UpdateLinkRequest) GetFolderId() string {
+func (x *BudgetTotal) GetTotalCents() int64 {
 	if x != nil {
-		return x.FolderId
+		return x.TotalCents
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLinkRequest) GetTitle() string {
+func (x *BudgetTotal) GetSpentCents() int64 {
 	if x != nil {
-		return x.Title
+		return x.SpentCents
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLinkRequest) GetUrl() string {
+func (x *BudgetTotal) GetPlannedCents() int64 {
 	if x != nil {
-		return x.Url
+		return x.PlannedCents
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLinkRequest) GetDescription() string {
+func (x *BudgetTotal) GetCount() int32 {
 	if x != nil {
-		return x.Description
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLinkRequest) GetCategory() LinkCategory {
+func (x *BudgetTotal) GetPurchasedCount() int32 {
 	if x != nil {
-		return x.Category
+		return x.PurchasedCount
 	}
-	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateLinkRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+type GetFolderBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        []*BudgetTotal         `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`      // la devise la plus utilisée d'abord
+	Unpriced      int32                  `protobuf:"varint,2,opt,name=unpriced,proto3" json:"unpriced,omitempty"` // liens sans prix reconnu
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLinkRequest) GetAgeRange() string {
-	if x != nil {
-		return x.AgeRange
-	}
-	return ""
+func (x *GetFolderBudgetResponse) Reset() {
+	*x = GetFolderBudgetResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLinkRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+func (x *GetFolderBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateLinkRequest) GetPrice() string {
+func (*GetFolderBudgetResponse) ProtoMessage() {}
+
+func (x *GetFolderBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[40]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateLinkRequest) GetImageUrl() string {
+// Deprecated: Use GetFolderBudgetResponse.ProtoReflect.Descriptor instead.
+func (*GetFolderBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetFolderBudgetResponse) GetTotals() []*BudgetTotal {
 	if x != nil {
-		return x.ImageUrl
+		return x.Totals
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateLinkRequest) GetEventDate() int64 {
+func (x *GetFolderBudgetResponse) GetUnpriced() int32 {
 	if x != nil {
-		return x.EventDate
+		return x.Unpriced
 	}
 	return 0
 }
 
-func (x *UpdateLinkRequest) GetReminderEnabled() bool {
-	if x != nil {
-		return x.ReminderEnabled
-	}
-	return false
+// Suit le prix de la page d'un lien, d'après ses offres schema.org
+type WatchPriceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LinkId         string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	ThresholdCents int64                  `protobuf:"varint,2,opt,name=threshold_cents,json=thresholdCents,proto3" json:"threshold_cents,omitempty"` // 0 : prévenir à chaque baisse
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateLinkRequest) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+func (x *WatchPriceRequest) Reset() {
+	*x = WatchPriceRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLinkRequest) GetIngredients() []string {
+func (x *WatchPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPriceRequest) ProtoMessage() {}
+
+func (x *WatchPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[41]
 	if x != nil {
-		return x.Ingredients
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateLinkRequest) GetFavorite() bool {
+// Deprecated: Use WatchPriceRequest.ProtoReflect.Descriptor instead.
+func (*WatchPriceRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WatchPriceRequest) GetLinkId() string {
 	if x != nil {
-		return x.Favorite
+		return x.LinkId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateLinkRequest) GetVisibility() string {
+func (x *WatchPriceRequest) GetThresholdCents() int64 {
 	if x != nil {
-		return x.Visibility
+		return x.ThresholdCents
 	}
-	return ""
+	return 0
 }
 
-type UpdateLinkResponse struct {
+type WatchPriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Link          *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLinkResponse) Reset() {
-	*x = UpdateLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[8]
+func (x *WatchPriceResponse) Reset() {
+	*x = WatchPriceResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLinkResponse) String() string {
+func (x *WatchPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLinkResponse) ProtoMessage() {}
+func (*WatchPriceResponse) ProtoMessage() {}
 
-func (x *UpdateLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[8]
+func (x *WatchPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,40 +3189,40 @@ func (x *UpdateLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLinkResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use WatchPriceResponse.ProtoReflect.Descriptor instead.
+func (*WatchPriceResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateLinkResponse) GetLink() *Link {
+func (x *WatchPriceResponse) GetLink() *Link {
 	if x != nil {
 		return x.Link
 	}
 	return nil
 }
 
-type DeleteLinkRequest struct {
+type UnwatchPriceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLinkRequest) Reset() {
-	*x = DeleteLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[9]
+func (x *UnwatchPriceRequest) Reset() {
+	*x = UnwatchPriceRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLinkRequest) String() string {
+func (x *UnwatchPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLinkRequest) ProtoMessage() {}
+func (*UnwatchPriceRequest) ProtoMessage() {}
 
-func (x *DeleteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[9]
+func (x *UnwatchPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,39 +3233,39 @@ func (x *DeleteLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLinkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UnwatchPriceRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchPriceRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteLinkRequest) GetLinkId() string {
+func (x *UnwatchPriceRequest) GetLinkId() string {
 	if x != nil {
 		return x.LinkId
 	}
 	return ""
 }
 
-type DeleteLinkResponse struct {
+type UnwatchPriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLinkResponse) Reset() {
-	*x = DeleteLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[10]
+func (x *UnwatchPriceResponse) Reset() {
+	*x = UnwatchPriceResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLinkResponse) String() string {
+func (x *UnwatchPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLinkResponse) ProtoMessage() {}
-
-func (x *DeleteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[10]
+func (*UnwatchPriceResponse) ProtoMessage() {}
+
+func (x *UnwatchPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,33 +3276,33 @@ func (x *DeleteLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLinkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UnwatchPriceResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchPriceResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{44}
 }
 
-type LikeLinkRequest struct {
+type GetPriceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeLinkRequest) Reset() {
-	*x = LikeLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[11]
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeLinkRequest) String() string {
+func (x *GetPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeLinkRequest) ProtoMessage() {}
+func (*GetPriceHistoryRequest) ProtoMessage() {}
 
-func (x *LikeLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[11]
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,40 +3313,41 @@ func (x *LikeLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeLinkRequest.ProtoReflect.Descriptor instead.
-func (*LikeLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *LikeLinkRequest) GetLinkId() string {
+func (x *GetPriceHistoryRequest) GetLinkId() string {
 	if x != nil {
 		return x.LinkId
 	}
 	return ""
 }
 
-type LikeLinkResponse struct {
+type PricePoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LikeCount     int32                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Price         *Money                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	ObservedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeLinkResponse) Reset() {
-	*x = LikeLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[12]
+func (x *PricePoint) Reset() {
+	*x = PricePoint{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeLinkResponse) String() string {
+func (x *PricePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeLinkResponse) ProtoMessage() {}
+func (*PricePoint) ProtoMessage() {}
 
-func (x *LikeLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[12]
+func (x *PricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,40 +3358,47 @@ func (x *LikeLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeLinkResponse.ProtoReflect.Descriptor instead.
-func (*LikeLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *LikeLinkResponse) GetLikeCount() int32 {
+func (x *PricePoint) GetPrice() *Money {
 	if x != nil {
-		return x.LikeCount
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
-type UnlikeLinkRequest struct {
+func (x *PricePoint) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+type GetPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Points        []*PricePoint          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // changements de prix, du plus ancien au plus récent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeLinkRequest) Reset() {
-	*x = UnlikeLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[13]
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeLinkRequest) String() string {
+func (x *GetPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeLinkRequest) ProtoMessage() {}
+func (*GetPriceHistoryResponse) ProtoMessage() {}
 
-func (x *UnlikeLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[13]
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,40 +3409,43 @@ func (x *UnlikeLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeLinkRequest.ProtoReflect.Descriptor instead.
-func (*UnlikeLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UnlikeLinkRequest) GetLinkId() string {
+func (x *GetPriceHistoryResponse) GetPoints() []*PricePoint {
 	if x != nil {
-		return x.LinkId
+		return x.Points
 	}
-	return ""
+	return nil
 }
 
-type UnlikeLinkResponse struct {
+type ScaleRecipeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LikeCount     int32                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Servings      int32                  `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`                             // nombre de parts voulu
+	BaseServings  int32                  `protobuf:"varint,3,opt,name=base_servings,json=baseServings,proto3" json:"base_servings,omitempty"` // parts de la recette d'origine, si la fiche ne le donne pas
+	Metric        bool                   `protobuf:"varint,4,opt,name=metric,proto3" json:"metric,omitempty"`                                 // convertir cups, oz, lb… en g et ml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeLinkResponse) Reset() {
-	*x = UnlikeLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[14]
+func (x *ScaleRecipeRequest) Reset() {
+	*x = ScaleRecipeRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeLinkResponse) String() string {
+func (x *ScaleRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeLinkResponse) ProtoMessage() {}
+func (*ScaleRecipeRequest) ProtoMessage() {}
 
-func (x *UnlikeLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[14]
+func (x *ScaleRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,40 +3456,63 @@ func (x *UnlikeLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeLinkResponse.ProtoReflect.Descriptor instead.
-func (*UnlikeLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ScaleRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ScaleRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UnlikeLinkResponse) GetLikeCount() int32 {
+func (x *ScaleRecipeRequest) GetLinkId() string {
 	if x != nil {
-		return x.LikeCount
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ScaleRecipeRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
 	}
 	return 0
 }
 
-type ToggleFavoriteLinkRequest struct {
+func (x *ScaleRecipeRequest) GetBaseServings() int32 {
+	if x != nil {
+		return x.BaseServings
+	}
+	return 0
+}
+
+func (x *ScaleRecipeRequest) GetMetric() bool {
+	if x != nil {
+		return x.Metric
+	}
+	return false
+}
+
+type ScaleRecipeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Ingredients   []*Ingredient          `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Servings      int32                  `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`
+	BaseServings  int32                  `protobuf:"varint,3,opt,name=base_servings,json=baseServings,proto3" json:"base_servings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleFavoriteLinkRequest) Reset() {
-	*x = ToggleFavoriteLinkRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[15]
+func (x *ScaleRecipeResponse) Reset() {
+	*x = ScaleRecipeResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleFavoriteLinkRequest) String() string {
+func (x *ScaleRecipeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFavoriteLinkRequest) ProtoMessage() {}
+func (*ScaleRecipeResponse) ProtoMessage() {}
 
-func (x *ToggleFavoriteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[15]
+func (x *ScaleRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,40 +3523,62 @@ func (x *ToggleFavoriteLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFavoriteLinkRequest.ProtoReflect.Descriptor instead.
-func (*ToggleFavoriteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ScaleRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ScaleRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ToggleFavoriteLinkRequest) GetLinkId() string {
+func (x *ScaleRecipeResponse) GetIngredients() []*Ingredient {
 	if x != nil {
-		return x.LinkId
+		return x.Ingredients
 	}
-	return ""
+	return nil
 }
 
-type ToggleFavoriteLinkResponse struct {
+func (x *ScaleRecipeResponse) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *ScaleRecipeResponse) GetBaseServings() int32 {
+	if x != nil {
+		return x.BaseServings
+	}
+	return 0
+}
+
+// Recherche autour d'un point, parmi ses liens, ceux de ses dossiers partagés
+// et ceux de la communauté. Le centre est donné par ses coordonnées ou par un
+// lieu en texte libre ("Lyon", "13100").
+type SearchNearbyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Favorite      bool                   `protobuf:"varint,1,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Place         string                 `protobuf:"bytes,3,opt,name=place,proto3" json:"place,omitempty"`                         // remplace latitude et longitude s'il est renseigné
+	RadiusKm      float64                `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"` // 20 par défaut, 200 au plus
+	Category      LinkCategory           `protobuf:"varint,5,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // 50 par défaut, 200 au plus
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleFavoriteLinkResponse) Reset() {
-	*x = ToggleFavoriteLinkResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[16]
+func (x *SearchNearbyRequest) Reset() {
+	*x = SearchNearbyRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleFavoriteLinkResponse) String() string {
+func (x *SearchNearbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFavoriteLinkResponse) ProtoMessage() {}
+func (*SearchNearbyRequest) ProtoMessage() {}
 
-func (x *ToggleFavoriteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[16]
+func (x *SearchNearbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,41 +3589,76 @@ func (x *ToggleFavoriteLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFavoriteLinkResponse.ProtoReflect.Descriptor instead.
-func (*ToggleFavoriteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SearchNearbyRequest.ProtoReflect.Descriptor instead.
+func (*SearchNearbyRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ToggleFavoriteLinkResponse) GetFavorite() bool {
+func (x *SearchNearbyRequest) GetLatitude() float64 {
 	if x != nil {
-		return x.Favorite
+		return x.Latitude
 	}
-	return false
+	return 0
 }
 
-type ListCommunityLinksRequest struct {
+func (x *SearchNearbyRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchNearbyRequest) GetPlace() string {
+	if x != nil {
+		return x.Place
+	}
+	return ""
+}
+
+func (x *SearchNearbyRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *SearchNearbyRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *SearchNearbyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // optionnel, filtre par catégorie
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`      // max par catégorie, défaut 6
+	Link          *Link                  `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunityLinksRequest) Reset() {
-	*x = ListCommunityLinksRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[17]
+func (x *NearbyLink) Reset() {
+	*x = NearbyLink{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunityLinksRequest) String() string {
+func (x *NearbyLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunityLinksRequest) ProtoMessage() {}
+func (*NearbyLink) ProtoMessage() {}
 
-func (x *ListCommunityLinksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[17]
+func (x *NearbyLink) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,47 +3669,48 @@ func (x *ListCommunityLinksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunityLinksRequest.ProtoReflect.Descriptor instead.
-func (*ListCommunityLinksRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use NearbyLink.ProtoReflect.Descriptor instead.
+func (*NearbyLink) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListCommunityLinksRequest) GetCategory() string {
+func (x *NearbyLink) GetLink() *Link {
 	if x != nil {
-		return x.Category
+		return x.Link
 	}
-	return ""
+	return nil
 }
 
-func (x *ListCommunityLinksRequest) GetLimit() int32 {
+func (x *NearbyLink) GetDistanceKm() float64 {
 	if x != nil {
-		return x.Limit
+		return x.DistanceKm
 	}
 	return 0
 }
 
-type ListCommunityLinksResponse struct {
+type SearchNearbyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	Results       []*NearbyLink          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // du plus proche au plus lointain
+	Center        *GeoPoint              `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunityLinksResponse) Reset() {
-	*x = ListCommunityLinksResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[18]
+func (x *SearchNearbyResponse) Reset() {
+	*x = SearchNearbyResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunityLinksResponse) String() string {
+func (x *SearchNearbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunityLinksResponse) ProtoMessage() {}
+func (*SearchNearbyResponse) ProtoMessage() {}
 
-func (x *ListCommunityLinksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[18]
+func (x *SearchNearbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,40 +3721,50 @@ func (x *ListCommunityLinksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunityLinksResponse.ProtoReflect.Descriptor instead.
-func (*ListCommunityLinksResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SearchNearbyResponse.ProtoReflect.Descriptor instead.
+func (*SearchNearbyResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListCommunityLinksResponse) GetLinks() []*Link {
+func (x *SearchNearbyResponse) GetResults() []*NearbyLink {
 	if x != nil {
-		return x.Links
+		return x.Results
 	}
 	return nil
 }
 
-type ListNewLinksRequest struct {
+func (x *SearchNearbyResponse) GetCenter() *GeoPoint {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+// Liens publics de la communauté adaptés à l'âge actuel d'un enfant
+type RecommendForChildRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // nombre de nouveautés, défaut 10
+	ChildId       string                 `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	Category      LinkCategory           `protobuf:"varint,2,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 20 par défaut, 100 au plus
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNewLinksRequest) Reset() {
-	*x = ListNewLinksRequest{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[19]
+func (x *RecommendForChildRequest) Reset() {
+	*x = RecommendForChildRequest{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNewLinksRequest) String() string {
+func (x *RecommendForChildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNewLinksRequest) ProtoMessage() {}
+func (*RecommendForChildRequest) ProtoMessage() {}
 
-func (x *ListNewLinksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[19]
+func (x *RecommendForChildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,40 +3775,55 @@ func (x *ListNewLinksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNewLinksRequest.ProtoReflect.Descriptor instead.
-func (*ListNewLinksRequest) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RecommendForChildRequest.ProtoReflect.Descriptor instead.
+func (*RecommendForChildRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListNewLinksRequest) GetLimit() int32 {
+func (x *RecommendForChildRequest) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *RecommendForChildRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *RecommendForChildRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type ListNewLinksResponse struct {
+type RecommendForChildResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	Links         []*Link                `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`                           // les mieux notés d'abord
+	AgeMonths     int32                  `protobuf:"varint,2,opt,name=age_months,json=ageMonths,proto3" json:"age_months,omitempty"` // âge de l'enfant retenu
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNewLinksResponse) Reset() {
-	*x = ListNewLinksResponse{}
-	mi := &file_tribbae_v1_link_proto_msgTypes[20]
+func (x *RecommendForChildResponse) Reset() {
+	*x = RecommendForChildResponse{}
+	mi := &file_tribbae_v1_link_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNewLinksResponse) String() string {
+func (x *RecommendForChildResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNewLinksResponse) ProtoMessage() {}
+func (*RecommendForChildResponse) ProtoMessage() {}
 
-func (x *ListNewLinksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tribbae_v1_link_proto_msgTypes[20]
+func (x *RecommendForChildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_link_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,24 +3834,86 @@ func (x *ListNewLinksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNewLinksResponse.ProtoReflect.Descriptor instead.
-func (*ListNewLinksResponse) Descriptor() ([]byte, []int) {
-	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RecommendForChildResponse.ProtoReflect.Descriptor instead.
+func (*RecommendForChildResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_link_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListNewLinksResponse) GetLinks() []*Link {
+func (x *RecommendForChildResponse) GetLinks() []*Link {
 	if x != nil {
 		return x.Links
 	}
 	return nil
 }
 
+func (x *RecommendForChildResponse) GetAgeMonths() int32 {
+	if x != nil {
+		return x.AgeMonths
+	}
+	return 0
+}
+
 var File_tribbae_v1_link_proto protoreflect.FileDescriptor
 
 const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\n" +
 	"\x15tribbae/v1/link.proto\x12\n" +
-	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x06\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x01\n" +
+	"\n" +
+	"LinkHealth\x12\x1f\n" +
+	"\vhttp_status\x18\x01 \x01(\x05R\n" +
+	"httpStatus\x12\x1b\n" +
+	"\tfinal_url\x18\x02 \x01(\tR\bfinalUrl\x129\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\x16\n" +
+	"\x06broken\x18\x04 \x01(\bR\x06broken\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xfe\x01\n" +
+	"\x06Recipe\x12\"\n" +
+	"\finstructions\x18\x01 \x03(\tR\finstructions\x12\x14\n" +
+	"\x05yield\x18\x02 \x01(\tR\x05yield\x12\x1a\n" +
+	"\bservings\x18\x03 \x01(\x05R\bservings\x12!\n" +
+	"\fprep_minutes\x18\x04 \x01(\x05R\vprepMinutes\x12!\n" +
+	"\fcook_minutes\x18\x05 \x01(\x05R\vcookMinutes\x12#\n" +
+	"\rtotal_minutes\x18\x06 \x01(\x05R\ftotalMinutes\x123\n" +
+	"\tnutrition\x18\a \x01(\v2\x15.tribbae.v1.NutritionR\tnutrition\"\x83\x02\n" +
+	"\tNutrition\x12!\n" +
+	"\fserving_size\x18\x01 \x01(\tR\vservingSize\x12\x1a\n" +
+	"\bcalories\x18\x02 \x01(\tR\bcalories\x12\x10\n" +
+	"\x03fat\x18\x03 \x01(\tR\x03fat\x12#\n" +
+	"\rsaturated_fat\x18\x04 \x01(\tR\fsaturatedFat\x12\"\n" +
+	"\fcarbohydrate\x18\x05 \x01(\tR\fcarbohydrate\x12\x14\n" +
+	"\x05sugar\x18\x06 \x01(\tR\x05sugar\x12\x14\n" +
+	"\x05fiber\x18\a \x01(\tR\x05fiber\x12\x18\n" +
+	"\aprotein\x18\b \x01(\tR\aprotein\x12\x16\n" +
+	"\x06sodium\x18\t \x01(\tR\x06sodium\"\x99\x01\n" +
+	"\n" +
+	"Ingredient\x12\x10\n" +
+	"\x03raw\x18\x01 \x01(\tR\x03raw\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12!\n" +
+	"\fquantity_max\x18\x03 \x01(\x01R\vquantityMax\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04item\x18\x05 \x01(\tR\x04item\x12\x12\n" +
+	"\x04text\x18\x06 \x01(\tR\x04text\"I\n" +
+	"\tAgeBounds\x12\x1d\n" +
+	"\n" +
+	"min_months\x18\x01 \x01(\x05R\tminMonths\x12\x1d\n" +
+	"\n" +
+	"max_months\x18\x02 \x01(\x05R\tmaxMonths\"F\n" +
+	"\x05Money\x12!\n" +
+	"\famount_cents\x18\x01 \x01(\x03R\vamountCents\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xf1\x01\n" +
+	"\n" +
+	"PriceWatch\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fthreshold_cents\x18\x02 \x01(\x03R\x0ethresholdCents\x12+\n" +
+	"\acurrent\x18\x03 \x01(\v2\x11.tribbae.v1.MoneyR\acurrent\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xd3\t\n" +
 	"\x04Link\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
@@ -1480,7 +3945,18 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\x0eowner_is_admin\x18\x17 \x01(\bR\fownerIsAdmin\x12\x1e\n" +
 	"\n" +
 	"visibility\x18\x18 \x01(\tR\n" +
-	"visibility\"\xf0\x03\n" +
+	"visibility\x12\x12\n" +
+	"\x04etag\x18\x19 \x01(\tR\x04etag\x12#\n" +
+	"\rcanonical_url\x18\x1a \x01(\tR\fcanonicalUrl\x12.\n" +
+	"\x06health\x18\x1b \x01(\v2\x16.tribbae.v1.LinkHealthR\x06health\x12*\n" +
+	"\x06recipe\x18\x1c \x01(\v2\x12.tribbae.v1.RecipeR\x06recipe\x12E\n" +
+	"\x12parsed_ingredients\x18\x1d \x03(\v2\x16.tribbae.v1.IngredientR\x11parsedIngredients\x12&\n" +
+	"\x03geo\x18\x1e \x01(\v2\x14.tribbae.v1.GeoPointR\x03geo\x12)\n" +
+	"\x04ages\x18\x1f \x01(\v2\x15.tribbae.v1.AgeBoundsR\x04ages\x124\n" +
+	"\fprice_amount\x18  \x01(\v2\x11.tribbae.v1.MoneyR\vpriceAmount\x12\x1c\n" +
+	"\tpurchased\x18! \x01(\bR\tpurchased\x127\n" +
+	"\vprice_watch\x18\" \x01(\v2\x16.tribbae.v1.PriceWatchR\n" +
+	"priceWatch\"\xb7\x04\n" +
 	"\x11CreateLinkRequest\x12\x1b\n" +
 	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
@@ -1501,17 +3977,50 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\bfavorite\x18\x0f \x01(\bR\bfavorite\x12\x1e\n" +
 	"\n" +
 	"visibility\x18\x10 \x01(\tR\n" +
-	"visibility\":\n" +
+	"visibility\x12'\n" +
+	"\x0fallow_duplicate\x18\x11 \x01(\bR\x0eallowDuplicate\x12\x1c\n" +
+	"\tpurchased\x18\x12 \x01(\bR\tpurchased\"h\n" +
 	"\x12CreateLinkResponse\x12$\n" +
-	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\")\n" +
+	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\x12,\n" +
+	"\x12duplicate_link_ids\x18\x02 \x03(\tR\x10duplicateLinkIds\")\n" +
 	"\x0eGetLinkRequest\x12\x17\n" +
 	"\alink_id\x18\x01 \x01(\tR\x06linkId\"7\n" +
 	"\x0fGetLinkResponse\x12$\n" +
-	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\"/\n" +
+	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\"\x89\x06\n" +
 	"\x10ListLinksRequest\x12\x1b\n" +
-	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\";\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x124\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x18.tribbae.v1.LinkCategoryR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12$\n" +
+	"\x0ematch_all_tags\x18\x04 \x01(\bR\fmatchAllTags\x12%\n" +
+	"\x0efavorites_only\x18\x05 \x01(\bR\rfavoritesOnly\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\x06 \x01(\x05R\tminRating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\a \x01(\x05R\tmaxRating\x12;\n" +
+	"\vevent_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"eventAfter\x12=\n" +
+	"\fevent_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\veventBefore\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibility\x12\x19\n" +
+	"\bowner_id\x18\v \x01(\tR\aownerId\x122\n" +
+	"\asort_by\x18\f \x01(\x0e2\x19.tribbae.v1.LinkSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\r \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\x0e \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x0f \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vbroken_only\x18\x10 \x01(\bR\n" +
+	"brokenOnly\x12/\n" +
+	"\x14suitable_for_my_kids\x18\x11 \x01(\bR\x11suitableForMyKids\x12&\n" +
+	"\x0fmin_price_cents\x18\x12 \x01(\x03R\rminPriceCents\x12&\n" +
+	"\x0fmax_price_cents\x18\x13 \x01(\x03R\rmaxPriceCents\x12\x1a\n" +
+	"\bcurrency\x18\x14 \x01(\tR\bcurrency\"c\n" +
 	"\x11ListLinksResponse\x12&\n" +
-	"\x05links\x18\x01 \x03(\v2\x10.tribbae.v1.LinkR\x05links\"\x89\x04\n" +
+	"\x05links\x18\x01 \x03(\v2\x10.tribbae.v1.LinkR\x05links\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa1\x05\n" +
 	"\x11UpdateLinkRequest\x12\x17\n" +
 	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1b\n" +
 	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12\x14\n" +
@@ -1533,9 +4042,15 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\bfavorite\x18\x10 \x01(\bR\bfavorite\x12\x1e\n" +
 	"\n" +
 	"visibility\x18\x11 \x01(\tR\n" +
-	"visibility\":\n" +
+	"visibility\x12;\n" +
+	"\vupdate_mask\x18\x12 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x13 \x01(\tR\x04etag\x12'\n" +
+	"\x0fallow_duplicate\x18\x14 \x01(\bR\x0eallowDuplicate\x12\x1c\n" +
+	"\tpurchased\x18\x15 \x01(\bR\tpurchased\"h\n" +
 	"\x12UpdateLinkResponse\x12$\n" +
-	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\",\n" +
+	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\x12,\n" +
+	"\x12duplicate_link_ids\x18\x02 \x03(\tR\x10duplicateLinkIds\",\n" +
 	"\x11DeleteLinkRequest\x12\x17\n" +
 	"\alink_id\x18\x01 \x01(\tR\x06linkId\"\x14\n" +
 	"\x12DeleteLinkResponse\"*\n" +
@@ -1561,7 +4076,107 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\x13ListNewLinksRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\">\n" +
 	"\x14ListNewLinksResponse\x12&\n" +
-	"\x05links\x18\x01 \x03(\v2\x10.tribbae.v1.LinkR\x05links*\xea\x01\n" +
+	"\x05links\x18\x01 \x03(\v2\x10.tribbae.v1.LinkR\x05links\"r\n" +
+	"\x0fBatchLinkResult\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xa8\x01\n" +
+	"\x17BatchUpdateLinksRequest\x12\x19\n" +
+	"\blink_ids\x18\x01 \x03(\tR\alinkIds\x12\x19\n" +
+	"\badd_tags\x18\x02 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x03 \x03(\tR\n" +
+	"removeTags\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomic\"Q\n" +
+	"\x18BatchUpdateLinksResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.tribbae.v1.BatchLinkResultR\aresults\"t\n" +
+	"\x15BatchMoveLinksRequest\x12\x19\n" +
+	"\blink_ids\x18\x01 \x03(\tR\alinkIds\x12(\n" +
+	"\x10target_folder_id\x18\x02 \x01(\tR\x0etargetFolderId\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"O\n" +
+	"\x16BatchMoveLinksResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.tribbae.v1.BatchLinkResultR\aresults\"L\n" +
+	"\x17BatchDeleteLinksRequest\x12\x19\n" +
+	"\blink_ids\x18\x01 \x03(\tR\alinkIds\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"Q\n" +
+	"\x18BatchDeleteLinksResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.tribbae.v1.BatchLinkResultR\aresults\"5\n" +
+	"\x16GetFolderHealthRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\xbc\x01\n" +
+	"\x17GetFolderHealthResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x05R\achecked\x12\x1c\n" +
+	"\tunchecked\x18\x03 \x01(\x05R\tunchecked\x12\x1e\n" +
+	"\n" +
+	"redirected\x18\x04 \x01(\x05R\n" +
+	"redirected\x123\n" +
+	"\fbroken_links\x18\x05 \x03(\v2\x10.tribbae.v1.LinkR\vbrokenLinks\"5\n" +
+	"\x16GetFolderBudgetRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\xcf\x01\n" +
+	"\vBudgetTotal\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vtotal_cents\x18\x02 \x01(\x03R\n" +
+	"totalCents\x12\x1f\n" +
+	"\vspent_cents\x18\x03 \x01(\x03R\n" +
+	"spentCents\x12#\n" +
+	"\rplanned_cents\x18\x04 \x01(\x03R\fplannedCents\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12'\n" +
+	"\x0fpurchased_count\x18\x06 \x01(\x05R\x0epurchasedCount\"f\n" +
+	"\x17GetFolderBudgetResponse\x12/\n" +
+	"\x06totals\x18\x01 \x03(\v2\x17.tribbae.v1.BudgetTotalR\x06totals\x12\x1a\n" +
+	"\bunpriced\x18\x02 \x01(\x05R\bunpriced\"U\n" +
+	"\x11WatchPriceRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12'\n" +
+	"\x0fthreshold_cents\x18\x02 \x01(\x03R\x0ethresholdCents\":\n" +
+	"\x12WatchPriceResponse\x12$\n" +
+	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\".\n" +
+	"\x13UnwatchPriceRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\"\x16\n" +
+	"\x14UnwatchPriceResponse\"1\n" +
+	"\x16GetPriceHistoryRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\"r\n" +
+	"\n" +
+	"PricePoint\x12'\n" +
+	"\x05price\x18\x01 \x01(\v2\x11.tribbae.v1.MoneyR\x05price\x12;\n" +
+	"\vobserved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\"I\n" +
+	"\x17GetPriceHistoryResponse\x12.\n" +
+	"\x06points\x18\x01 \x03(\v2\x16.tribbae.v1.PricePointR\x06points\"\x86\x01\n" +
+	"\x12ScaleRecipeRequest\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\x05R\bservings\x12#\n" +
+	"\rbase_servings\x18\x03 \x01(\x05R\fbaseServings\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\bR\x06metric\"\x90\x01\n" +
+	"\x13ScaleRecipeResponse\x128\n" +
+	"\vingredients\x18\x01 \x03(\v2\x16.tribbae.v1.IngredientR\vingredients\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\x05R\bservings\x12#\n" +
+	"\rbase_servings\x18\x03 \x01(\x05R\fbaseServings\"\xce\x01\n" +
+	"\x13SearchNearbyRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05place\x18\x03 \x01(\tR\x05place\x12\x1b\n" +
+	"\tradius_km\x18\x04 \x01(\x01R\bradiusKm\x124\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x18.tribbae.v1.LinkCategoryR\bcategory\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"S\n" +
+	"\n" +
+	"NearbyLink\x12$\n" +
+	"\x04link\x18\x01 \x01(\v2\x10.tribbae.v1.LinkR\x04link\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"v\n" +
+	"\x14SearchNearbyResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.tribbae.v1.NearbyLinkR\aresults\x12,\n" +
+	"\x06center\x18\x02 \x01(\v2\x14.tribbae.v1.GeoPointR\x06center\"\x81\x01\n" +
+	"\x18RecommendForChildRequest\x12\x19\n" +
+	"\bchild_id\x18\x01 \x01(\tR\achildId\x124\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x18.tribbae.v1.LinkCategoryR\bcategory\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"b\n" +
+	"\x19RecommendForChildResponse\x12&\n" +
+	"\x05links\x18\x01 \x03(\v2\x10.tribbae.v1.LinkR\x05links\x12\x1d\n" +
+	"\n" +
+	"age_months\x18\x02 \x01(\x05R\tageMonths*\xea\x01\n" +
 	"\fLinkCategory\x12\x1d\n" +
 	"\x19LINK_CATEGORY_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12LINK_CATEGORY_IDEE\x10\x01\x12\x18\n" +
@@ -1570,7 +4185,14 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\x17LINK_CATEGORY_EVENEMENT\x10\x04\x12\x19\n" +
 	"\x15LINK_CATEGORY_RECETTE\x10\x05\x12\x17\n" +
 	"\x13LINK_CATEGORY_LIVRE\x10\x06\x12\x1c\n" +
-	"\x18LINK_CATEGORY_DECORATION\x10\a2\xe0\b\n" +
+	"\x18LINK_CATEGORY_DECORATION\x10\a*\xc7\x01\n" +
+	"\rLinkSortField\x12\x1f\n" +
+	"\x1bLINK_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aLINK_SORT_FIELD_CREATED_AT\x10\x01\x12\x1e\n" +
+	"\x1aLINK_SORT_FIELD_UPDATED_AT\x10\x02\x12\x1a\n" +
+	"\x16LINK_SORT_FIELD_RATING\x10\x03\x12\x1e\n" +
+	"\x1aLINK_SORT_FIELD_EVENT_DATE\x10\x04\x12\x19\n" +
+	"\x15LINK_SORT_FIELD_TITLE\x10\x052\xdc\x13\n" +
 	"\vLinkService\x12a\n" +
 	"\n" +
 	"CreateLink\x12\x1d.tribbae.v1.CreateLinkRequest\x1a\x1e.tribbae.v1.CreateLinkResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/links\x12_\n" +
@@ -1579,7 +4201,19 @@ const file_tribbae_v1_link_proto_rawDesc = "" +
 	"\n" +
 	"UpdateLink\x12\x1d.tribbae.v1.UpdateLinkRequest\x1a\x1e.tribbae.v1.UpdateLinkResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/links/{link_id}\x12h\n" +
 	"\n" +
-	"DeleteLink\x12\x1d.tribbae.v1.DeleteLinkRequest\x1a\x1e.tribbae.v1.DeleteLinkResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/links/{link_id}\x12j\n" +
+	"DeleteLink\x12\x1d.tribbae.v1.DeleteLinkRequest\x1a\x1e.tribbae.v1.DeleteLinkResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/links/{link_id}\x12\x7f\n" +
+	"\x10BatchUpdateLinks\x12#.tribbae.v1.BatchUpdateLinksRequest\x1a$.tribbae.v1.BatchUpdateLinksResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/links:batchUpdate\x12w\n" +
+	"\x0eBatchMoveLinks\x12!.tribbae.v1.BatchMoveLinksRequest\x1a\".tribbae.v1.BatchMoveLinksResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/links:batchMove\x12\x7f\n" +
+	"\x10BatchDeleteLinks\x12#.tribbae.v1.BatchDeleteLinksRequest\x1a$.tribbae.v1.BatchDeleteLinksResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/links:batchDelete\x12\x82\x01\n" +
+	"\x0fGetFolderHealth\x12\".tribbae.v1.GetFolderHealthRequest\x1a#.tribbae.v1.GetFolderHealthResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/folders/{folder_id}/health\x12\x82\x01\n" +
+	"\x0fGetFolderBudget\x12\".tribbae.v1.GetFolderBudgetRequest\x1a#.tribbae.v1.GetFolderBudgetResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/folders/{folder_id}/budget\x12w\n" +
+	"\n" +
+	"WatchPrice\x12\x1d.tribbae.v1.WatchPriceRequest\x1a\x1e.tribbae.v1.WatchPriceResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/links/{link_id}/price-watch\x12z\n" +
+	"\fUnwatchPrice\x12\x1f.tribbae.v1.UnwatchPriceRequest\x1a .tribbae.v1.UnwatchPriceResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/links/{link_id}/price-watch\x12\x85\x01\n" +
+	"\x0fGetPriceHistory\x12\".tribbae.v1.GetPriceHistoryRequest\x1a#.tribbae.v1.GetPriceHistoryResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/links/{link_id}/price-history\x12q\n" +
+	"\vScaleRecipe\x12\x1e.tribbae.v1.ScaleRecipeRequest\x1a\x1f.tribbae.v1.ScaleRecipeResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/links/{link_id}/scale\x12q\n" +
+	"\fSearchNearby\x12\x1f.tribbae.v1.SearchNearbyRequest\x1a .tribbae.v1.SearchNearbyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/links:searchNearby\x12\x91\x01\n" +
+	"\x11RecommendForChild\x12$.tribbae.v1.RecommendForChildRequest\x1a%.tribbae.v1.RecommendForChildResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/children/{child_id}/recommendations\x12j\n" +
 	"\bLikeLink\x12\x1b.tribbae.v1.LikeLinkRequest\x1a\x1c.tribbae.v1.LikeLinkResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/links/{link_id}/like\x12m\n" +
 	"\n" +
 	"UnlikeLink\x12\x1d.tribbae.v1.UnlikeLinkRequest\x1a\x1e.tribbae.v1.UnlikeLinkResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/links/{link_id}/like\x12\x8c\x01\n" +
@@ -1599,70 +4233,161 @@ func file_tribbae_v1_link_proto_rawDescGZIP() []byte {
 	return file_tribbae_v1_link_proto_rawDescData
 }
 
-var file_tribbae_v1_link_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_tribbae_v1_link_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_tribbae_v1_link_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tribbae_v1_link_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_tribbae_v1_link_proto_goTypes = []any{
 	(LinkCategory)(0),                  // 0: tribbae.v1.LinkCategory
-	(*Link)(nil),                       // 1: tribbae.v1.Link
-	(*CreateLinkRequest)(nil),          // 2: tribbae.v1.CreateLinkRequest
-	(*CreateLinkResponse)(nil),         // 3: tribbae.v1.CreateLinkResponse
-	(*GetLinkRequest)(nil),             // 4: tribbae.v1.GetLinkRequest
-	(*GetLinkResponse)(nil),            // 5: tribbae.v1.GetLinkResponse
-	(*ListLinksRequest)(nil),           // 6: tribbae.v1.ListLinksRequest
-	(*ListLinksResponse)(nil),          // 7: tribbae.v1.ListLinksResponse
-	(*UpdateLinkRequest)(nil),          // 8: tribbae.v1.UpdateLinkRequest
-	(*UpdateLinkResponse)(nil),         // 9: tribbae.v1.UpdateLinkResponse
-	(*DeleteLinkRequest)(nil),          // 10: tribbae.v1.DeleteLinkRequest
-	(*DeleteLinkResponse)(nil),         // 11: tribbae.v1.DeleteLinkResponse
-	(*LikeLinkRequest)(nil),            // 12: tribbae.v1.LikeLinkRequest
-	(*LikeLinkResponse)(nil),           // 13: tribbae.v1.LikeLinkResponse
-	(*UnlikeLinkRequest)(nil),          // 14: tribbae.v1.UnlikeLinkRequest
-	(*UnlikeLinkResponse)(nil),         // 15: tribbae.v1.UnlikeLinkResponse
-	(*ToggleFavoriteLinkRequest)(nil),  // 16: tribbae.v1.ToggleFavoriteLinkRequest
-	(*ToggleFavoriteLinkResponse)(nil), // 17: tribbae.v1.ToggleFavoriteLinkResponse
-	(*ListCommunityLinksRequest)(nil),  // 18: tribbae.v1.ListCommunityLinksRequest
-	(*ListCommunityLinksResponse)(nil), // 19: tribbae.v1.ListCommunityLinksResponse
-	(*ListNewLinksRequest)(nil),        // 20: tribbae.v1.ListNewLinksRequest
-	(*ListNewLinksResponse)(nil),       // 21: tribbae.v1.ListNewLinksResponse
-	(*timestamppb.Timestamp)(nil),      // 22: google.protobuf.Timestamp
+	(LinkSortField)(0),                 // 1: tribbae.v1.LinkSortField
+	(*LinkHealth)(nil),                 // 2: tribbae.v1.LinkHealth
+	(*Recipe)(nil),                     // 3: tribbae.v1.Recipe
+	(*Nutrition)(nil),                  // 4: tribbae.v1.Nutrition
+	(*Ingredient)(nil),                 // 5: tribbae.v1.Ingredient
+	(*AgeBounds)(nil),                  // 6: tribbae.v1.AgeBounds
+	(*Money)(nil),                      // 7: tribbae.v1.Money
+	(*PriceWatch)(nil),                 // 8: tribbae.v1.PriceWatch
+	(*GeoPoint)(nil),                   // 9: tribbae.v1.GeoPoint
+	(*Link)(nil),                       // 10: tribbae.v1.Link
+	(*CreateLinkRequest)(nil),          // 11: tribbae.v1.CreateLinkRequest
+	(*CreateLinkResponse)(nil),         // 12: tribbae.v1.CreateLinkResponse
+	(*GetLinkRequest)(nil),             // 13: tribbae.v1.GetLinkRequest
+	(*GetLinkResponse)(nil),            // 14: tribbae.v1.GetLinkResponse
+	(*ListLinksRequest)(nil),           // 15: tribbae.v1.ListLinksRequest
+	(*ListLinksResponse)(nil),          // 16: tribbae.v1.ListLinksResponse
+	(*UpdateLinkRequest)(nil),          // 17: tribbae.v1.UpdateLinkRequest
+	(*UpdateLinkResponse)(nil),         // 18: tribbae.v1.UpdateLinkResponse
+	(*DeleteLinkRequest)(nil),          // 19: tribbae.v1.DeleteLinkRequest
+	(*DeleteLinkResponse)(nil),         // 20: tribbae.v1.DeleteLinkResponse
+	(*LikeLinkRequest)(nil),            // 21: tribbae.v1.LikeLinkRequest
+	(*LikeLinkResponse)(nil),           // 22: tribbae.v1.LikeLinkResponse
+	(*UnlikeLinkRequest)(nil),          // 23: tribbae.v1.UnlikeLinkRequest
+	(*UnlikeLinkResponse)(nil),         // 24: tribbae.v1.UnlikeLinkResponse
+	(*ToggleFavoriteLinkRequest)(nil),  // 25: tribbae.v1.ToggleFavoriteLinkRequest
+	(*ToggleFavoriteLinkResponse)(nil), // 26: tribbae.v1.ToggleFavoriteLinkResponse
+	(*ListCommunityLinksRequest)(nil),  // 27: tribbae.v1.ListCommunityLinksRequest
+	(*ListCommunityLinksResponse)(nil), // 28: tribbae.v1.ListCommunityLinksResponse
+	(*ListNewLinksRequest)(nil),        // 29: tribbae.v1.ListNewLinksRequest
+	(*ListNewLinksResponse)(nil),       // 30: tribbae.v1.ListNewLinksResponse
+	(*BatchLinkResult)(nil),            // 31: tribbae.v1.BatchLinkResult
+	(*BatchUpdateLinksRequest)(nil),    // 32: tribbae.v1.BatchUpdateLinksRequest
+	(*BatchUpdateLinksResponse)(nil),   // 33: tribbae.v1.BatchUpdateLinksResponse
+	(*BatchMoveLinksRequest)(nil),      // 34: tribbae.v1.BatchMoveLinksRequest
+	(*BatchMoveLinksResponse)(nil),     // 35: tribbae.v1.BatchMoveLinksResponse
+	(*BatchDeleteLinksRequest)(nil),    // 36: tribbae.v1.BatchDeleteLinksRequest
+	(*BatchDeleteLinksResponse)(nil),   // 37: tribbae.v1.BatchDeleteLinksResponse
+	(*GetFolderHealthRequest)(nil),     // 38: tribbae.v1.GetFolderHealthRequest
+	(*GetFolderHealthResponse)(nil),    // 39: tribbae.v1.GetFolderHealthResponse
+	(*GetFolderBudgetRequest)(nil),     // 40: tribbae.v1.GetFolderBudgetRequest
+	(*BudgetTotal)(nil),                // 41: tribbae.v1.BudgetTotal
+	(*GetFolderBudgetResponse)(nil),    // 42: tribbae.v1.GetFolderBudgetResponse
+	(*WatchPriceRequest)(nil),          // 43: tribbae.v1.WatchPriceRequest
+	(*WatchPriceResponse)(nil),         // 44: tribbae.v1.WatchPriceResponse
+	(*UnwatchPriceRequest)(nil),        // 45: tribbae.v1.UnwatchPriceRequest
+	(*UnwatchPriceResponse)(nil),       // 46: tribbae.v1.UnwatchPriceResponse
+	(*GetPriceHistoryRequest)(nil),     // 47: tribbae.v1.GetPriceHistoryRequest
+	(*PricePoint)(nil),                 // 48: tribbae.v1.PricePoint
+	(*GetPriceHistoryResponse)(nil),    // 49: tribbae.v1.GetPriceHistoryResponse
+	(*ScaleRecipeRequest)(nil),         // 50: tribbae.v1.ScaleRecipeRequest
+	(*ScaleRecipeResponse)(nil),        // 51: tribbae.v1.ScaleRecipeResponse
+	(*SearchNearbyRequest)(nil),        // 52: tribbae.v1.SearchNearbyRequest
+	(*NearbyLink)(nil),                 // 53: tribbae.v1.NearbyLink
+	(*SearchNearbyResponse)(nil),       // 54: tribbae.v1.SearchNearbyResponse
+	(*RecommendForChildRequest)(nil),   // 55: tribbae.v1.RecommendForChildRequest
+	(*RecommendForChildResponse)(nil),  // 56: tribbae.v1.RecommendForChildResponse
+	(*timestamppb.Timestamp)(nil),      // 57: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 58: google.protobuf.FieldMask
 }
 var file_tribbae_v1_link_proto_depIdxs = []int32{
-	0,  // 0: tribbae.v1.Link.category:type_name -> tribbae.v1.LinkCategory
-	22, // 1: tribbae.v1.Link.created_at:type_name -> google.protobuf.Timestamp
-	22, // 2: tribbae.v1.Link.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: tribbae.v1.CreateLinkRequest.category:type_name -> tribbae.v1.LinkCategory
-	1,  // 4: tribbae.v1.CreateLinkResponse.link:type_name -> tribbae.v1.Link
-	1,  // 5: tribbae.v1.GetLinkResponse.link:type_name -> tribbae.v1.Link
-	1,  // 6: tribbae.v1.ListLinksResponse.links:type_name -> tribbae.v1.Link
-	0,  // 7: tribbae.v1.UpdateLinkRequest.category:type_name -> tribbae.v1.LinkCategory
-	1,  // 8: tribbae.v1.UpdateLinkResponse.link:type_name -> tribbae.v1.Link
-	1,  // 9: tribbae.v1.ListCommunityLinksResponse.links:type_name -> tribbae.v1.Link
-	1,  // 10: tribbae.v1.ListNewLinksResponse.links:type_name -> tribbae.v1.Link
-	2,  // 11: tribbae.v1.LinkService.CreateLink:input_type -> tribbae.v1.CreateLinkRequest
-	4,  // 12: tribbae.v1.LinkService.GetLink:input_type -> tribbae.v1.GetLinkRequest
-	6,  // 13: tribbae.v1.LinkService.ListLinks:input_type -> tribbae.v1.ListLinksRequest
-	8,  // 14: tribbae.v1.LinkService.UpdateLink:input_type -> tribbae.v1.UpdateLinkRequest
-	10, // 15: tribbae.v1.LinkService.DeleteLink:input_type -> tribbae.v1.DeleteLinkRequest
-	12, // 16: tribbae.v1.LinkService.LikeLink:input_type -> tribbae.v1.LikeLinkRequest
-	14, // 17: tribbae.v1.LinkService.UnlikeLink:input_type -> tribbae.v1.UnlikeLinkRequest
-	16, // 18: tribbae.v1.LinkService.ToggleFavoriteLink:input_type -> tribbae.v1.ToggleFavoriteLinkRequest
-	18, // 19: tribbae.v1.LinkService.ListCommunityLinks:input_type -> tribbae.v1.ListCommunityLinksRequest
-	20, // 20: tribbae.v1.LinkService.ListNewLinks:input_type -> tribbae.v1.ListNewLinksRequest
-	3,  // 21: tribbae.v1.LinkService.CreateLink:output_type -> tribbae.v1.CreateLinkResponse
-	5,  // 22: tribbae.v1.LinkService.GetLink:output_type -> tribbae.v1.GetLinkResponse
-	7,  // 23: tribbae.v1.LinkService.ListLinks:output_type -> tribbae.v1.ListLinksResponse
-	9,  // 24: tribbae.v1.LinkService.UpdateLink:output_type -> tribbae.v1.UpdateLinkResponse
-	11, // 25: tribbae.v1.LinkService.DeleteLink:output_type -> tribbae.v1.DeleteLinkResponse
-	13, // 26: tribbae.v1.LinkService.LikeLink:output_type -> tribbae.v1.LikeLinkResponse
-	15, // 27: tribbae.v1.LinkService.UnlikeLink:output_type -> tribbae.v1.UnlikeLinkResponse
-	17, // 28: tribbae.v1.LinkService.ToggleFavoriteLink:output_type -> tribbae.v1.ToggleFavoriteLinkResponse
-	19, // 29: tribbae.v1.LinkService.ListCommunityLinks:output_type -> tribbae.v1.ListCommunityLinksResponse
-	21, // 30: tribbae.v1.LinkService.ListNewLinks:output_type -> tribbae.v1.ListNewLinksResponse
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	57, // 0: tribbae.v1.LinkHealth.checked_at:type_name -> google.protobuf.Timestamp
+	4,  // 1: tribbae.v1.Recipe.nutrition:type_name -> tribbae.v1.Nutrition
+	7,  // 2: tribbae.v1.PriceWatch.current:type_name -> tribbae.v1.Money
+	57, // 3: tribbae.v1.PriceWatch.checked_at:type_name -> google.protobuf.Timestamp
+	57, // 4: tribbae.v1.PriceWatch.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: tribbae.v1.Link.category:type_name -> tribbae.v1.LinkCategory
+	57, // 6: tribbae.v1.Link.created_at:type_name -> google.protobuf.Timestamp
+	57, // 7: tribbae.v1.Link.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 8: tribbae.v1.Link.health:type_name -> tribbae.v1.LinkHealth
+	3,  // 9: tribbae.v1.Link.recipe:type_name -> tribbae.v1.Recipe
+	5,  // 10: tribbae.v1.Link.parsed_ingredients:type_name -> tribbae.v1.Ingredient
+	9,  // 11: tribbae.v1.Link.geo:type_name -> tribbae.v1.GeoPoint
+	6,  // 12: tribbae.v1.Link.ages:type_name -> tribbae.v1.AgeBounds
+	7,  // 13: tribbae.v1.Link.price_amount:type_name -> tribbae.v1.Money
+	8,  // 14: tribbae.v1.Link.price_watch:type_name -> tribbae.v1.PriceWatch
+	0,  // 15: tribbae.v1.CreateLinkRequest.category:type_name -> tribbae.v1.LinkCategory
+	10, // 16: tribbae.v1.CreateLinkResponse.link:type_name -> tribbae.v1.Link
+	10, // 17: tribbae.v1.GetLinkResponse.link:type_name -> tribbae.v1.Link
+	0,  // 18: tribbae.v1.ListLinksRequest.category:type_name -> tribbae.v1.LinkCategory
+	57, // 19: tribbae.v1.ListLinksRequest.event_after:type_name -> google.protobuf.Timestamp
+	57, // 20: tribbae.v1.ListLinksRequest.event_before:type_name -> google.protobuf.Timestamp
+	1,  // 21: tribbae.v1.ListLinksRequest.sort_by:type_name -> tribbae.v1.LinkSortField
+	10, // 22: tribbae.v1.ListLinksResponse.links:type_name -> tribbae.v1.Link
+	0,  // 23: tribbae.v1.UpdateLinkRequest.category:type_name -> tribbae.v1.LinkCategory
+	58, // 24: tribbae.v1.UpdateLinkRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 25: tribbae.v1.UpdateLinkResponse.link:type_name -> tribbae.v1.Link
+	10, // 26: tribbae.v1.ListCommunityLinksResponse.links:type_name -> tribbae.v1.Link
+	10, // 27: tribbae.v1.ListNewLinksResponse.links:type_name -> tribbae.v1.Link
+	31, // 28: tribbae.v1.BatchUpdateLinksResponse.results:type_name -> tribbae.v1.BatchLinkResult
+	31, // 29: tribbae.v1.BatchMoveLinksResponse.results:type_name -> tribbae.v1.BatchLinkResult
+	31, // 30: tribbae.v1.BatchDeleteLinksResponse.results:type_name -> tribbae.v1.BatchLinkResult
+	10, // 31: tribbae.v1.GetFolderHealthResponse.broken_links:type_name -> tribbae.v1.Link
+	41, // 32: tribbae.v1.GetFolderBudgetResponse.totals:type_name -> tribbae.v1.BudgetTotal
+	10, // 33: tribbae.v1.WatchPriceResponse.link:type_name -> tribbae.v1.Link
+	7,  // 34: tribbae.v1.PricePoint.price:type_name -> tribbae.v1.Money
+	57, // 35: tribbae.v1.PricePoint.observed_at:type_name -> google.protobuf.Timestamp
+	48, // 36: tribbae.v1.GetPriceHistoryResponse.points:type_name -> tribbae.v1.PricePoint
+	5,  // 37: tribbae.v1.ScaleRecipeResponse.ingredients:type_name -> tribbae.v1.Ingredient
+	0,  // 38: tribbae.v1.SearchNearbyRequest.category:type_name -> tribbae.v1.LinkCategory
+	10, // 39: tribbae.v1.NearbyLink.link:type_name -> tribbae.v1.Link
+	53, // 40: tribbae.v1.SearchNearbyResponse.results:type_name -> tribbae.v1.NearbyLink
+	9,  // 41: tribbae.v1.SearchNearbyResponse.center:type_name -> tribbae.v1.GeoPoint
+	0,  // 42: tribbae.v1.RecommendForChildRequest.category:type_name -> tribbae.v1.LinkCategory
+	10, // 43: tribbae.v1.RecommendForChildResponse.links:type_name -> tribbae.v1.Link
+	11, // 44: tribbae.v1.LinkService.CreateLink:input_type -> tribbae.v1.CreateLinkRequest
+	13, // 45: tribbae.v1.LinkService.GetLink:input_type -> tribbae.v1.GetLinkRequest
+	15, // 46: tribbae.v1.LinkService.ListLinks:input_type -> tribbae.v1.ListLinksRequest
+	17, // 47: tribbae.v1.LinkService.UpdateLink:input_type -> tribbae.v1.UpdateLinkRequest
+	19, // 48: tribbae.v1.LinkService.DeleteLink:input_type -> tribbae.v1.DeleteLinkRequest
+	32, // 49: tribbae.v1.LinkService.BatchUpdateLinks:input_type -> tribbae.v1.BatchUpdateLinksRequest
+	34, // 50: tribbae.v1.LinkService.BatchMoveLinks:input_type -> tribbae.v1.BatchMoveLinksRequest
+	36, // 51: tribbae.v1.LinkService.BatchDeleteLinks:input_type -> tribbae.v1.BatchDeleteLinksRequest
+	38, // 52: tribbae.v1.LinkService.GetFolderHealth:input_type -> tribbae.v1.GetFolderHealthRequest
+	40, // 53: tribbae.v1.LinkService.GetFolderBudget:input_type -> tribbae.v1.GetFolderBudgetRequest
+	43, // 54: tribbae.v1.LinkService.WatchPrice:input_type -> tribbae.v1.WatchPriceRequest
+	45, // 55: tribbae.v1.LinkService.UnwatchPrice:input_type -> tribbae.v1.UnwatchPriceRequest
+	47, // 56: tribbae.v1.LinkService.GetPriceHistory:input_type -> tribbae.v1.GetPriceHistoryRequest
+	50, // 57: tribbae.v1.LinkService.ScaleRecipe:input_type -> tribbae.v1.ScaleRecipeRequest
+	52, // 58: tribbae.v1.LinkService.SearchNearby:input_type -> tribbae.v1.SearchNearbyRequest
+	55, // 59: tribbae.v1.LinkService.RecommendForChild:input_type -> tribbae.v1.RecommendForChildRequest
+	21, // 60: tribbae.v1.LinkService.LikeLink:input_type -> tribbae.v1.LikeLinkRequest
+	23, // 61: tribbae.v1.LinkService.UnlikeLink:input_type -> tribbae.v1.UnlikeLinkRequest
+	25, // 62: tribbae.v1.LinkService.ToggleFavoriteLink:input_type -> tribbae.v1.ToggleFavoriteLinkRequest
+	27, // 63: tribbae.v1.LinkService.ListCommunityLinks:input_type -> tribbae.v1.ListCommunityLinksRequest
+	29, // 64: tribbae.v1.LinkService.ListNewLinks:input_type -> tribbae.v1.ListNewLinksRequest
+	12, // 65: tribbae.v1.LinkService.CreateLink:output_type -> tribbae.v1.CreateLinkResponse
+	14, // 66: tribbae.v1.LinkService.GetLink:output_type -> tribbae.v1.GetLinkResponse
+	16, // 67: tribbae.v1.LinkService.ListLinks:output_type -> tribbae.v1.ListLinksResponse
+	18, // 68: tribbae.v1.LinkService.UpdateLink:output_type -> tribbae.v1.UpdateLinkResponse
+	20, // 69: tribbae.v1.LinkService.DeleteLink:output_type -> tribbae.v1.DeleteLinkResponse
+	33, // 70: tribbae.v1.LinkService.BatchUpdateLinks:output_type -> tribbae.v1.BatchUpdateLinksResponse
+	35, // 71: tribbae.v1.LinkService.BatchMoveLinks:output_type -> tribbae.v1.BatchMoveLinksResponse
+	37, // 72: tribbae.v1.LinkService.BatchDeleteLinks:output_type -> tribbae.v1.BatchDeleteLinksResponse
+	39, // 73: tribbae.v1.LinkService.GetFolderHealth:output_type -> tribbae.v1.GetFolderHealthResponse
+	42, // 74: tribbae.v1.LinkService.GetFolderBudget:output_type -> tribbae.v1.GetFolderBudgetResponse
+	44, // 75: tribbae.v1.LinkService.WatchPrice:output_type -> tribbae.v1.WatchPriceResponse
+	46, // 76: tribbae.v1.LinkService.UnwatchPrice:output_type -> tribbae.v1.UnwatchPriceResponse
+	49, // 77: tribbae.v1.LinkService.GetPriceHistory:output_type -> tribbae.v1.GetPriceHistoryResponse
+	51, // 78: tribbae.v1.LinkService.ScaleRecipe:output_type -> tribbae.v1.ScaleRecipeResponse
+	54, // 79: tribbae.v1.LinkService.SearchNearby:output_type -> tribbae.v1.SearchNearbyResponse
+	56, // 80: tribbae.v1.LinkService.RecommendForChild:output_type -> tribbae.v1.RecommendForChildResponse
+	22, // 81: tribbae.v1.LinkService.LikeLink:output_type -> tribbae.v1.LikeLinkResponse
+	24, // 82: tribbae.v1.LinkService.UnlikeLink:output_type -> tribbae.v1.UnlikeLinkResponse
+	26, // 83: tribbae.v1.LinkService.ToggleFavoriteLink:output_type -> tribbae.v1.ToggleFavoriteLinkResponse
+	28, // 84: tribbae.v1.LinkService.ListCommunityLinks:output_type -> tribbae.v1.ListCommunityLinksResponse
+	30, // 85: tribbae.v1.LinkService.ListNewLinks:output_type -> tribbae.v1.ListNewLinksResponse
+	65, // [65:86] is the sub-list for method output_type
+	44, // [44:65] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_tribbae_v1_link_proto_init() }
@@ -1675,8 +4400,8 @@ func file_tribbae_v1_link_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_link_proto_rawDesc), len(file_tribbae_v1_link_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      2,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,17 @@ const (
 	LinkService_ListLinks_FullMethodName          = "/tribbae.v1.LinkService/ListLinks"
 	LinkService_UpdateLink_FullMethodName         = "/tribbae.v1.LinkService/UpdateLink"
 	LinkService_DeleteLink_FullMethodName         = "/tribbae.v1.LinkService/DeleteLink"
+	LinkService_BatchUpdateLinks_FullMethodName   = "/tribbae.v1.LinkService/BatchUpdateLinks"
+	LinkService_BatchMoveLinks_FullMethodName     = "/tribbae.v1.LinkService/BatchMoveLinks"
+	LinkService_BatchDeleteLinks_FullMethodName   = "/tribbae.v1.LinkService/BatchDeleteLinks"
+	LinkService_GetFolderHealth_FullMethodName    = "/tribbae.v1.LinkService/GetFolderHealth"
+	LinkService_GetFolderBudget_FullMethodName    = "/tribbae.v1.LinkService/GetFolderBudget"
+	LinkService_WatchPrice_FullMethodName         = "/tribbae.v1.LinkService/WatchPrice"
+	LinkService_UnwatchPrice_FullMethodName       = "/tribbae.v1.LinkService/UnwatchPrice"
+	LinkService_GetPriceHistory_FullMethodName    = "/tribbae.v1.LinkService/GetPriceHistory"
+	LinkService_ScaleRecipe_FullMethodName        = "/tribbae.v1.LinkService/ScaleRecipe"
+	LinkService_SearchNearby_FullMethodName       = "/tribbae.v1.LinkService/SearchNearby"
+	LinkService_RecommendForChild_FullMethodName  = "/tribbae.v1.LinkService/RecommendForChild"
 	LinkService_LikeLink_FullMethodName           = "/tribbae.v1.LinkService/LikeLink"
 	LinkService_UnlikeLink_FullMethodName         = "/tribbae.v1.LinkService/UnlikeLink"
 	LinkService_ToggleFavoriteLink_FullMethodName = "/tribbae.v1.LinkService/ToggleFavoriteLink"
@@ -40,6 +51,17 @@ type LinkServiceClient interface {
 	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error)
 	UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*UpdateLinkResponse, error)
 	DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*DeleteLinkResponse, error)
+	BatchUpdateLinks(ctx context.Context, in *BatchUpdateLinksRequest, opts ...grpc.CallOption) (*BatchUpdateLinksResponse, error)
+	BatchMoveLinks(ctx context.Context, in *BatchMoveLinksRequest, opts ...grpc.CallOption) (*BatchMoveLinksResponse, error)
+	BatchDeleteLinks(ctx context.Context, in *BatchDeleteLinksRequest, opts ...grpc.CallOption) (*BatchDeleteLinksResponse, error)
+	GetFolderHealth(ctx context.Context, in *GetFolderHealthRequest, opts ...grpc.CallOption) (*GetFolderHealthResponse, error)
+	GetFolderBudget(ctx context.Context, in *GetFolderBudgetRequest, opts ...grpc.CallOption) (*GetFolderBudgetResponse, error)
+	WatchPrice(ctx context.Context, in *WatchPriceRequest, opts ...grpc.CallOption) (*WatchPriceResponse, error)
+	UnwatchPrice(ctx context.Context, in *UnwatchPriceRequest, opts ...grpc.CallOption) (*UnwatchPriceResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	ScaleRecipe(ctx context.Context, in *ScaleRecipeRequest, opts ...grpc.CallOption) (*ScaleRecipeResponse, error)
+	SearchNearby(ctx context.Context, in *SearchNearbyRequest, opts ...grpc.CallOption) (*SearchNearbyResponse, error)
+	RecommendForChild(ctx context.Context, in *RecommendForChildRequest, opts ...grpc.CallOption) (*RecommendForChildResponse, error)
 	LikeLink(ctx context.Context, in *LikeLinkRequest, opts ...grpc.CallOption) (*LikeLinkResponse, error)
 	UnlikeLink(ctx context.Context, in *UnlikeLinkRequest, opts ...grpc.CallOption) (*UnlikeLinkResponse, error)
 	ToggleFavoriteLink(ctx context.Context, in *ToggleFavoriteLinkRequest, opts ...grpc.CallOption) (*ToggleFavoriteLinkResponse, error)
@@ -105,6 +127,116 @@ func (c *linkServiceClient) DeleteLink(ctx context.Context, in *DeleteLinkReques
 	return out, nil
 }
 
+func (c *linkServiceClient) BatchUpdateLinks(ctx context.Context, in *BatchUpdateLinksRequest, opts ...grpc.CallOption) (*BatchUpdateLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateLinksResponse)
+	err := c.cc.Invoke(ctx, LinkService_BatchUpdateLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) BatchMoveLinks(ctx context.Context, in *BatchMoveLinksRequest, opts ...grpc.CallOption) (*BatchMoveLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMoveLinksResponse)
+	err := c.cc.Invoke(ctx, LinkService_BatchMoveLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) BatchDeleteLinks(ctx context.Context, in *BatchDeleteLinksRequest, opts ...grpc.CallOption) (*BatchDeleteLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteLinksResponse)
+	err := c.cc.Invoke(ctx, LinkService_BatchDeleteLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetFolderHealth(ctx context.Context, in *GetFolderHealthRequest, opts ...grpc.CallOption) (*GetFolderHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFolderHealthResponse)
+	err := c.cc.Invoke(ctx, LinkService_GetFolderHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetFolderBudget(ctx context.Context, in *GetFolderBudgetRequest, opts ...grpc.CallOption) (*GetFolderBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFolderBudgetResponse)
+	err := c.cc.Invoke(ctx, LinkService_GetFolderBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) WatchPrice(ctx context.Context, in *WatchPriceRequest, opts ...grpc.CallOption) (*WatchPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchPriceResponse)
+	err := c.cc.Invoke(ctx, LinkService_WatchPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) UnwatchPrice(ctx context.Context, in *UnwatchPriceRequest, opts ...grpc.CallOption) (*UnwatchPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwatchPriceResponse)
+	err := c.cc.Invoke(ctx, LinkService_UnwatchPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, LinkService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ScaleRecipe(ctx context.Context, in *ScaleRecipeRequest, opts ...grpc.CallOption) (*ScaleRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleRecipeResponse)
+	err := c.cc.Invoke(ctx, LinkService_ScaleRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) SearchNearby(ctx context.Context, in *SearchNearbyRequest, opts ...grpc.CallOption) (*SearchNearbyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNearbyResponse)
+	err := c.cc.Invoke(ctx, LinkService_SearchNearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RecommendForChild(ctx context.Context, in *RecommendForChildRequest, opts ...grpc.CallOption) (*RecommendForChildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendForChildResponse)
+	err := c.cc.Invoke(ctx, LinkService_RecommendForChild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *linkServiceClient) LikeLink(ctx context.Context, in *LikeLinkRequest, opts ...grpc.CallOption) (*LikeLinkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikeLinkResponse)
@@ -164,6 +296,17 @@ type LinkServiceServer interface {
 	ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error)
 	UpdateLink(context.Context, *UpdateLinkRequest) (*UpdateLinkResponse, error)
 	DeleteLink(context.Context, *DeleteLinkRequest) (*DeleteLinkResponse, error)
+	BatchUpdateLinks(context.Context, *BatchUpdateLinksRequest) (*BatchUpdateLinksResponse, error)
+	BatchMoveLinks(context.Context, *BatchMoveLinksRequest) (*BatchMoveLinksResponse, error)
+	BatchDeleteLinks(context.Context, *BatchDeleteLinksRequest) (*BatchDeleteLinksResponse, error)
+	GetFolderHealth(context.Context, *GetFolderHealthRequest) (*GetFolderHealthResponse, error)
+	GetFolderBudget(context.Context, *GetFolderBudgetRequest) (*GetFolderBudgetResponse, error)
+	WatchPrice(context.Context, *WatchPriceRequest) (*WatchPriceResponse, error)
+	UnwatchPrice(context.Context, *UnwatchPriceRequest) (*UnwatchPriceResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	ScaleRecipe(context.Context, *ScaleRecipeRequest) (*ScaleRecipeResponse, error)
+	SearchNearby(context.Context, *SearchNearbyRequest) (*SearchNearbyResponse, error)
+	RecommendForChild(context.Context, *RecommendForChildRequest) (*RecommendForChildResponse, error)
 	LikeLink(context.Context, *LikeLinkRequest) (*LikeLinkResponse, error)
 	UnlikeLink(context.Context, *UnlikeLinkRequest) (*UnlikeLinkResponse, error)
 	ToggleFavoriteLink(context.Context, *ToggleFavoriteLinkRequest) (*ToggleFavoriteLinkResponse, error)
@@ -193,6 +336,39 @@ func (UnimplementedLinkServiceServer) UpdateLink(context.Context, *UpdateLinkReq
 func (UnimplementedLinkServiceServer) DeleteLink(context.Context, *DeleteLinkRequest) (*DeleteLinkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteLink not implemented")
 }
+func (UnimplementedLinkServiceServer) BatchUpdateLinks(context.Context, *BatchUpdateLinksRequest) (*BatchUpdateLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) BatchMoveLinks(context.Context, *BatchMoveLinksRequest) (*BatchMoveLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchMoveLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) BatchDeleteLinks(context.Context, *BatchDeleteLinksRequest) (*BatchDeleteLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) GetFolderHealth(context.Context, *GetFolderHealthRequest) (*GetFolderHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFolderHealth not implemented")
+}
+func (UnimplementedLinkServiceServer) GetFolderBudget(context.Context, *GetFolderBudgetRequest) (*GetFolderBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFolderBudget not implemented")
+}
+func (UnimplementedLinkServiceServer) WatchPrice(context.Context, *WatchPriceRequest) (*WatchPriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WatchPrice not implemented")
+}
+func (UnimplementedLinkServiceServer) UnwatchPrice(context.Context, *UnwatchPriceRequest) (*UnwatchPriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnwatchPrice not implemented")
+}
+func (UnimplementedLinkServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedLinkServiceServer) ScaleRecipe(context.Context, *ScaleRecipeRequest) (*ScaleRecipeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScaleRecipe not implemented")
+}
+func (UnimplementedLinkServiceServer) SearchNearby(context.Context, *SearchNearbyRequest) (*SearchNearbyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchNearby not implemented")
+}
+func (UnimplementedLinkServiceServer) RecommendForChild(context.Context, *RecommendForChildRequest) (*RecommendForChildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecommendForChild not implemented")
+}
 func (UnimplementedLinkServiceServer) LikeLink(context.Context, *LikeLinkRequest) (*LikeLinkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LikeLink not implemented")
 }
@@ -318,6 +494,204 @@ func _LinkService_DeleteLink_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LinkService_BatchUpdateLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).BatchUpdateLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_BatchUpdateLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).BatchUpdateLinks(ctx, req.(*BatchUpdateLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_BatchMoveLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMoveLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).BatchMoveLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_BatchMoveLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).BatchMoveLinks(ctx, req.(*BatchMoveLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_BatchDeleteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).BatchDeleteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_BatchDeleteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).BatchDeleteLinks(ctx, req.(*BatchDeleteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetFolderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetFolderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_GetFolderHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetFolderHealth(ctx, req.(*GetFolderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetFolderBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetFolderBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_GetFolderBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetFolderBudget(ctx, req.(*GetFolderBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_WatchPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).WatchPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_WatchPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).WatchPrice(ctx, req.(*WatchPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_UnwatchPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).UnwatchPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_UnwatchPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).UnwatchPrice(ctx, req.(*UnwatchPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ScaleRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ScaleRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_ScaleRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ScaleRecipe(ctx, req.(*ScaleRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_SearchNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).SearchNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_SearchNearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).SearchNearby(ctx, req.(*SearchNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RecommendForChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendForChildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RecommendForChild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinkService_RecommendForChild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RecommendForChild(ctx, req.(*RecommendForChildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LinkService_LikeLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikeLinkRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +809,50 @@ var LinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLink",
 			Handler:    _LinkService_DeleteLink_Handler,
 		},
+		{
+			MethodName: "BatchUpdateLinks",
+			Handler:    _LinkService_BatchUpdateLinks_Handler,
+		},
+		{
+			MethodName: "BatchMoveLinks",
+			Handler:    _LinkService_BatchMoveLinks_Handler,
+		},
+		{
+			MethodName: "BatchDeleteLinks",
+			Handler:    _LinkService_BatchDeleteLinks_Handler,
+		},
+		{
+			MethodName: "GetFolderHealth",
+			Handler:    _LinkService_GetFolderHealth_Handler,
+		},
+		{
+			MethodName: "GetFolderBudget",
+			Handler:    _LinkService_GetFolderBudget_Handler,
+		},
+		{
+			MethodName: "WatchPrice",
+			Handler:    _LinkService_WatchPrice_Handler,
+		},
+		{
+			MethodName: "UnwatchPrice",
+			Handler:    _LinkService_UnwatchPrice_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _LinkService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "ScaleRecipe",
+			Handler:    _LinkService_ScaleRecipe_Handler,
+		},
+		{
+			MethodName: "SearchNearby",
+			Handler:    _LinkService_SearchNearby_Handler,
+		},
+		{
+			MethodName: "RecommendForChild",
+			Handler:    _LinkService_RecommendForChild_Handler,
+		},
 		{
 			MethodName: "LikeLink",
 			Handler:    _LinkService_LikeLink_Handler,
@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/mealplan.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MealType int32
+
+const (
+	MealType_MEAL_TYPE_UNSPECIFIED MealType = 0
+	MealType_MEAL_TYPE_BREAKFAST   MealType = 1
+	MealType_MEAL_TYPE_LUNCH       MealType = 2
+	MealType_MEAL_TYPE_SNACK       MealType = 3
+	MealType_MEAL_TYPE_DINNER      MealType = 4
+)
+
+// Enum value maps for MealType.
+var (
+	MealType_name = map[int32]string{
+		0: "MEAL_TYPE_UNSPECIFIED",
+		1: "MEAL_TYPE_BREAKFAST",
+		2: "MEAL_TYPE_LUNCH",
+		3: "MEAL_TYPE_SNACK",
+		4: "MEAL_TYPE_DINNER",
+	}
+	MealType_value = map[string]int32{
+		"MEAL_TYPE_UNSPECIFIED": 0,
+		"MEAL_TYPE_BREAKFAST":   1,
+		"MEAL_TYPE_LUNCH":       2,
+		"MEAL_TYPE_SNACK":       3,
+		"MEAL_TYPE_DINNER":      4,
+	}
+)
+
+func (x MealType) Enum() *MealType {
+	p := new(MealType)
+	*p = x
+	return p
+}
+
+func (x MealType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MealType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tribbae_v1_mealplan_proto_enumTypes[0].Descriptor()
+}
+
+func (MealType) Type() protoreflect.EnumType {
+	return &file_tribbae_v1_mealplan_proto_enumTypes[0]
+}
+
+func (x MealType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MealType.Descriptor instead.
+func (MealType) EnumDescriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{0}
+}
+
+// Recette placée sur un créneau du menu
+type MealEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Meal          MealType               `protobuf:"varint,3,opt,name=meal,proto3,enum=tribbae.v1.MealType" json:"meal,omitempty"`
+	LinkId        string                 `protobuf:"bytes,4,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Servings      int32                  `protobuf:"varint,7,opt,name=servings,proto3" json:"servings,omitempty"` // 0 : nombre de parts de la recette
+	Note          string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealEntry) Reset() {
+	*x = MealEntry{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealEntry) ProtoMessage() {}
+
+func (x *MealEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealEntry.ProtoReflect.Descriptor instead.
+func (*MealEntry) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MealEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MealEntry) GetMeal() MealType {
+	if x != nil {
+		return x.Meal
+	}
+	return MealType_MEAL_TYPE_UNSPECIFIED
+}
+
+func (x *MealEntry) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *MealEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MealEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MealEntry) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *MealEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Menu d'une semaine, du lundi au dimanche
+type MealPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // vide tant qu'aucune recette n'a été placée
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`    // vide pour un menu personnel
+	WeekStart     string                 `protobuf:"bytes,3,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // lundi, YYYY-MM-DD
+	Entries       []*MealEntry           `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`                      // triées par jour puis par repas
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlan) Reset() {
+	*x = MealPlan{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlan) ProtoMessage() {}
+
+func (x *MealPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlan.ProtoReflect.Descriptor instead.
+func (*MealPlan) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MealPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlan) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *MealPlan) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *MealPlan) GetEntries() []*MealEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *MealPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetMealPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // un jour quelconque de la semaine, YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanRequest) Reset() {
+	*x = GetMealPlanRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanRequest) ProtoMessage() {}
+
+func (x *GetMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMealPlanRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetMealPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *MealPlan              `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanResponse) Reset() {
+	*x = GetMealPlanResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanResponse) ProtoMessage() {}
+
+func (x *GetMealPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetMealPlanResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMealPlanResponse) GetPlan() *MealPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type AddMealEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Meal          MealType               `protobuf:"varint,3,opt,name=meal,proto3,enum=tribbae.v1.MealType" json:"meal,omitempty"`
+	LinkId        string                 `protobuf:"bytes,4,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMealEntryRequest) Reset() {
+	*x = AddMealEntryRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMealEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMealEntryRequest) ProtoMessage() {}
+
+func (x *AddMealEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMealEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddMealEntryRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddMealEntryRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *AddMealEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AddMealEntryRequest) GetMeal() MealType {
+	if x != nil {
+		return x.Meal
+	}
+	return MealType_MEAL_TYPE_UNSPECIFIED
+}
+
+func (x *AddMealEntryRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *AddMealEntryRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *AddMealEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AddMealEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *MealPlan              `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMealEntryResponse) Reset() {
+	*x = AddMealEntryResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMealEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMealEntryResponse) ProtoMessage() {}
+
+func (x *AddMealEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMealEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddMealEntryResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddMealEntryResponse) GetPlan() *MealPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type RemoveMealEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMealEntryRequest) Reset() {
+	*x = RemoveMealEntryRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMealEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMealEntryRequest) ProtoMessage() {}
+
+func (x *RemoveMealEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMealEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMealEntryRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveMealEntryRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *RemoveMealEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type RemoveMealEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *MealPlan              `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMealEntryResponse) Reset() {
+	*x = RemoveMealEntryResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMealEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMealEntryResponse) ProtoMessage() {}
+
+func (x *RemoveMealEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMealEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMealEntryResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveMealEntryResponse) GetPlan() *MealPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type CopyPreviousWeekRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // un jour de la semaine à remplir
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyPreviousWeekRequest) Reset() {
+	*x = CopyPreviousWeekRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyPreviousWeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyPreviousWeekRequest) ProtoMessage() {}
+
+func (x *CopyPreviousWeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyPreviousWeekRequest.ProtoReflect.Descriptor instead.
+func (*CopyPreviousWeekRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CopyPreviousWeekRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *CopyPreviousWeekRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CopyPreviousWeekResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *MealPlan              `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyPreviousWeekResponse) Reset() {
+	*x = CopyPreviousWeekResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyPreviousWeekResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyPreviousWeekResponse) ProtoMessage() {}
+
+func (x *CopyPreviousWeekResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyPreviousWeekResponse.ProtoReflect.Descriptor instead.
+func (*CopyPreviousWeekResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CopyPreviousWeekResponse) GetPlan() *MealPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GenerateShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // "Courses de la semaine du …" si vide
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateShoppingListRequest) Reset() {
+	*x = GenerateShoppingListRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateShoppingListRequest) ProtoMessage() {}
+
+func (x *GenerateShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GenerateShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateShoppingListRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *GenerateShoppingListRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GenerateShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ShoppingList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateShoppingListResponse) Reset() {
+	*x = GenerateShoppingListResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateShoppingListResponse) ProtoMessage() {}
+
+func (x *GenerateShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*GenerateShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateShoppingListResponse) GetList() *ShoppingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ExportMealPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMealPlanRequest) Reset() {
+	*x = ExportMealPlanRequest{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMealPlanRequest) ProtoMessage() {}
+
+func (x *ExportMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*ExportMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportMealPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type ExportMealPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"` // "menus-2026-10-19.ics"
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`   // calendrier iCalendar (text/calendar)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMealPlanResponse) Reset() {
+	*x = ExportMealPlanResponse{}
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMealPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMealPlanResponse) ProtoMessage() {}
+
+func (x *ExportMealPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_mealplan_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMealPlanResponse.ProtoReflect.Descriptor instead.
+func (*ExportMealPlanResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_mealplan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportMealPlanResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMealPlanResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+var File_tribbae_v1_mealplan_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_mealplan_proto_rawDesc = "" +
+	"\n" +
+	"\x19tribbae/v1/mealplan.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19tribbae/v1/shopping.proto\"\xca\x01\n" +
+	"\tMealEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12(\n" +
+	"\x04meal\x18\x03 \x01(\x0e2\x14.tribbae.v1.MealTypeR\x04meal\x12\x17\n" +
+	"\alink_id\x18\x04 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1a\n" +
+	"\bservings\x18\a \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\"\xc2\x01\n" +
+	"\bMealPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\tR\tweekStart\x12/\n" +
+	"\aentries\x18\x04 \x03(\v2\x15.tribbae.v1.MealEntryR\aentries\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"E\n" +
+	"\x12GetMealPlanRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"?\n" +
+	"\x13GetMealPlanResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.tribbae.v1.MealPlanR\x04plan\"\xb9\x01\n" +
+	"\x13AddMealEntryRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12(\n" +
+	"\x04meal\x18\x03 \x01(\x0e2\x14.tribbae.v1.MealTypeR\x04meal\x12\x17\n" +
+	"\alink_id\x18\x04 \x01(\tR\x06linkId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"@\n" +
+	"\x14AddMealEntryResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.tribbae.v1.MealPlanR\x04plan\"L\n" +
+	"\x16RemoveMealEntryRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\"C\n" +
+	"\x17RemoveMealEntryResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.tribbae.v1.MealPlanR\x04plan\"J\n" +
+	"\x17CopyPreviousWeekRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"D\n" +
+	"\x18CopyPreviousWeekResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.tribbae.v1.MealPlanR\x04plan\"L\n" +
+	"\x1bGenerateShoppingListRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"L\n" +
+	"\x1cGenerateShoppingListResponse\x12,\n" +
+	"\x04list\x18\x01 \x01(\v2\x18.tribbae.v1.ShoppingListR\x04list\"0\n" +
+	"\x15ExportMealPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\"N\n" +
+	"\x16ExportMealPlanResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent*~\n" +
+	"\bMealType\x12\x19\n" +
+	"\x15MEAL_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MEAL_TYPE_BREAKFAST\x10\x01\x12\x13\n" +
+	"\x0fMEAL_TYPE_LUNCH\x10\x02\x12\x13\n" +
+	"\x0fMEAL_TYPE_SNACK\x10\x03\x12\x14\n" +
+	"\x10MEAL_TYPE_DINNER\x10\x042\xac\x06\n" +
+	"\x0fMealPlanService\x12f\n" +
+	"\vGetMealPlan\x12\x1e.tribbae.v1.GetMealPlanRequest\x1a\x1f.tribbae.v1.GetMealPlanResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/meal-plans\x12t\n" +
+	"\fAddMealEntry\x12\x1f.tribbae.v1.AddMealEntryRequest\x1a .tribbae.v1.AddMealEntryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/meal-plans/entries\x12\x8f\x01\n" +
+	"\x0fRemoveMealEntry\x12\".tribbae.v1.RemoveMealEntryRequest\x1a#.tribbae.v1.RemoveMealEntryResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/meal-plans/{plan_id}/entries/{entry_id}\x12\x89\x01\n" +
+	"\x10CopyPreviousWeek\x12#.tribbae.v1.CopyPreviousWeekRequest\x1a$.tribbae.v1.CopyPreviousWeekResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/meal-plans:copyPreviousWeek\x12\x9c\x01\n" +
+	"\x14GenerateShoppingList\x12'.tribbae.v1.GenerateShoppingListRequest\x1a(.tribbae.v1.GenerateShoppingListResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/meal-plans/{plan_id}/shopping-list\x12~\n" +
+	"\x0eExportMealPlan\x12!.tribbae.v1.ExportMealPlanRequest\x1a\".tribbae.v1.ExportMealPlanResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/meal-plans/{plan_id}/icalB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_mealplan_proto_rawDescOnce sync.Once
+	file_tribbae_v1_mealplan_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_mealplan_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_mealplan_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_mealplan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_mealplan_proto_rawDesc), len(file_tribbae_v1_mealplan_proto_rawDesc)))
+	})
+	return file_tribbae_v1_mealplan_proto_rawDescData
+}
+
+var file_tribbae_v1_mealplan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tribbae_v1_mealplan_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_tribbae_v1_mealplan_proto_goTypes = []any{
+	(MealType)(0),                        // 0: tribbae.v1.MealType
+	(*MealEntry)(nil),                    // 1: tribbae.v1.MealEntry
+	(*MealPlan)(nil),                     // 2: tribbae.v1.MealPlan
+	(*GetMealPlanRequest)(nil),           // 3: tribbae.v1.GetMealPlanRequest
+	(*GetMealPlanResponse)(nil),          // 4: tribbae.v1.GetMealPlanResponse
+	(*AddMealEntryRequest)(nil),          // 5: tribbae.v1.AddMealEntryRequest
+	(*AddMealEntryResponse)(nil),         // 6: tribbae.v1.AddMealEntryResponse
+	(*RemoveMealEntryRequest)(nil),       // 7: tribbae.v1.RemoveMealEntryRequest
+	(*RemoveMealEntryResponse)(nil),      // 8: tribbae.v1.RemoveMealEntryResponse
+	(*CopyPreviousWeekRequest)(nil),      // 9: tribbae.v1.CopyPreviousWeekRequest
+	(*CopyPreviousWeekResponse)(nil),     // 10: tribbae.v1.CopyPreviousWeekResponse
+	(*GenerateShoppingListRequest)(nil),  // 11: tribbae.v1.GenerateShoppingListRequest
+	(*GenerateShoppingListResponse)(nil), // 12: tribbae.v1.GenerateShoppingListResponse
+	(*ExportMealPlanRequest)(nil),        // 13: tribbae.v1.ExportMealPlanRequest
+	(*ExportMealPlanResponse)(nil),       // 14: tribbae.v1.ExportMealPlanResponse
+	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+	(*ShoppingList)(nil),                 // 16: tribbae.v1.ShoppingList
+}
+var file_tribbae_v1_mealplan_proto_depIdxs = []int32{
+	0,  // 0: tribbae.v1.MealEntry.meal:type_name -> tribbae.v1.MealType
+	1,  // 1: tribbae.v1.MealPlan.entries:type_name -> tribbae.v1.MealEntry
+	15, // 2: tribbae.v1.MealPlan.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: tribbae.v1.GetMealPlanResponse.plan:type_name -> tribbae.v1.MealPlan
+	0,  // 4: tribbae.v1.AddMealEntryRequest.meal:type_name -> tribbae.v1.MealType
+	2,  // 5: tribbae.v1.AddMealEntryResponse.plan:type_name -> tribbae.v1.MealPlan
+	2,  // 6: tribbae.v1.RemoveMealEntryResponse.plan:type_name -> tribbae.v1.MealPlan
+	2,  // 7: tribbae.v1.CopyPreviousWeekResponse.plan:type_name -> tribbae.v1.MealPlan
+	16, // 8: tribbae.v1.GenerateShoppingListResponse.list:type_name -> tribbae.v1.ShoppingList
+	3,  // 9: tribbae.v1.MealPlanService.GetMealPlan:input_type -> tribbae.v1.GetMealPlanRequest
+	5,  // 10: tribbae.v1.MealPlanService.AddMealEntry:input_type -> tribbae.v1.AddMealEntryRequest
+	7,  // 11: tribbae.v1.MealPlanService.RemoveMealEntry:input_type -> tribbae.v1.RemoveMealEntryRequest
+	9,  // 12: tribbae.v1.MealPlanService.CopyPreviousWeek:input_type -> tribbae.v1.CopyPreviousWeekRequest
+	11, // 13: tribbae.v1.MealPlanService.GenerateShoppingList:input_type -> tribbae.v1.GenerateShoppingListRequest
+	13, // 14: tribbae.v1.MealPlanService.ExportMealPlan:input_type -> tribbae.v1.ExportMealPlanRequest
+	4,  // 15: tribbae.v1.MealPlanService.GetMealPlan:output_type -> tribbae.v1.GetMealPlanResponse
+	6,  // 16: tribbae.v1.MealPlanService.AddMealEntry:output_type -> tribbae.v1.AddMealEntryResponse
+	8,  // 17: tribbae.v1.MealPlanService.RemoveMealEntry:output_type -> tribbae.v1.RemoveMealEntryResponse
+	10, // 18: tribbae.v1.MealPlanService.CopyPreviousWeek:output_type -> tribbae.v1.CopyPreviousWeekResponse
+	12, // 19: tribbae.v1.MealPlanService.GenerateShoppingList:output_type -> tribbae.v1.GenerateShoppingListResponse
+	14, // 20: tribbae.v1.MealPlanService.ExportMealPlan:output_type -> tribbae.v1.ExportMealPlanResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_mealplan_proto_init() }
+func file_tribbae_v1_mealplan_proto_init() {
+	if File_tribbae_v1_mealplan_proto != nil {
+		return
+	}
+	file_tribbae_v1_shopping_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_mealplan_proto_rawDesc), len(file_tribbae_v1_mealplan_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_mealplan_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_mealplan_proto_depIdxs,
+		EnumInfos:         file_tribbae_v1_mealplan_proto_enumTypes,
+		MessageInfos:      file_tribbae_v1_mealplan_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_mealplan_proto = out.File
+	file_tribbae_v1_mealplan_proto_goTypes = nil
+	file_tribbae_v1_mealplan_proto_depIdxs = nil
+}
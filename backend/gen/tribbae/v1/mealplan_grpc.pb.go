@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: tribbae/v1/mealplan.proto
+
+package tribbaev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MealPlanService_GetMealPlan_FullMethodName          = "/tribbae.v1.MealPlanService/GetMealPlan"
+	MealPlanService_AddMealEntry_FullMethodName         = "/tribbae.v1.MealPlanService/AddMealEntry"
+	MealPlanService_RemoveMealEntry_FullMethodName      = "/tribbae.v1.MealPlanService/RemoveMealEntry"
+	MealPlanService_CopyPreviousWeek_FullMethodName     = "/tribbae.v1.MealPlanService/CopyPreviousWeek"
+	MealPlanService_GenerateShoppingList_FullMethodName = "/tribbae.v1.MealPlanService/GenerateShoppingList"
+	MealPlanService_ExportMealPlan_FullMethodName       = "/tribbae.v1.MealPlanService/ExportMealPlan"
+)
+
+// MealPlanServiceClient is the client API for MealPlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MealPlanServiceClient interface {
+	GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*GetMealPlanResponse, error)
+	AddMealEntry(ctx context.Context, in *AddMealEntryRequest, opts ...grpc.CallOption) (*AddMealEntryResponse, error)
+	RemoveMealEntry(ctx context.Context, in *RemoveMealEntryRequest, opts ...grpc.CallOption) (*RemoveMealEntryResponse, error)
+	CopyPreviousWeek(ctx context.Context, in *CopyPreviousWeekRequest, opts ...grpc.CallOption) (*CopyPreviousWeekResponse, error)
+	GenerateShoppingList(ctx context.Context, in *GenerateShoppingListRequest, opts ...grpc.CallOption) (*GenerateShoppingListResponse, error)
+	ExportMealPlan(ctx context.Context, in *ExportMealPlanRequest, opts ...grpc.CallOption) (*ExportMealPlanResponse, error)
+}
+
+type mealPlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMealPlanServiceClient(cc grpc.ClientConnInterface) MealPlanServiceClient {
+	return &mealPlanServiceClient{cc}
+}
+
+func (c *mealPlanServiceClient) GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*GetMealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMealPlanResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_GetMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) AddMealEntry(ctx context.Context, in *AddMealEntryRequest, opts ...grpc.CallOption) (*AddMealEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMealEntryResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_AddMealEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) RemoveMealEntry(ctx context.Context, in *RemoveMealEntryRequest, opts ...grpc.CallOption) (*RemoveMealEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMealEntryResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_RemoveMealEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) CopyPreviousWeek(ctx context.Context, in *CopyPreviousWeekRequest, opts ...grpc.CallOption) (*CopyPreviousWeekResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyPreviousWeekResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_CopyPreviousWeek_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) GenerateShoppingList(ctx context.Context, in *GenerateShoppingListRequest, opts ...grpc.CallOption) (*GenerateShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateShoppingListResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_GenerateShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) ExportMealPlan(ctx context.Context, in *ExportMealPlanRequest, opts ...grpc.CallOption) (*ExportMealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMealPlanResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_ExportMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MealPlanServiceServer is the server API for MealPlanService service.
+// All implementations should embed UnimplementedMealPlanServiceServer
+// for forward compatibility.
+type MealPlanServiceServer interface {
+	GetMealPlan(context.Context, *GetMealPlanRequest) (*GetMealPlanResponse, error)
+	AddMealEntry(context.Context, *AddMealEntryRequest) (*AddMealEntryResponse, error)
+	RemoveMealEntry(context.Context, *RemoveMealEntryRequest) (*RemoveMealEntryResponse, error)
+	CopyPreviousWeek(context.Context, *CopyPreviousWeekRequest) (*CopyPreviousWeekResponse, error)
+	GenerateShoppingList(context.Context, *GenerateShoppingListRequest) (*GenerateShoppingListResponse, error)
+	ExportMealPlan(context.Context, *ExportMealPlanRequest) (*ExportMealPlanResponse, error)
+}
+
+// UnimplementedMealPlanServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMealPlanServiceServer struct{}
+
+func (UnimplementedMealPlanServiceServer) GetMealPlan(context.Context, *GetMealPlanRequest) (*GetMealPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) AddMealEntry(context.Context, *AddMealEntryRequest) (*AddMealEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddMealEntry not implemented")
+}
+func (UnimplementedMealPlanServiceServer) RemoveMealEntry(context.Context, *RemoveMealEntryRequest) (*RemoveMealEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveMealEntry not implemented")
+}
+func (UnimplementedMealPlanServiceServer) CopyPreviousWeek(context.Context, *CopyPreviousWeekRequest) (*CopyPreviousWeekResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyPreviousWeek not implemented")
+}
+func (UnimplementedMealPlanServiceServer) GenerateShoppingList(context.Context, *GenerateShoppingListRequest) (*GenerateShoppingListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateShoppingList not implemented")
+}
+func (UnimplementedMealPlanServiceServer) ExportMealPlan(context.Context, *ExportMealPlanRequest) (*ExportMealPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMealPlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MealPlanServiceServer will
+// result in compilation errors.
+type UnsafeMealPlanServiceServer interface {
+	mustEmbedUnimplementedMealPlanServiceServer()
+}
+
+func RegisterMealPlanServiceServer(s grpc.ServiceRegistrar, srv MealPlanServiceServer) {
+	// If the following call panics, it indicates UnimplementedMealPlanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MealPlanService_ServiceDesc, srv)
+}
+
+func _MealPlanService_GetMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_GetMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, req.(*GetMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_AddMealEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMealEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).AddMealEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_AddMealEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).AddMealEntry(ctx, req.(*AddMealEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_RemoveMealEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMealEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).RemoveMealEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_RemoveMealEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).RemoveMealEntry(ctx, req.(*RemoveMealEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_CopyPreviousWeek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyPreviousWeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).CopyPreviousWeek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_CopyPreviousWeek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).CopyPreviousWeek(ctx, req.(*CopyPreviousWeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_GenerateShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).GenerateShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_GenerateShoppingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).GenerateShoppingList(ctx, req.(*GenerateShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_ExportMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).ExportMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_ExportMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).ExportMealPlan(ctx, req.(*ExportMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MealPlanService_ServiceDesc is the grpc.ServiceDesc for MealPlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MealPlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tribbae.v1.MealPlanService",
+	HandlerType: (*MealPlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMealPlan",
+			Handler:    _MealPlanService_GetMealPlan_Handler,
+		},
+		{
+			MethodName: "AddMealEntry",
+			Handler:    _MealPlanService_AddMealEntry_Handler,
+		},
+		{
+			MethodName: "RemoveMealEntry",
+			Handler:    _MealPlanService_RemoveMealEntry_Handler,
+		},
+		{
+			MethodName: "CopyPreviousWeek",
+			Handler:    _MealPlanService_CopyPreviousWeek_Handler,
+		},
+		{
+			MethodName: "GenerateShoppingList",
+			Handler:    _MealPlanService_GenerateShoppingList_Handler,
+		},
+		{
+			MethodName: "ExportMealPlan",
+			Handler:    _MealPlanService_ExportMealPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tribbae/v1/mealplan.proto",
+}
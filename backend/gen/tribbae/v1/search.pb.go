@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/search.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchResultType int32
+
+const (
+	SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED SearchResultType = 0
+	SearchResultType_SEARCH_RESULT_TYPE_FOLDER      SearchResultType = 1
+	SearchResultType_SEARCH_RESULT_TYPE_LINK        SearchResultType = 2
+)
+
+// Enum value maps for SearchResultType.
+var (
+	SearchResultType_name = map[int32]string{
+		0: "SEARCH_RESULT_TYPE_UNSPECIFIED",
+		1: "SEARCH_RESULT_TYPE_FOLDER",
+		2: "SEARCH_RESULT_TYPE_LINK",
+	}
+	SearchResultType_value = map[string]int32{
+		"SEARCH_RESULT_TYPE_UNSPECIFIED": 0,
+		"SEARCH_RESULT_TYPE_FOLDER":      1,
+		"SEARCH_RESULT_TYPE_LINK":        2,
+	}
+)
+
+func (x SearchResultType) Enum() *SearchResultType {
+	p := new(SearchResultType)
+	*p = x
+	return p
+}
+
+func (x SearchResultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tribbae_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchResultType) Type() protoreflect.EnumType {
+	return &file_tribbae_v1_search_proto_enumTypes[0]
+}
+
+func (x SearchResultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResultType.Descriptor instead.
+func (SearchResultType) EnumDescriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+// Portion surlignée d'un extrait, en caractères (runes) depuis le début de text
+type HighlightRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"` // exclu
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightRange) Reset() {
+	*x = HighlightRange{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightRange) ProtoMessage() {}
+
+func (x *HighlightRange) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightRange.ProtoReflect.Descriptor instead.
+func (*HighlightRange) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HighlightRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *HighlightRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type Snippet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "name", "title", "description", "tags", "ingredients", "location"
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Highlights    []*HighlightRange      `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snippet) Reset() {
+	*x = Snippet{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snippet) ProtoMessage() {}
+
+func (x *Snippet) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snippet.ProtoReflect.Descriptor instead.
+func (*Snippet) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Snippet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Snippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Snippet) GetHighlights() []*HighlightRange {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SearchResultType       `protobuf:"varint,1,opt,name=type,proto3,enum=tribbae.v1.SearchResultType" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                       // nom du dossier ou titre du lien
+	FolderId      string                 `protobuf:"bytes,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // dossier d'un lien
+	Category      LinkCategory           `protobuf:"varint,5,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Score         float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	Snippets      []*Snippet             `protobuf:"bytes,8,rep,name=snippets,proto3" json:"snippets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResult) GetType() SearchResultType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *SearchResult) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSnippets() []*Snippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Category      LinkCategory           `protobuf:"varint,2,opt,name=category,proto3,enum=tribbae.v1.LinkCategory" json:"category,omitempty"` // ne garde que les liens de cette catégorie
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token de la page précédente
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCategory() LinkCategory {
+	if x != nil {
+		return x.Category
+	}
+	return LinkCategory_LINK_CATEGORY_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Comptes des liens correspondant à la recherche, sans les filtres category et tag
+	Categories    []*FacetCount `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags          []*FacetCount `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string        `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_tribbae_v1_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_search_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetCategories() []*FacetCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_tribbae_v1_search_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x17tribbae/v1/search.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x15tribbae/v1/link.proto\"8\n" +
+	"\x0eHighlightRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"o\n" +
+	"\aSnippet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12:\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x1a.tribbae.v1.HighlightRangeR\n" +
+	"highlights\"\x9d\x02\n" +
+	"\fSearchResult\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.tribbae.v1.SearchResultTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\tR\bfolderId\x124\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x18.tribbae.v1.LinkCategoryR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12/\n" +
+	"\bsnippets\x18\b \x03(\v2\x13.tribbae.v1.SnippetR\bsnippets\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xa9\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x124\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x18.tribbae.v1.LinkCategoryR\bcategory\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xd0\x01\n" +
+	"\x0eSearchResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.tribbae.v1.SearchResultR\aresults\x126\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x16.tribbae.v1.FacetCountR\n" +
+	"categories\x12*\n" +
+	"\x04tags\x18\x03 \x03(\v2\x16.tribbae.v1.FacetCountR\x04tags\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken*r\n" +
+	"\x10SearchResultType\x12\"\n" +
+	"\x1eSEARCH_RESULT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SEARCH_RESULT_TYPE_FOLDER\x10\x01\x12\x1b\n" +
+	"\x17SEARCH_RESULT_TYPE_LINK\x10\x022d\n" +
+	"\rSearchService\x12S\n" +
+	"\x06Search\x12\x19.tribbae.v1.SearchRequest\x1a\x1a.tribbae.v1.SearchResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/searchB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_search_proto_rawDescOnce sync.Once
+	file_tribbae_v1_search_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_search_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_search_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_search_proto_rawDesc), len(file_tribbae_v1_search_proto_rawDesc)))
+	})
+	return file_tribbae_v1_search_proto_rawDescData
+}
+
+var file_tribbae_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tribbae_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_tribbae_v1_search_proto_goTypes = []any{
+	(SearchResultType)(0),  // 0: tribbae.v1.SearchResultType
+	(*HighlightRange)(nil), // 1: tribbae.v1.HighlightRange
+	(*Snippet)(nil),        // 2: tribbae.v1.Snippet
+	(*SearchResult)(nil),   // 3: tribbae.v1.SearchResult
+	(*FacetCount)(nil),     // 4: tribbae.v1.FacetCount
+	(*SearchRequest)(nil),  // 5: tribbae.v1.SearchRequest
+	(*SearchResponse)(nil), // 6: tribbae.v1.SearchResponse
+	(LinkCategory)(0),      // 7: tribbae.v1.LinkCategory
+}
+var file_tribbae_v1_search_proto_depIdxs = []int32{
+	1, // 0: tribbae.v1.Snippet.highlights:type_name -> tribbae.v1.HighlightRange
+	0, // 1: tribbae.v1.SearchResult.type:type_name -> tribbae.v1.SearchResultType
+	7, // 2: tribbae.v1.SearchResult.category:type_name -> tribbae.v1.LinkCategory
+	2, // 3: tribbae.v1.SearchResult.snippets:type_name -> tribbae.v1.Snippet
+	7, // 4: tribbae.v1.SearchRequest.category:type_name -> tribbae.v1.LinkCategory
+	3, // 5: tribbae.v1.SearchResponse.results:type_name -> tribbae.v1.SearchResult
+	4, // 6: tribbae.v1.SearchResponse.categories:type_name -> tribbae.v1.FacetCount
+	4, // 7: tribbae.v1.SearchResponse.tags:type_name -> tribbae.v1.FacetCount
+	5, // 8: tribbae.v1.SearchService.Search:input_type -> tribbae.v1.SearchRequest
+	6, // 9: tribbae.v1.SearchService.Search:output_type -> tribbae.v1.SearchResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_search_proto_init() }
+func file_tribbae_v1_search_proto_init() {
+	if File_tribbae_v1_search_proto != nil {
+		return
+	}
+	file_tribbae_v1_link_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_search_proto_rawDesc), len(file_tribbae_v1_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_search_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_search_proto_depIdxs,
+		EnumInfos:         file_tribbae_v1_search_proto_enumTypes,
+		MessageInfos:      file_tribbae_v1_search_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_search_proto = out.File
+	file_tribbae_v1_search_proto_goTypes = nil
+	file_tribbae_v1_search_proto_depIdxs = nil
+}
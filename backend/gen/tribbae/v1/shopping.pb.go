@@ -0,0 +1,951 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/shopping.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Recette d'une liste de courses
+type ShoppingListSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Servings      int32                  `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"` // 0 : quantités de la recette telles qu'écrites
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListSource) Reset() {
+	*x = ShoppingListSource{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListSource) ProtoMessage() {}
+
+func (x *ShoppingListSource) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListSource.ProtoReflect.Descriptor instead.
+func (*ShoppingListSource) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShoppingListSource) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ShoppingListSource) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type ShoppingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0 si aucune recette ne donne de quantité
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"` // "1,2 kg de farine"
+	Aisle         string                 `protobuf:"bytes,6,opt,name=aisle,proto3" json:"aisle,omitempty"`
+	LinkIds       []string               `protobuf:"bytes,7,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"` // recettes qui en ont besoin
+	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`
+	CheckedBy     string                 `protobuf:"bytes,9,opt,name=checked_by,json=checkedBy,proto3" json:"checked_by,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShoppingItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetAisle() string {
+	if x != nil {
+		return x.Aisle
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
+	}
+	return nil
+}
+
+func (x *ShoppingItem) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetCheckedBy() string {
+	if x != nil {
+		return x.CheckedBy
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type ShoppingAisle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*ShoppingItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingAisle) Reset() {
+	*x = ShoppingAisle{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingAisle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingAisle) ProtoMessage() {}
+
+func (x *ShoppingAisle) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingAisle.ProtoReflect.Descriptor instead.
+func (*ShoppingAisle) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShoppingAisle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingAisle) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ShoppingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // partagée avec les collaborateurs de ce dossier
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Sources       []*ShoppingListSource  `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+	Aisles        []*ShoppingAisle       `protobuf:"bytes,6,rep,name=aisles,proto3" json:"aisles,omitempty"` // dans l'ordre d'un parcours de magasin
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingList) Reset() {
+	*x = ShoppingList{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingList) ProtoMessage() {}
+
+func (x *ShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingList.ProtoReflect.Descriptor instead.
+func (*ShoppingList) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShoppingList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetSources() []*ShoppingListSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ShoppingList) GetAisles() []*ShoppingAisle {
+	if x != nil {
+		return x.Aisles
+	}
+	return nil
+}
+
+func (x *ShoppingList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShoppingList) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateShoppingListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Recettes de la liste. Vide : tous les liens avec ingrédients de folder_id.
+	Sources       []*ShoppingListSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	FolderId      string                `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShoppingListRequest) Reset() {
+	*x = CreateShoppingListRequest{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShoppingListRequest) ProtoMessage() {}
+
+func (x *CreateShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*CreateShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateShoppingListRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetSources() []*ShoppingListSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *CreateShoppingListRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type CreateShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ShoppingList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShoppingListResponse) Reset() {
+	*x = CreateShoppingListResponse{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShoppingListResponse) ProtoMessage() {}
+
+func (x *CreateShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*CreateShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateShoppingListResponse) GetList() *ShoppingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GetShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListRequest) Reset() {
+	*x = GetShoppingListRequest{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListRequest) ProtoMessage() {}
+
+func (x *GetShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetShoppingListRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type GetShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ShoppingList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListResponse) Reset() {
+	*x = GetShoppingListResponse{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListResponse) ProtoMessage() {}
+
+func (x *GetShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*GetShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetShoppingListResponse) GetList() *ShoppingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ListShoppingListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShoppingListsRequest) Reset() {
+	*x = ListShoppingListsRequest{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShoppingListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShoppingListsRequest) ProtoMessage() {}
+
+func (x *ListShoppingListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShoppingListsRequest.ProtoReflect.Descriptor instead.
+func (*ListShoppingListsRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{8}
+}
+
+type ListShoppingListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*ShoppingList        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShoppingListsResponse) Reset() {
+	*x = ListShoppingListsResponse{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShoppingListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShoppingListsResponse) ProtoMessage() {}
+
+func (x *ListShoppingListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShoppingListsResponse.ProtoReflect.Descriptor instead.
+func (*ListShoppingListsResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListShoppingListsResponse) GetLists() []*ShoppingList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type CheckShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Checked       bool                   `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShoppingItemRequest) Reset() {
+	*x = CheckShoppingItemRequest{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShoppingItemRequest) ProtoMessage() {}
+
+func (x *CheckShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*CheckShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type CheckShoppingItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ShoppingList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShoppingItemResponse) Reset() {
+	*x = CheckShoppingItemResponse{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShoppingItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShoppingItemResponse) ProtoMessage() {}
+
+func (x *CheckShoppingItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShoppingItemResponse.ProtoReflect.Descriptor instead.
+func (*CheckShoppingItemResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckShoppingItemResponse) GetList() *ShoppingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DeleteShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShoppingListRequest) Reset() {
+	*x = DeleteShoppingListRequest{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShoppingListRequest) ProtoMessage() {}
+
+func (x *DeleteShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteShoppingListRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type DeleteShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShoppingListResponse) Reset() {
+	*x = DeleteShoppingListResponse{}
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShoppingListResponse) ProtoMessage() {}
+
+func (x *DeleteShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_shopping_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_shopping_proto_rawDescGZIP(), []int{13}
+}
+
+var File_tribbae_v1_shopping_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_shopping_proto_rawDesc = "" +
+	"\n" +
+	"\x19tribbae/v1/shopping.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"I\n" +
+	"\x12ShoppingListSource\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\x05R\bservings\"\x9b\x02\n" +
+	"\fShoppingItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x14\n" +
+	"\x05aisle\x18\x06 \x01(\tR\x05aisle\x12\x19\n" +
+	"\blink_ids\x18\a \x03(\tR\alinkIds\x12\x18\n" +
+	"\achecked\x18\b \x01(\bR\achecked\x12\x1d\n" +
+	"\n" +
+	"checked_by\x18\t \x01(\tR\tcheckedBy\x129\n" +
+	"\n" +
+	"checked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"S\n" +
+	"\rShoppingAisle\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.tribbae.v1.ShoppingItemR\x05items\"\xcf\x02\n" +
+	"\fShoppingList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\tR\bfolderId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x128\n" +
+	"\asources\x18\x05 \x03(\v2\x1e.tribbae.v1.ShoppingListSourceR\asources\x121\n" +
+	"\x06aisles\x18\x06 \x03(\v2\x19.tribbae.v1.ShoppingAisleR\x06aisles\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x88\x01\n" +
+	"\x19CreateShoppingListRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x128\n" +
+	"\asources\x18\x02 \x03(\v2\x1e.tribbae.v1.ShoppingListSourceR\asources\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\tR\bfolderId\"J\n" +
+	"\x1aCreateShoppingListResponse\x12,\n" +
+	"\x04list\x18\x01 \x01(\v2\x18.tribbae.v1.ShoppingListR\x04list\"1\n" +
+	"\x16GetShoppingListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\"G\n" +
+	"\x17GetShoppingListResponse\x12,\n" +
+	"\x04list\x18\x01 \x01(\v2\x18.tribbae.v1.ShoppingListR\x04list\"\x1a\n" +
+	"\x18ListShoppingListsRequest\"K\n" +
+	"\x19ListShoppingListsResponse\x12.\n" +
+	"\x05lists\x18\x01 \x03(\v2\x18.tribbae.v1.ShoppingListR\x05lists\"f\n" +
+	"\x18CheckShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\bR\achecked\"I\n" +
+	"\x19CheckShoppingItemResponse\x12,\n" +
+	"\x04list\x18\x01 \x01(\v2\x18.tribbae.v1.ShoppingListR\x04list\"4\n" +
+	"\x19DeleteShoppingListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\"\x1c\n" +
+	"\x1aDeleteShoppingListResponse2\xcb\x05\n" +
+	"\x13ShoppingListService\x12\x82\x01\n" +
+	"\x12CreateShoppingList\x12%.tribbae.v1.CreateShoppingListRequest\x1a&.tribbae.v1.CreateShoppingListResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/shopping-lists\x12\x80\x01\n" +
+	"\x0fGetShoppingList\x12\".tribbae.v1.GetShoppingListRequest\x1a#.tribbae.v1.GetShoppingListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/shopping-lists/{list_id}\x12|\n" +
+	"\x11ListShoppingLists\x12$.tribbae.v1.ListShoppingListsRequest\x1a%.tribbae.v1.ListShoppingListsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/shopping-lists\x12\xa1\x01\n" +
+	"\x11CheckShoppingItem\x12$.tribbae.v1.CheckShoppingItemRequest\x1a%.tribbae.v1.CheckShoppingItemResponse\"?\x82\xd3\xe4\x93\x029:\x01*\x1a4/v1/shopping-lists/{list_id}/items/{item_id}/checked\x12\x89\x01\n" +
+	"\x12DeleteShoppingList\x12%.tribbae.v1.DeleteShoppingListRequest\x1a&.tribbae.v1.DeleteShoppingListResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/shopping-lists/{list_id}B5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_shopping_proto_rawDescOnce sync.Once
+	file_tribbae_v1_shopping_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_shopping_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_shopping_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_shopping_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_shopping_proto_rawDesc), len(file_tribbae_v1_shopping_proto_rawDesc)))
+	})
+	return file_tribbae_v1_shopping_proto_rawDescData
+}
+
+var file_tribbae_v1_shopping_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_tribbae_v1_shopping_proto_goTypes = []any{
+	(*ShoppingListSource)(nil),         // 0: tribbae.v1.ShoppingListSource
+	(*ShoppingItem)(nil),               // 1: tribbae.v1.ShoppingItem
+	(*ShoppingAisle)(nil),              // 2: tribbae.v1.ShoppingAisle
+	(*ShoppingList)(nil),               // 3: tribbae.v1.ShoppingList
+	(*CreateShoppingListRequest)(nil),  // 4: tribbae.v1.CreateShoppingListRequest
+	(*CreateShoppingListResponse)(nil), // 5: tribbae.v1.CreateShoppingListResponse
+	(*GetShoppingListRequest)(nil),     // 6: tribbae.v1.GetShoppingListRequest
+	(*GetShoppingListResponse)(nil),    // 7: tribbae.v1.GetShoppingListResponse
+	(*ListShoppingListsRequest)(nil),   // 8: tribbae.v1.ListShoppingListsRequest
+	(*ListShoppingListsResponse)(nil),  // 9: tribbae.v1.ListShoppingListsResponse
+	(*CheckShoppingItemRequest)(nil),   // 10: tribbae.v1.CheckShoppingItemRequest
+	(*CheckShoppingItemResponse)(nil),  // 11: tribbae.v1.CheckShoppingItemResponse
+	(*DeleteShoppingListRequest)(nil),  // 12: tribbae.v1.DeleteShoppingListRequest
+	(*DeleteShoppingListResponse)(nil), // 13: tribbae.v1.DeleteShoppingListResponse
+	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
+}
+var file_tribbae_v1_shopping_proto_depIdxs = []int32{
+	14, // 0: tribbae.v1.ShoppingItem.checked_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: tribbae.v1.ShoppingAisle.items:type_name -> tribbae.v1.ShoppingItem
+	0,  // 2: tribbae.v1.ShoppingList.sources:type_name -> tribbae.v1.ShoppingListSource
+	2,  // 3: tribbae.v1.ShoppingList.aisles:type_name -> tribbae.v1.ShoppingAisle
+	14, // 4: tribbae.v1.ShoppingList.created_at:type_name -> google.protobuf.Timestamp
+	14, // 5: tribbae.v1.ShoppingList.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: tribbae.v1.CreateShoppingListRequest.sources:type_name -> tribbae.v1.ShoppingListSource
+	3,  // 7: tribbae.v1.CreateShoppingListResponse.list:type_name -> tribbae.v1.ShoppingList
+	3,  // 8: tribbae.v1.GetShoppingListResponse.list:type_name -> tribbae.v1.ShoppingList
+	3,  // 9: tribbae.v1.ListShoppingListsResponse.lists:type_name -> tribbae.v1.ShoppingList
+	3,  // 10: tribbae.v1.CheckShoppingItemResponse.list:type_name -> tribbae.v1.ShoppingList
+	4,  // 11: tribbae.v1.ShoppingListService.CreateShoppingList:input_type -> tribbae.v1.CreateShoppingListRequest
+	6,  // 12: tribbae.v1.ShoppingListService.GetShoppingList:input_type -> tribbae.v1.GetShoppingListRequest
+	8,  // 13: tribbae.v1.ShoppingListService.ListShoppingLists:input_type -> tribbae.v1.ListShoppingListsRequest
+	10, // 14: tribbae.v1.ShoppingListService.CheckShoppingItem:input_type -> tribbae.v1.CheckShoppingItemRequest
+	12, // 15: tribbae.v1.ShoppingListService.DeleteShoppingList:input_type -> tribbae.v1.DeleteShoppingListRequest
+	5,  // 16: tribbae.v1.ShoppingListService.CreateShoppingList:output_type -> tribbae.v1.CreateShoppingListResponse
+	7,  // 17: tribbae.v1.ShoppingListService.GetShoppingList:output_type -> tribbae.v1.GetShoppingListResponse
+	9,  // 18: tribbae.v1.ShoppingListService.ListShoppingLists:output_type -> tribbae.v1.ListShoppingListsResponse
+	11, // 19: tribbae.v1.ShoppingListService.CheckShoppingItem:output_type -> tribbae.v1.CheckShoppingItemResponse
+	13, // 20: tribbae.v1.ShoppingListService.DeleteShoppingList:output_type -> tribbae.v1.DeleteShoppingListResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_shopping_proto_init() }
+func file_tribbae_v1_shopping_proto_init() {
+	if File_tribbae_v1_shopping_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_shopping_proto_rawDesc), len(file_tribbae_v1_shopping_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_shopping_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_shopping_proto_depIdxs,
+		MessageInfos:      file_tribbae_v1_shopping_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_shopping_proto = out.File
+	file_tribbae_v1_shopping_proto_goTypes = nil
+	file_tribbae_v1_shopping_proto_depIdxs = nil
+}
@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: tribbae/v1/shopping.proto
+
+package tribbaev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShoppingListService_CreateShoppingList_FullMethodName = "/tribbae.v1.ShoppingListService/CreateShoppingList"
+	ShoppingListService_GetShoppingList_FullMethodName    = "/tribbae.v1.ShoppingListService/GetShoppingList"
+	ShoppingListService_ListShoppingLists_FullMethodName  = "/tribbae.v1.ShoppingListService/ListShoppingLists"
+	ShoppingListService_CheckShoppingItem_FullMethodName  = "/tribbae.v1.ShoppingListService/CheckShoppingItem"
+	ShoppingListService_DeleteShoppingList_FullMethodName = "/tribbae.v1.ShoppingListService/DeleteShoppingList"
+)
+
+// ShoppingListServiceClient is the client API for ShoppingListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShoppingListServiceClient interface {
+	CreateShoppingList(ctx context.Context, in *CreateShoppingListRequest, opts ...grpc.CallOption) (*CreateShoppingListResponse, error)
+	GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*GetShoppingListResponse, error)
+	ListShoppingLists(ctx context.Context, in *ListShoppingListsRequest, opts ...grpc.CallOption) (*ListShoppingListsResponse, error)
+	CheckShoppingItem(ctx context.Context, in *CheckShoppingItemRequest, opts ...grpc.CallOption) (*CheckShoppingItemResponse, error)
+	DeleteShoppingList(ctx context.Context, in *DeleteShoppingListRequest, opts ...grpc.CallOption) (*DeleteShoppingListResponse, error)
+}
+
+type shoppingListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShoppingListServiceClient(cc grpc.ClientConnInterface) ShoppingListServiceClient {
+	return &shoppingListServiceClient{cc}
+}
+
+func (c *shoppingListServiceClient) CreateShoppingList(ctx context.Context, in *CreateShoppingListRequest, opts ...grpc.CallOption) (*CreateShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_CreateShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*GetShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_GetShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) ListShoppingLists(ctx context.Context, in *ListShoppingListsRequest, opts ...grpc.CallOption) (*ListShoppingListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShoppingListsResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_ListShoppingLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) CheckShoppingItem(ctx context.Context, in *CheckShoppingItemRequest, opts ...grpc.CallOption) (*CheckShoppingItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckShoppingItemResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_CheckShoppingItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) DeleteShoppingList(ctx context.Context, in *DeleteShoppingListRequest, opts ...grpc.CallOption) (*DeleteShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_DeleteShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShoppingListServiceServer is the server API for ShoppingListService service.
+// All implementations should embed UnimplementedShoppingListServiceServer
+// for forward compatibility.
+type ShoppingListServiceServer interface {
+	CreateShoppingList(context.Context, *CreateShoppingListRequest) (*CreateShoppingListResponse, error)
+	GetShoppingList(context.Context, *GetShoppingListRequest) (*GetShoppingListResponse, error)
+	ListShoppingLists(context.Context, *ListShoppingListsRequest) (*ListShoppingListsResponse, error)
+	CheckShoppingItem(context.Context, *CheckShoppingItemRequest) (*CheckShoppingItemResponse, error)
+	DeleteShoppingList(context.Context, *DeleteShoppingListRequest) (*DeleteShoppingListResponse, error)
+}
+
+// UnimplementedShoppingListServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShoppingListServiceServer struct{}
+
+func (UnimplementedShoppingListServiceServer) CreateShoppingList(context.Context, *CreateShoppingListRequest) (*CreateShoppingListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShoppingList not implemented")
+}
+func (UnimplementedShoppingListServiceServer) GetShoppingList(context.Context, *GetShoppingListRequest) (*GetShoppingListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShoppingList not implemented")
+}
+func (UnimplementedShoppingListServiceServer) ListShoppingLists(context.Context, *ListShoppingListsRequest) (*ListShoppingListsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShoppingLists not implemented")
+}
+func (UnimplementedShoppingListServiceServer) CheckShoppingItem(context.Context, *CheckShoppingItemRequest) (*CheckShoppingItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckShoppingItem not implemented")
+}
+func (UnimplementedShoppingListServiceServer) DeleteShoppingList(context.Context, *DeleteShoppingListRequest) (*DeleteShoppingListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteShoppingList not implemented")
+}
+func (UnimplementedShoppingListServiceServer) testEmbeddedByValue() {}
+
+// UnsafeShoppingListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShoppingListServiceServer will
+// result in compilation errors.
+type UnsafeShoppingListServiceServer interface {
+	mustEmbedUnimplementedShoppingListServiceServer()
+}
+
+func RegisterShoppingListServiceServer(s grpc.ServiceRegistrar, srv ShoppingListServiceServer) {
+	// If the following call panics, it indicates UnimplementedShoppingListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShoppingListService_ServiceDesc, srv)
+}
+
+func _ShoppingListService_CreateShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).CreateShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_CreateShoppingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).CreateShoppingList(ctx, req.(*CreateShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_GetShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_GetShoppingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, req.(*GetShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_ListShoppingLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShoppingListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).ListShoppingLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_ListShoppingLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).ListShoppingLists(ctx, req.(*ListShoppingListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_CheckShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).CheckShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_CheckShoppingItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).CheckShoppingItem(ctx, req.(*CheckShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_DeleteShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).DeleteShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_DeleteShoppingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).DeleteShoppingList(ctx, req.(*DeleteShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShoppingListService_ServiceDesc is the grpc.ServiceDesc for ShoppingListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShoppingListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tribbae.v1.ShoppingListService",
+	HandlerType: (*ShoppingListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShoppingList",
+			Handler:    _ShoppingListService_CreateShoppingList_Handler,
+		},
+		{
+			MethodName: "GetShoppingList",
+			Handler:    _ShoppingListService_GetShoppingList_Handler,
+		},
+		{
+			MethodName: "ListShoppingLists",
+			Handler:    _ShoppingListService_ListShoppingLists_Handler,
+		},
+		{
+			MethodName: "CheckShoppingItem",
+			Handler:    _ShoppingListService_CheckShoppingItem_Handler,
+		},
+		{
+			MethodName: "DeleteShoppingList",
+			Handler:    _ShoppingListService_DeleteShoppingList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tribbae/v1/shopping.proto",
+}
@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tribbae/v1/trash.proto
+
+package tribbaev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TrashItemType int32
+
+const (
+	TrashItemType_TRASH_ITEM_TYPE_UNSPECIFIED TrashItemType = 0
+	TrashItemType_TRASH_ITEM_TYPE_FOLDER      TrashItemType = 1
+	TrashItemType_TRASH_ITEM_TYPE_LINK        TrashItemType = 2
+)
+
+// Enum value maps for TrashItemType.
+var (
+	TrashItemType_name = map[int32]string{
+		0: "TRASH_ITEM_TYPE_UNSPECIFIED",
+		1: "TRASH_ITEM_TYPE_FOLDER",
+		2: "TRASH_ITEM_TYPE_LINK",
+	}
+	TrashItemType_value = map[string]int32{
+		"TRASH_ITEM_TYPE_UNSPECIFIED": 0,
+		"TRASH_ITEM_TYPE_FOLDER":      1,
+		"TRASH_ITEM_TYPE_LINK":        2,
+	}
+)
+
+func (x TrashItemType) Enum() *TrashItemType {
+	p := new(TrashItemType)
+	*p = x
+	return p
+}
+
+func (x TrashItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrashItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tribbae_v1_trash_proto_enumTypes[0].Descriptor()
+}
+
+func (TrashItemType) Type() protoreflect.EnumType {
+	return &file_tribbae_v1_trash_proto_enumTypes[0]
+}
+
+func (x TrashItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrashItemType.Descriptor instead.
+func (TrashItemType) EnumDescriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{0}
+}
+
+type TrashItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TrashItemType          `protobuf:"varint,2,opt,name=type,proto3,enum=tribbae.v1.TrashItemType" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                       // nom du dossier ou titre du lien
+	FolderId      string                 `protobuf:"bytes,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // dossier d'origine d'un lien
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // suppression définitive automatique
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetType() TrashItemType {
+	if x != nil {
+		return x.Type
+	}
+	return TrashItemType_TRASH_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *TrashItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashItem) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{1}
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TrashItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TrashItemType          `protobuf:"varint,2,opt,name=type,proto3,enum=tribbae.v1.TrashItemType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RestoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreRequest) GetType() TrashItemType {
+	if x != nil {
+		return x.Type
+	}
+	return TrashItemType_TRASH_ITEM_TYPE_UNSPECIFIED
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{4}
+}
+
+type EmptyTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyTrashRequest) Reset() {
+	*x = EmptyTrashRequest{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashRequest) ProtoMessage() {}
+
+func (x *EmptyTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashRequest.ProtoReflect.Descriptor instead.
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{5}
+}
+
+type EmptyTrashResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FoldersDeleted int32                  `protobuf:"varint,1,opt,name=folders_deleted,json=foldersDeleted,proto3" json:"folders_deleted,omitempty"`
+	LinksDeleted   int32                  `protobuf:"varint,2,opt,name=links_deleted,json=linksDeleted,proto3" json:"links_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EmptyTrashResponse) Reset() {
+	*x = EmptyTrashResponse{}
+	mi := &file_tribbae_v1_trash_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashResponse) ProtoMessage() {}
+
+func (x *EmptyTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tribbae_v1_trash_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashResponse.ProtoReflect.Descriptor instead.
+func (*EmptyTrashResponse) Descriptor() ([]byte, []int) {
+	return file_tribbae_v1_trash_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmptyTrashResponse) GetFoldersDeleted() int32 {
+	if x != nil {
+		return x.FoldersDeleted
+	}
+	return 0
+}
+
+func (x *EmptyTrashResponse) GetLinksDeleted() int32 {
+	if x != nil {
+		return x.LinksDeleted
+	}
+	return 0
+}
+
+var File_tribbae_v1_trash_proto protoreflect.FileDescriptor
+
+const file_tribbae_v1_trash_proto_rawDesc = "" +
+	"\n" +
+	"\x16tribbae/v1/trash.proto\x12\n" +
+	"tribbae.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
+	"\tTrashItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.tribbae.v1.TrashItemTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\tR\bfolderId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"\x12\n" +
+	"\x10ListTrashRequest\"@\n" +
+	"\x11ListTrashResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.tribbae.v1.TrashItemR\x05items\"O\n" +
+	"\x0eRestoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.tribbae.v1.TrashItemTypeR\x04type\"\x11\n" +
+	"\x0fRestoreResponse\"\x13\n" +
+	"\x11EmptyTrashRequest\"b\n" +
+	"\x12EmptyTrashResponse\x12'\n" +
+	"\x0ffolders_deleted\x18\x01 \x01(\x05R\x0efoldersDeleted\x12#\n" +
+	"\rlinks_deleted\x18\x02 \x01(\x05R\flinksDeleted*f\n" +
+	"\rTrashItemType\x12\x1f\n" +
+	"\x1bTRASH_ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRASH_ITEM_TYPE_FOLDER\x10\x01\x12\x18\n" +
+	"\x14TRASH_ITEM_TYPE_LINK\x10\x022\xb2\x02\n" +
+	"\fTrashService\x12[\n" +
+	"\tListTrash\x12\x1c.tribbae.v1.ListTrashRequest\x1a\x1d.tribbae.v1.ListTrashResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trash\x12e\n" +
+	"\aRestore\x12\x1a.tribbae.v1.RestoreRequest\x1a\x1b.tribbae.v1.RestoreResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/trash/{id}/restore\x12^\n" +
+	"\n" +
+	"EmptyTrash\x12\x1d.tribbae.v1.EmptyTrashRequest\x1a\x1e.tribbae.v1.EmptyTrashResponse\"\x11\x82\xd3\xe4\x93\x02\v*\t/v1/trashB5Z3github.com/tribbae/backend/gen/tribbae/v1;tribbaev1b\x06proto3"
+
+var (
+	file_tribbae_v1_trash_proto_rawDescOnce sync.Once
+	file_tribbae_v1_trash_proto_rawDescData []byte
+)
+
+func file_tribbae_v1_trash_proto_rawDescGZIP() []byte {
+	file_tribbae_v1_trash_proto_rawDescOnce.Do(func() {
+		file_tribbae_v1_trash_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tribbae_v1_trash_proto_rawDesc), len(file_tribbae_v1_trash_proto_rawDesc)))
+	})
+	return file_tribbae_v1_trash_proto_rawDescData
+}
+
+var file_tribbae_v1_trash_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tribbae_v1_trash_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_tribbae_v1_trash_proto_goTypes = []any{
+	(TrashItemType)(0),            // 0: tribbae.v1.TrashItemType
+	(*TrashItem)(nil),             // 1: tribbae.v1.TrashItem
+	(*ListTrashRequest)(nil),      // 2: tribbae.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),     // 3: tribbae.v1.ListTrashResponse
+	(*RestoreRequest)(nil),        // 4: tribbae.v1.RestoreRequest
+	(*RestoreResponse)(nil),       // 5: tribbae.v1.RestoreResponse
+	(*EmptyTrashRequest)(nil),     // 6: tribbae.v1.EmptyTrashRequest
+	(*EmptyTrashResponse)(nil),    // 7: tribbae.v1.EmptyTrashResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_tribbae_v1_trash_proto_depIdxs = []int32{
+	0, // 0: tribbae.v1.TrashItem.type:type_name -> tribbae.v1.TrashItemType
+	8, // 1: tribbae.v1.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	8, // 2: tribbae.v1.TrashItem.purge_at:type_name -> google.protobuf.Timestamp
+	1, // 3: tribbae.v1.ListTrashResponse.items:type_name -> tribbae.v1.TrashItem
+	0, // 4: tribbae.v1.RestoreRequest.type:type_name -> tribbae.v1.TrashItemType
+	2, // 5: tribbae.v1.TrashService.ListTrash:input_type -> tribbae.v1.ListTrashRequest
+	4, // 6: tribbae.v1.TrashService.Restore:input_type -> tribbae.v1.RestoreRequest
+	6, // 7: tribbae.v1.TrashService.EmptyTrash:input_type -> tribbae.v1.EmptyTrashRequest
+	3, // 8: tribbae.v1.TrashService.ListTrash:output_type -> tribbae.v1.ListTrashResponse
+	5, // 9: tribbae.v1.TrashService.Restore:output_type -> tribbae.v1.RestoreResponse
+	7, // 10: tribbae.v1.TrashService.EmptyTrash:output_type -> tribbae.v1.EmptyTrashResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tribbae_v1_trash_proto_init() }
+func file_tribbae_v1_trash_proto_init() {
+	if File_tribbae_v1_trash_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tribbae_v1_trash_proto_rawDesc), len(file_tribbae_v1_trash_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tribbae_v1_trash_proto_goTypes,
+		DependencyIndexes: file_tribbae_v1_trash_proto_depIdxs,
+		EnumInfos:         file_tribbae_v1_trash_proto_enumTypes,
+		MessageInfos:      file_tribbae_v1_trash_proto_msgTypes,
+	}.Build()
+	File_tribbae_v1_trash_proto = out.File
+	file_tribbae_v1_trash_proto_goTypes = nil
+	file_tribbae_v1_trash_proto_depIdxs = nil
+}
@@ -0,0 +1,148 @@
+// Package agerange lit les âges conseillés écrits librement sur les liens
+// (« 3-6 ans », « dès 18 mois », « moins de 3 ans ») en bornes exprimées en
+// mois, comparables à l'âge des enfants.
+package agerange
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// Unbounded est la borne haute d'un âge sans limite (« dès 3 ans »)
+const Unbounded = 100 * 12
+
+// Range est une tranche d'âge, bornes incluses : « 3-6 ans » va de 36 à 83
+// mois, jusqu'à la veille des 7 ans
+type Range struct {
+	MinMonths int32 `bson:"min_months" json:"min_months"`
+	MaxMonths int32 `bson:"max_months" json:"max_months"`
+}
+
+// Contains indique si un enfant de months mois est dans la tranche
+func (r Range) Contains(months int32) bool {
+	return r.MinMonths <= months && months <= r.MaxMonths
+}
+
+var (
+	numberRe = regexp.MustCompile(`(\d+(?:[.,]\d+)?)\s*(mois|ans|an)?\b`)
+	// words sont les tranches nommées, utilisées quand le texte n'a pas de nombre
+	words = []struct {
+		word string
+		r    Range
+	}{
+		{"tout age", Range{0, Unbounded}},
+		{"tous ages", Range{0, Unbounded}},
+		{"famille", Range{0, Unbounded}},
+		{"bebe", Range{0, 23}},
+		{"maternelle", Range{36, 71}},
+		{"primaire", Range{72, 131}},
+		{"ado", Range{144, 215}},
+		{"adulte", Range{216, Unbounded}},
+	}
+	// upperMarks précèdent une borne haute, incluse sauf après strictMarks
+	upperMarks  = []string{"jusqu", "max", "au plus"}
+	strictMarks = []string{"moins de", "avant", "<"}
+)
+
+type age struct {
+	value float64
+	unit  string // "mois" ou "ans"
+}
+
+// months retourne l'âge en mois ; upper donne le dernier mois de l'année
+// citée (« 6 ans » va jusqu'à 83 mois)
+func (a age) months(upper bool) int32 {
+	if a.unit == "mois" {
+		return int32(math.Floor(a.value))
+	}
+	m := int32(math.Floor(a.value * 12))
+	if upper {
+		m += 11
+	}
+	return m
+}
+
+// Parse lit un âge conseillé. Un âge seul (« 3 ans ») est une borne basse,
+// comme sur les jouets. ok est faux si le texte n'indique pas d'âge.
+func Parse(s string) (r Range, ok bool) {
+	t := " " + strings.ReplaceAll(textutil.Fold(s), "naissance", "0") + " "
+	matches := numberRe.FindAllStringSubmatchIndex(t, -1)
+	if len(matches) == 0 {
+		for _, w := range words {
+			if strings.Contains(t, w.word) {
+				return w.r, true
+			}
+		}
+		return Range{}, false
+	}
+	if len(matches) > 2 {
+		matches = matches[:2]
+	}
+
+	ages := make([]age, len(matches))
+	for i, m := range matches {
+		v, err := strconv.ParseFloat(strings.Replace(t[m[2]:m[3]], ",", ".", 1), 64)
+		if err != nil {
+			return Range{}, false
+		}
+		ages[i].value = v
+		if m[4] >= 0 {
+			ages[i].unit = t[m[4]:m[5]]
+		}
+	}
+	// « 2-4 ans », « 0-12 mois » : l'unité du second âge vaut pour le premier
+	for i := len(ages) - 1; i >= 0; i-- {
+		switch {
+		case ages[i].unit == "an":
+			ages[i].unit = "ans"
+		case ages[i].unit != "":
+		case i+1 < len(ages):
+			ages[i].unit = ages[i+1].unit
+		default:
+			ages[i].unit = "ans"
+		}
+	}
+
+	before := t[:matches[0][0]]
+	switch {
+	case len(ages) == 2:
+		r = Range{ages[0].months(false), ages[1].months(true)}
+	case containsAny(before, strictMarks):
+		r = Range{0, ages[0].months(false) - 1}
+	case containsAny(before, upperMarks):
+		r = Range{0, ages[0].months(true)}
+	default:
+		// « dès 3 ans », « 3 ans et + » ou « 3 ans »
+		r = Range{ages[0].months(false), Unbounded}
+	}
+	if r.MinMonths > r.MaxMonths || r.MaxMonths < 0 {
+		return Range{}, false
+	}
+	r.MaxMonths = min(r.MaxMonths, Unbounded)
+	return r, true
+}
+
+func containsAny(s string, marks []string) bool {
+	for _, m := range marks {
+		if strings.Contains(s, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// AgeMonths retourne l'âge en mois révolus, à la date now, d'un enfant né à birth
+func AgeMonths(birth, now time.Time) int32 {
+	by, bm, bd := birth.Date()
+	ny, nm, nd := now.In(birth.Location()).Date()
+	months := (ny-by)*12 + int(nm-bm)
+	if nd < bd {
+		months--
+	}
+	return int32(max(months, 0))
+}
@@ -0,0 +1,73 @@
+package agerange
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Range
+		ok   bool
+	}{
+		{"3-6 ans", Range{36, 83}, true},
+		{"3 - 6 ans", Range{36, 83}, true},
+		{"de 3 à 6 ans", Range{36, 83}, true},
+		{"0-12 mois", Range{0, 12}, true},
+		{"18 mois - 3 ans", Range{18, 47}, true},
+		{"6 mois à 2 ans", Range{6, 35}, true},
+		{"naissance - 3 ans", Range{0, 47}, true},
+		{"Dès 18 mois", Range{18, Unbounded}, true},
+		{"à partir de 4 ans", Range{48, Unbounded}, true},
+		{"3 ans et +", Range{36, Unbounded}, true},
+		{"6+", Range{72, Unbounded}, true},
+		{"8 ans", Range{96, Unbounded}, true},
+		{"1an", Range{12, Unbounded}, true},
+		{"2,5 ans", Range{30, Unbounded}, true},
+		{"moins de 3 ans", Range{0, 35}, true},
+		{"avant 18 mois", Range{0, 17}, true},
+		{"jusqu'à 6 ans", Range{0, 83}, true},
+		{"Tout âge", Range{0, Unbounded}, true},
+		{"Adultes", Range{216, Unbounded}, true},
+		{"Bébé", Range{0, 23}, true},
+		{"", Range{}, false},
+		{"pour les grands", Range{}, false},
+		{"6-3 ans", Range{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q) = %+v, %v; want %+v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAgeMonths(t *testing.T) {
+	birth := time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		now  time.Time
+		want int32
+	}{
+		{time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2020, 4, 14, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2020, 4, 15, 0, 0, 0, 0, time.UTC), 1},
+		{time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC), 35},
+		{time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC), 36},
+		{time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		if got := AgeMonths(birth, tt.now); got != tt.want {
+			t.Errorf("AgeMonths(%s) = %d, want %d", tt.now.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	r := Range{36, 83}
+	for m, want := range map[int32]bool{35: false, 36: true, 83: true, 84: false} {
+		if r.Contains(m) != want {
+			t.Errorf("Contains(%d) = %v", m, !want)
+		}
+	}
+}
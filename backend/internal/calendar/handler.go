@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedCalendarServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrFeedNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (h *Handler) toProto(f *Feed) *pb.CalendarFeed {
+	url := h.svc.URL(f)
+	return &pb.CalendarFeed{
+		FolderId:  f.FolderID,
+		Url:       url,
+		WebcalUrl: WebcalURL(url),
+		CreatedAt: timestamppb.New(f.CreatedAt),
+	}
+}
+
+func (h *Handler) GetCalendarFeed(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	f, err := h.svc.Get(ctx, userID, req.FolderId, req.Rotate)
+	if err != nil {
+		return nil, serviceError(err, "get calendar feed")
+	}
+	return &pb.GetCalendarFeedResponse{Feed: h.toProto(f)}, nil
+}
+
+func (h *Handler) RevokeCalendarFeed(ctx context.Context, req *pb.RevokeCalendarFeedRequest) (*pb.RevokeCalendarFeedResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.Revoke(ctx, userID, req.FolderId); err != nil {
+		return nil, serviceError(err, "revoke calendar feed")
+	}
+	return &pb.RevokeCalendarFeedResponse{}, nil
+}
+
+// FeedPrefix est le chemin des flux servis par FeedHandler : FeedPrefix + <jeton>.ics
+const FeedPrefix = "/v1/calendar/"
+
+// FeedHandler sert les flux d'abonnement au format iCalendar. Le jeton de
+// l'adresse tient lieu d'authentification, les agendas n'envoyant pas
+// d'en-tête Authorization.
+func (s *Service) FeedHandler() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, FeedPrefix), ".ics")
+		if !ok || strings.Contains(token, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		cal, err := s.Calendar(r.Context(), token)
+		if errors.Is(err, ErrFeedNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			log.Printf("ERROR: calendar feed: %v", err)
+			http.Error(w, "calendar unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="tribbae.ics"`)
+		w.Header().Set("Cache-Control", "private, max-age=900")
+		if err := cal.Write(w); err != nil {
+			log.Printf("ERROR: write calendar feed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+// Package calendar publie les liens événements et les anniversaires des
+// enfants sous forme de calendriers iCalendar auxquels les agendas des
+// téléphones peuvent s'abonner. Chaque flux est identifié par un jeton
+// secret, sans authentification.
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/ical"
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// eventCategory est la catégorie des liens publiés dans les flux
+	eventCategory = "LINK_CATEGORY_EVENEMENT"
+	// reminderLead est l'avance des rappels, la même que les notifications
+	// de l'application Android
+	reminderLead = 24 * time.Hour
+	// pastWindow limite les événements passés publiés dans un flux
+	pastWindow = 365 * 24 * time.Hour
+	// maxEvents limite la taille d'un flux
+	maxEvents = 1000
+	// refreshInterval est la fréquence de mise à jour conseillée aux agendas
+	refreshInterval = time.Hour
+	// defaultBirthdayLead est l'avance du rappel des anniversaires, le temps
+	// de prévoir les cadeaux
+	defaultBirthdayLead = 14 * 24 * time.Hour
+)
+
+var (
+	ErrFeedNotFound  = errors.New("calendar feed not found")
+	ErrInvalidFolder = errors.New("folder not found or not accessible")
+)
+
+// LinkLister liste les liens d'un utilisateur. Implémenté par link.Service.
+type LinkLister interface {
+	List(ctx context.Context, userID string, opts link.ListOptions) ([]*link.Link, string, error)
+}
+
+// Feed est un flux d'abonnement. Sans FolderID, il publie les événements de
+// l'utilisateur et de ses dossiers partagés ; sinon ceux du dossier.
+type Feed struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Token     string             `bson:"token"         json:"-"`
+	UserID    string             `bson:"user_id"       json:"user_id"`
+	FolderID  string             `bson:"folder_id"     json:"folder_id"`
+	CreatedAt time.Time          `bson:"created_at"    json:"created_at"`
+}
+
+type Service struct {
+	col          *mongo.Collection
+	folderCol    *mongo.Collection
+	childCol     *mongo.Collection
+	links        LinkLister
+	baseURL      string
+	birthdayLead time.Duration
+}
+
+func NewService(col, folderCol *mongo.Collection, links LinkLister, baseURL string) *Service {
+	return &Service{
+		col:          col,
+		folderCol:    folderCol,
+		childCol:     folderCol.Database().Collection("children"),
+		links:        links,
+		baseURL:      baseURL,
+		birthdayLead: defaultBirthdayLead,
+	}
+}
+
+// SetBirthdayLead règle l'avance du rappel des anniversaires (0 : sans rappel)
+func (s *Service) SetBirthdayLead(lead time.Duration) {
+	s.birthdayLead = lead
+}
+
+// URL retourne l'adresse d'abonnement du flux
+func (s *Service) URL(f *Feed) string {
+	return strings.TrimSuffix(s.baseURL, "/") + "/v1/calendar/" + f.Token + ".ics"
+}
+
+// WebcalURL retourne l'adresse webcal:// du flux, qui ouvre directement
+// l'abonnement dans l'agenda du téléphone
+func WebcalURL(feedURL string) string {
+	if i := strings.Index(feedURL, "://"); i >= 0 {
+		return "webcal" + feedURL[i:]
+	}
+	return feedURL
+}
+
+// Get retourne le flux de l'utilisateur, pour un dossier ou pour tous ses
+// événements si folderID est vide, en le créant au premier appel. rotate
+// remplace le jeton : l'ancienne adresse cesse de fonctionner.
+func (s *Service) Get(ctx context.Context, userID, folderID string, rotate bool) (*Feed, error) {
+	if folderID != "" {
+		if _, ok := s.folderName(ctx, userID, folderID); !ok {
+			return nil, ErrInvalidFolder
+		}
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	fields := bson.M{"token": token, "created_at": time.Now()}
+	update := bson.M{"$setOnInsert": fields}
+	if rotate {
+		update = bson.M{"$set": fields}
+	}
+	var f Feed
+	err = s.col.FindOneAndUpdate(ctx,
+		bson.M{"user_id": userID, "folder_id": folderID},
+		update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Revoke supprime le flux : son adresse cesse de fonctionner
+func (s *Service) Revoke(ctx context.Context, userID, folderID string) error {
+	res, err := s.col.DeleteOne(ctx, bson.M{"user_id": userID, "folder_id": folderID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrFeedNotFound
+	}
+	return nil
+}
+
+// Calendar construit le calendrier du flux de jeton token. Les droits sont
+// ceux de l'utilisateur au moment de la lecture : un collaborateur retiré
+// d'un dossier ne reçoit plus ses événements.
+func (s *Service) Calendar(ctx context.Context, token string) (*ical.Calendar, error) {
+	if token == "" {
+		return nil, ErrFeedNotFound
+	}
+	var f Feed
+	if err := s.col.FindOne(ctx, bson.M{"token": token}).Decode(&f); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, err
+	}
+	cal := &ical.Calendar{Name: "Tribbae — Événements", RefreshInterval: refreshInterval}
+	if f.FolderID != "" {
+		name, ok := s.folderName(ctx, f.UserID, f.FolderID)
+		if !ok {
+			return nil, ErrFeedNotFound
+		}
+		cal.Name = "Tribbae — " + name
+	}
+
+	after := time.Now().Add(-pastWindow)
+	opts := link.ListOptions{
+		FolderID:   f.FolderID,
+		Category:   eventCategory,
+		EventAfter: &after,
+		SortBy:     link.SortByEventAt,
+		PageSize:   500,
+	}
+	for len(cal.Events) < maxEvents {
+		links, next, err := s.links.List(ctx, f.UserID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range links {
+			if e, ok := eventOf(l); ok && len(cal.Events) < maxEvents {
+				cal.Events = append(cal.Events, e)
+			}
+		}
+		if next == "" {
+			break
+		}
+		opts.PageToken = next
+	}
+
+	children, err := s.children(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+	until := time.Now().AddDate(1, 0, 0)
+	for _, c := range children {
+		cal.Events = append(cal.Events, birthdayEvents(c, after, until, s.birthdayLead)...)
+	}
+	return cal, nil
+}
+
+// children retourne les enfants dont le flux publie les anniversaires : ceux
+// de l'utilisateur (et de ses co-parents et de son foyer), ou ceux rattachés
+// au dossier du flux
+func (s *Service) children(ctx context.Context, f *Feed) ([]*child.Child, error) {
+	var filter bson.M
+	if f.FolderID == "" {
+		owner, err := primitive.ObjectIDFromHex(f.UserID)
+		if err != nil {
+			return nil, nil
+		}
+		if filter, err = child.FamilyAccessFilter(ctx, s.childCol.Database(), owner); err != nil {
+			return nil, err
+		}
+	} else {
+		fid, err := primitive.ObjectIDFromHex(f.FolderID)
+		if err != nil {
+			return nil, nil
+		}
+		var folder struct {
+			OwnerID  string   `bson:"owner_id"`
+			ChildIDs []string `bson:"child_ids"`
+		}
+		if err := s.folderCol.FindOne(ctx, bson.M{"_id": fid}).Decode(&folder); err != nil {
+			return nil, err
+		}
+		owner, err := primitive.ObjectIDFromHex(folder.OwnerID)
+		if err != nil || len(folder.ChildIDs) == 0 {
+			return nil, nil
+		}
+		ids := make([]primitive.ObjectID, 0, len(folder.ChildIDs))
+		for _, id := range folder.ChildIDs {
+			if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+				ids = append(ids, oid)
+			}
+		}
+		if filter, err = child.FamilyAccessFilter(ctx, s.childCol.Database(), owner); err != nil {
+			return nil, err
+		}
+		filter["_id"] = bson.M{"$in": ids}
+	}
+	cursor, err := s.childCol.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var children []*child.Child
+	return children, cursor.All(ctx, &children)
+}
+
+// birthdayEvents retourne les anniversaires de l'enfant entre from et to,
+// d'une journée entière, avec un rappel lead avant pour prévoir les cadeaux
+func birthdayEvents(c *child.Child, from, to time.Time, lead time.Duration) []ical.Event {
+	birthYear, _, _ := c.Birthday()
+	var events []ical.Event
+	for year := from.Year(); year <= to.Year(); year++ {
+		day := c.BirthdayIn(year, time.UTC)
+		age := year - birthYear
+		if age < 1 || day.Before(from) || day.After(to) {
+			continue
+		}
+		e := ical.Event{
+			UID:     fmt.Sprintf("birthday-%s-%d@tribbae", c.ID.Hex(), year),
+			Summary: fmt.Sprintf("Anniversaire de %s (%d ans)", c.Name, age),
+			Start:   day,
+			End:     day.AddDate(0, 0, 1),
+			AllDay:  true,
+			Updated: time.Unix(c.UpdatedAt, 0),
+		}
+		if lead > 0 {
+			e.Alarms = []time.Duration{lead}
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// eventOf convertit un lien daté en événement, d'une journée entière ou
+// d'une heure selon sa date
+func eventOf(l *link.Link) (ical.Event, bool) {
+	if l.EventAt == nil {
+		return ical.Event{}, false
+	}
+	start := l.EventAt.UTC()
+	e := ical.Event{
+		UID:         l.ID.Hex() + "@tribbae",
+		Summary:     l.Title,
+		Description: l.Description,
+		URL:         l.URL,
+		Location:    l.Location,
+		Start:       start,
+		Updated:     l.UpdatedAt,
+	}
+	if l.AllDayEvent() {
+		e.AllDay = true
+		e.End = start.AddDate(0, 0, 1)
+	} else {
+		e.End = start.Add(time.Hour)
+	}
+	if l.ReminderEnabled {
+		e.Alarms = []time.Duration{reminderLead}
+	}
+	return e, true
+}
+
+// folderName retourne le nom d'un dossier visible par l'utilisateur
+func (s *Service) folderName(ctx context.Context, userID, folderID string) (string, bool) {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return "", false
+	}
+	var folder struct {
+		Name string `bson:"name"`
+	}
+	err = s.folderCol.FindOne(ctx, bson.M{
+		"_id":        fid,
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"owner_id": userID},
+			bson.M{"collaborators.user_id": userID},
+		},
+	}).Decode(&folder)
+	if err != nil {
+		return "", false
+	}
+	return folder.Name, true
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,180 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func TestEventOf(t *testing.T) {
+	day := time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)
+	e, ok := eventOf(&link.Link{ID: primitive.NewObjectID(), Title: "Kermesse", EventAt: &day, ReminderEnabled: true})
+	if !ok || !e.AllDay || !e.End.Equal(day.AddDate(0, 0, 1)) {
+		t.Errorf("all-day event = %+v, %v", e, ok)
+	}
+	if len(e.Alarms) != 1 || e.Alarms[0] != 24*time.Hour {
+		t.Errorf("alarms = %v", e.Alarms)
+	}
+
+	at := time.Date(2026, 6, 20, 14, 30, 0, 0, time.UTC)
+	e, ok = eventOf(&link.Link{ID: primitive.NewObjectID(), Title: "Spectacle", EventAt: &at})
+	if !ok || e.AllDay || !e.End.Equal(at.Add(time.Hour)) || len(e.Alarms) != 0 {
+		t.Errorf("timed event = %+v, %v", e, ok)
+	}
+
+	if _, ok := eventOf(&link.Link{Title: "Sans date"}); ok {
+		t.Error("link without date should not give an event")
+	}
+}
+
+func TestWebcalURL(t *testing.T) {
+	if got := WebcalURL("https://tribbae.fr/v1/calendar/abc.ics"); got != "webcal://tribbae.fr/v1/calendar/abc.ics" {
+		t.Errorf("WebcalURL = %q", got)
+	}
+}
+
+// Un collaborateur s'abonne au flux d'un dossier ; l'abonnement cesse quand
+// le jeton est renouvelé ou quand il perd l'accès au dossier
+func TestFeed_Folder(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	svc := NewService(db.Collection("calendar_feeds"), db.Collection("folders"), links, "https://tribbae.fr")
+	ownerID := primitive.NewObjectID().Hex()
+	viewerID := primitive.NewObjectID().Hex()
+
+	folderID := primitive.NewObjectID()
+	if _, err := db.Collection("folders").InsertOne(ctx, bson.M{
+		"_id": folderID, "owner_id": ownerID, "name": "Sorties",
+		"collaborators": bson.A{bson.M{"user_id": viewerID, "role": "viewer"}},
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	fid := folderID.Hex()
+	soon := time.Now().AddDate(0, 1, 0).UTC().Truncate(24 * time.Hour)
+	for _, l := range []*link.Link{
+		{FolderID: fid, Title: "Kermesse", Category: eventCategory, EventDate: soon.Unix(), ReminderEnabled: true},
+		{FolderID: fid, Title: "Vieux spectacle", Category: eventCategory, EventDate: time.Now().AddDate(-2, 0, 0).Unix()},
+		{FolderID: fid, Title: "Crêpes", Category: "LINK_CATEGORY_RECETTE", EventDate: soon.Unix()},
+	} {
+		if _, err := links.Create(ctx, ownerID, l); err != nil {
+			t.Fatalf("create link: %v", err)
+		}
+	}
+
+	if _, err := svc.Get(ctx, primitive.NewObjectID().Hex(), fid, false); !errors.Is(err, ErrInvalidFolder) {
+		t.Errorf("stranger get err = %v, want ErrInvalidFolder", err)
+	}
+	feed, err := svc.Get(ctx, viewerID, fid, false)
+	if err != nil {
+		t.Fatalf("get feed: %v", err)
+	}
+	if again, err := svc.Get(ctx, viewerID, fid, false); err != nil || again.Token != feed.Token {
+		t.Errorf("second get = %+v, %v; want same token", again, err)
+	}
+
+	serve := func(token string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		svc.FeedHandler()(rec, httptest.NewRequest(http.MethodGet, FeedPrefix+token+".ics", nil))
+		return rec
+	}
+	rec := serve(feed.Token)
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/calendar") {
+		t.Fatalf("feed = %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	out := rec.Body.String()
+	if !strings.Contains(out, "X-WR-CALNAME:Tribbae — Sorties") ||
+		!strings.Contains(out, "SUMMARY:Kermesse") ||
+		!strings.Contains(out, "DTSTART;VALUE=DATE:"+soon.Format("20060102")) ||
+		!strings.Contains(out, "TRIGGER:-P1D") {
+		t.Errorf("unexpected feed:\n%s", out)
+	}
+	if strings.Contains(out, "Vieux spectacle") || strings.Contains(out, "Crêpes") {
+		t.Errorf("feed should only hold upcoming events:\n%s", out)
+	}
+
+	rotated, err := svc.Get(ctx, viewerID, fid, true)
+	if err != nil || rotated.Token == feed.Token {
+		t.Fatalf("rotate = %+v, %v", rotated, err)
+	}
+	if rec := serve(feed.Token); rec.Code != http.StatusNotFound {
+		t.Errorf("old token = %d, want 404", rec.Code)
+	}
+
+	if _, err := db.Collection("folders").UpdateOne(ctx, bson.M{"_id": folderID}, bson.M{"$set": bson.M{"collaborators": bson.A{}}}); err != nil {
+		t.Fatalf("remove collaborator: %v", err)
+	}
+	if rec := serve(rotated.Token); rec.Code != http.StatusNotFound {
+		t.Errorf("removed collaborator feed = %d, want 404", rec.Code)
+	}
+	if err := svc.Revoke(ctx, viewerID, fid); err != nil {
+		t.Errorf("revoke: %v", err)
+	}
+	if err := svc.Revoke(ctx, viewerID, fid); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("second revoke err = %v, want ErrFeedNotFound", err)
+	}
+}
+
+func TestBirthdayEvents(t *testing.T) {
+	lea := &child.Child{ID: primitive.NewObjectID(), Name: "Léa", BirthDate: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli()}
+	from := time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC)
+	events := birthdayEvents(lea, from, from.AddDate(1, 0, 0), 14*24*time.Hour)
+	if len(events) != 1 {
+		t.Fatalf("events = %+v, want the 2026 birthday", events)
+	}
+	e := events[0]
+	if e.Summary != "Anniversaire de Léa (6 ans)" || !e.AllDay || !e.Start.Equal(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("event = %+v", e)
+	}
+	if len(e.Alarms) != 1 || e.Alarms[0] != 14*24*time.Hour {
+		t.Errorf("alarms = %v", e.Alarms)
+	}
+
+	// Pas d'anniversaire avant le premier
+	baby := &child.Child{ID: primitive.NewObjectID(), Name: "Tom", BirthDate: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC).UnixMilli()}
+	if got := birthdayEvents(baby, from, from.AddDate(1, 0, 0), 0); len(got) != 0 {
+		t.Errorf("newborn events = %+v", got)
+	}
+}
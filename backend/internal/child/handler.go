@@ -2,6 +2,7 @@ package child
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/tribbae/backend/gen/tribbae/v1"
 	"github.com/tribbae/backend/internal/interceptor"
@@ -19,6 +20,38 @@ func NewHandler(svc *Service) *Handler {
 	return &Handler{svc: svc}
 }
 
+func childToProto(c *Child) *pb.Child {
+	out := &pb.Child{
+		Id:        c.ID.Hex(),
+		OwnerId:   c.OwnerID.Hex(),
+		Name:      c.Name,
+		BirthDate: c.BirthDate,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+	for _, id := range c.CoParentIDs {
+		out.CoParentIds = append(out.CoParentIds, id.Hex())
+	}
+	for _, id := range c.PendingCoParentIDs {
+		out.PendingCoParentIds = append(out.PendingCoParentIds, id.Hex())
+	}
+	return out
+}
+
+// serviceError traduit les erreurs du partage en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrChildNotFound), errors.Is(err, ErrUserNotFound), errors.Is(err, ErrNotCoParent), errors.Is(err, ErrInviteNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotOwner):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrAlreadyParent), errors.Is(err, ErrAlreadyInvited):
+		return status.Errorf(codes.AlreadyExists, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
 func (h *Handler) CreateChild(ctx context.Context, req *pb.CreateChildRequest) (*pb.CreateChildResponse, error) {
 	ownerID, err := ownerIDFromContext(ctx)
 	if err != nil {
@@ -30,16 +63,7 @@ func (h *Handler) CreateChild(ctx context.Context, req *pb.CreateChildRequest) (
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.CreateChildResponse{
-		Child: &pb.Child{
-			Id:        child.ID.Hex(),
-			OwnerId:   child.OwnerID.Hex(),
-			Name:      child.Name,
-			BirthDate: child.BirthDate,
-			CreatedAt: child.CreatedAt,
-			UpdatedAt: child.UpdatedAt,
-		},
-	}, nil
+	return &pb.CreateChildResponse{Child: childToProto(child)}, nil
 }
 
 func (h *Handler) ListChildren(ctx context.Context, req *pb.ListChildrenRequest) (*pb.ListChildrenResponse, error) {
@@ -55,14 +79,7 @@ func (h *Handler) ListChildren(ctx context.Context, req *pb.ListChildrenRequest)
 
 	pbChildren := make([]*pb.Child, len(children))
 	for i, c := range children {
-		pbChildren[i] = &pb.Child{
-			Id:        c.ID.Hex(),
-			OwnerId:   c.OwnerID.Hex(),
-			Name:      c.Name,
-			BirthDate: c.BirthDate,
-			CreatedAt: c.CreatedAt,
-			UpdatedAt: c.UpdatedAt,
-		}
+		pbChildren[i] = childToProto(c)
 	}
 
 	return &pb.ListChildrenResponse{Children: pbChildren}, nil
@@ -84,16 +101,7 @@ func (h *Handler) UpdateChild(ctx context.Context, req *pb.UpdateChildRequest) (
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.UpdateChildResponse{
-		Child: &pb.Child{
-			Id:        child.ID.Hex(),
-			OwnerId:   child.OwnerID.Hex(),
-			Name:      child.Name,
-			BirthDate: child.BirthDate,
-			CreatedAt: child.CreatedAt,
-			UpdatedAt: child.UpdatedAt,
-		},
-	}, nil
+	return &pb.UpdateChildResponse{Child: childToProto(child)}, nil
 }
 
 func (h *Handler) DeleteChild(ctx context.Context, req *pb.DeleteChildRequest) (*pb.DeleteChildResponse, error) {
@@ -114,6 +122,89 @@ func (h *Handler) DeleteChild(ctx context.Context, req *pb.DeleteChildRequest) (
 	return &pb.DeleteChildResponse{}, nil
 }
 
+func (h *Handler) AddCoParent(ctx context.Context, req *pb.AddCoParentRequest) (*pb.AddCoParentResponse, error) {
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	childID, err := primitive.ObjectIDFromHex(req.ChildId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid child ID")
+	}
+	child, err := h.svc.AddCoParent(ctx, childID, ownerID, req.Email)
+	if err != nil {
+		return nil, serviceError(err, "add co-parent")
+	}
+	return &pb.AddCoParentResponse{Child: childToProto(child)}, nil
+}
+
+func (h *Handler) RemoveCoParent(ctx context.Context, req *pb.RemoveCoParentRequest) (*pb.RemoveCoParentResponse, error) {
+	userID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	childID, err := primitive.ObjectIDFromHex(req.ChildId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid child ID")
+	}
+	coParentID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	child, err := h.svc.RemoveCoParent(ctx, childID, userID, coParentID)
+	if err != nil {
+		return nil, serviceError(err, "remove co-parent")
+	}
+	return &pb.RemoveCoParentResponse{Child: childToProto(child)}, nil
+}
+
+func (h *Handler) ListCoParentInvites(ctx context.Context, _ *pb.ListCoParentInvitesRequest) (*pb.ListCoParentInvitesResponse, error) {
+	userID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children, err := h.svc.ListCoParentInvites(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err, "list co-parent invites")
+	}
+	out := make([]*pb.Child, len(children))
+	for i, c := range children {
+		out[i] = childToProto(c)
+	}
+	return &pb.ListCoParentInvitesResponse{Children: out}, nil
+}
+
+func (h *Handler) AcceptCoParentInvite(ctx context.Context, req *pb.AcceptCoParentInviteRequest) (*pb.AcceptCoParentInviteResponse, error) {
+	userID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	childID, err := primitive.ObjectIDFromHex(req.ChildId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid child ID")
+	}
+	child, err := h.svc.AcceptCoParentInvite(ctx, childID, userID)
+	if err != nil {
+		return nil, serviceError(err, "accept co-parent invite")
+	}
+	return &pb.AcceptCoParentInviteResponse{Child: childToProto(child)}, nil
+}
+
+func (h *Handler) DeclineCoParentInvite(ctx context.Context, req *pb.DeclineCoParentInviteRequest) (*pb.DeclineCoParentInviteResponse, error) {
+	userID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	childID, err := primitive.ObjectIDFromHex(req.ChildId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid child ID")
+	}
+	if err := h.svc.DeclineCoParentInvite(ctx, childID, userID); err != nil {
+		return nil, serviceError(err, "decline co-parent invite")
+	}
+	return &pb.DeclineCoParentInviteResponse{}, nil
+}
+
 // ownerIDFromContext extrait le userID du contexte et le convertit en ObjectID.
 func ownerIDFromContext(ctx context.Context) (primitive.ObjectID, error) {
 	userID, err := interceptor.UserIDFromContext(ctx)
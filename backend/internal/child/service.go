@@ -2,11 +2,25 @@ package child
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrChildNotFound  = errors.New("child not found")
+	ErrUserNotFound   = errors.New("no user with this email")
+	ErrAlreadyParent  = errors.New("already a parent of this child")
+	ErrNotOwner       = errors.New("only the child's owner can do this")
+	ErrNotCoParent    = errors.New("not a co-parent of this child")
+	ErrAlreadyInvited = errors.New("already invited as a co-parent of this child")
+	ErrInviteNotFound = errors.New("no pending co-parent invitation for this child")
 )
 
 type Child struct {
@@ -16,14 +30,145 @@ type Child struct {
 	BirthDate int64              `bson:"birthDate"`
 	CreatedAt int64              `bson:"createdAt"`
 	UpdatedAt int64              `bson:"updatedAt"`
+	// BirthMonthDay est le jour d'anniversaire (mois×100 + jour, 315 pour le
+	// 15 mars), dérivé de BirthDate pour trouver les anniversaires d'un jour
+	BirthMonthDay int32 `bson:"birthMonthDay"`
+	// CoParentIDs sont les parents avec qui le propriétaire partage l'enfant :
+	// ils le voient et le modifient comme les leurs, sans pouvoir le supprimer
+	CoParentIDs []primitive.ObjectID `bson:"coParentIds,omitempty"`
+	// PendingCoParentIDs sont les parents invités qui n'ont pas encore
+	// accepté : ils n'ont aucun accès à l'enfant d'ici là
+	PendingCoParentIDs []primitive.ObjectID `bson:"pendingCoParentIds,omitempty"`
+}
+
+// AccessFilter sélectionne les enfants d'un parent : les siens, ceux qu'un
+// autre parent partage avec lui, et ceux des parents de sa famille (voir
+// FamilyIDs)
+func AccessFilter(userID primitive.ObjectID, family ...primitive.ObjectID) bson.M {
+	or := bson.A{
+		bson.M{"ownerId": userID},
+		bson.M{"coParentIds": userID},
+	}
+	if len(family) > 0 {
+		or = append(or, bson.M{"ownerId": bson.M{"$in": family}})
+	}
+	return bson.M{"$or": or}
+}
+
+// parentRoles sont les rôles de membre d'un foyer qui font un parent (voir
+// household) ; les viewers (grands-parents…) n'ont pas accès aux enfants
+var parentRoles = []string{"admin", "editor"}
+
+// FamilyIDs retourne les parents d'un même foyer que l'utilisateur : les
+// admins et editors des foyers dont il est lui-même admin ou editor. Ils
+// partagent leurs enfants comme des co-parents, tant qu'ils sont du foyer.
+func FamilyIDs(ctx context.Context, db *mongo.Database, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	cursor, err := db.Collection("households").Find(ctx,
+		bson.M{"members": bson.M{"$elemMatch": bson.M{"user_id": userID.Hex(), "role": bson.M{"$in": parentRoles}}}},
+		options.Find().SetProjection(bson.M{"members": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var households []struct {
+		Members []struct {
+			UserID string `bson:"user_id"`
+			Role   string `bson:"role"`
+		} `bson:"members"`
+	}
+	if err := cursor.All(ctx, &households); err != nil {
+		return nil, err
+	}
+	var ids []primitive.ObjectID
+	for _, h := range households {
+		for _, m := range h.Members {
+			oid, err := primitive.ObjectIDFromHex(m.UserID)
+			if err != nil || oid == userID || !slices.Contains(parentRoles, m.Role) || slices.Contains(ids, oid) {
+				continue
+			}
+			ids = append(ids, oid)
+		}
+	}
+	return ids, nil
+}
+
+// FamilyAccessFilter est AccessFilter étendu aux enfants de la famille de
+// l'utilisateur
+func FamilyAccessFilter(ctx context.Context, db *mongo.Database, userID primitive.ObjectID) (bson.M, error) {
+	family, err := FamilyIDs(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	return AccessFilter(userID, family...), nil
+}
+
+// ParentIDs retourne le propriétaire puis les co-parents
+func (c *Child) ParentIDs() []primitive.ObjectID {
+	return append([]primitive.ObjectID{c.OwnerID}, c.CoParentIDs...)
+}
+
+// Birth retourne la date de naissance, envoyée en millisecondes par les
+// applications ; une valeur en secondes est aussi comprise, comme event_date.
+func (c *Child) Birth() time.Time {
+	if c.BirthDate > 1e11 || c.BirthDate < -1e11 {
+		return time.UnixMilli(c.BirthDate).UTC()
+	}
+	return time.Unix(c.BirthDate, 0).UTC()
+}
+
+// Birthday retourne le jour de naissance. Les applications envoient minuit,
+// heure locale ou UTC : midi UTC tombe le même jour dans les deux cas.
+func (c *Child) Birthday() (year int, month time.Month, day int) {
+	return c.Birth().Add(12 * time.Hour).Date()
+}
+
+// BirthdayIn retourne l'anniversaire de l'année year, à minuit dans loc. Un
+// enfant né un 29 février le fête le 1er mars les autres années.
+func (c *Child) BirthdayIn(year int, loc *time.Location) time.Time {
+	_, m, d := c.Birthday()
+	return time.Date(year, m, d, 0, 0, 0, 0, loc)
+}
+
+// birthMonthDay calcule BirthMonthDay
+func birthMonthDay(birthDate int64) int32 {
+	_, m, d := (&Child{BirthDate: birthDate}).Birthday()
+	return int32(m)*100 + int32(d)
+}
+
+// MonthDaysOn retourne les valeurs de BirthMonthDay des enfants qui fêtent
+// leur anniversaire le jour de day : le 1er mars d'une année non bissextile,
+// ceux du 29 février aussi
+func MonthDaysOn(day time.Time) []int32 {
+	y, m, d := day.Date()
+	out := []int32{int32(m)*100 + int32(d)}
+	if m == time.March && d == 1 && time.Date(y, time.February, 29, 0, 0, 0, 0, time.UTC).Month() != time.February {
+		out = append(out, 229)
+	}
+	return out
+}
+
+// NextBirthday retourne le prochain anniversaire à partir du jour de now (le
+// jour même s'il tombe aujourd'hui, à minuit dans le fuseau de now) et l'âge
+// fêté. Un enfant né un 29 février le fête le 1er mars les autres années.
+func (c *Child) NextBirthday(now time.Time) (time.Time, int) {
+	by, _, _ := c.Birthday()
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	next := c.BirthdayIn(y, now.Location())
+	if next.Before(today) {
+		y++
+		next = c.BirthdayIn(y, now.Location())
+	}
+	return next, y - by
 }
 
 type Service struct {
-	coll *mongo.Collection
+	coll    *mongo.Collection
+	userCol *mongo.Collection
 }
 
 func NewService(db *mongo.Database) *Service {
-	return &Service{coll: db.Collection("children")}
+	return &Service{coll: db.Collection("children"), userCol: db.Collection("users")}
 }
 
 func (s *Service) Create(ctx context.Context, ownerID primitive.ObjectID, name string, birthDate int64) (*Child, error) {
@@ -34,6 +179,8 @@ func (s *Service) Create(ctx context.Context, ownerID primitive.ObjectID, name s
 		BirthDate: birthDate,
 		CreatedAt: time.Now().Unix(),
 		UpdatedAt: time.Now().Unix(),
+
+		BirthMonthDay: birthMonthDay(birthDate),
 	}
 	_, err := s.coll.InsertOne(ctx, child)
 	if err != nil {
@@ -43,7 +190,11 @@ func (s *Service) Create(ctx context.Context, ownerID primitive.ObjectID, name s
 }
 
 func (s *Service) List(ctx context.Context, ownerID primitive.ObjectID) ([]*Child, error) {
-	cursor, err := s.coll.Find(ctx, bson.M{"ownerId": ownerID})
+	filter, err := FamilyAccessFilter(ctx, s.coll.Database(), ownerID)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.coll.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -56,16 +207,23 @@ func (s *Service) List(ctx context.Context, ownerID primitive.ObjectID) ([]*Chil
 	return children, nil
 }
 
+// Update modifie un enfant du parent, le sien ou un enfant partagé
 func (s *Service) Update(ctx context.Context, childID, ownerID primitive.ObjectID, name string, birthDate int64) (*Child, error) {
-	filter := bson.M{"_id": childID, "ownerId": ownerID}
+	filter, err := FamilyAccessFilter(ctx, s.coll.Database(), ownerID)
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = childID
 	update := bson.M{"$set": bson.M{
 		"name":      name,
 		"birthDate": birthDate,
 		"updatedAt": time.Now().Unix(),
+
+		"birthMonthDay": birthMonthDay(birthDate),
 	}}
 	
 	var child Child
-	err := s.coll.FindOneAndUpdate(ctx, filter, update).Decode(&child)
+	err = s.coll.FindOneAndUpdate(ctx, filter, update).Decode(&child)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +236,167 @@ func (s *Service) Update(ctx context.Context, childID, ownerID primitive.ObjectI
 	return &child, nil
 }
 
+// Delete supprime un enfant ; seul son propriétaire le peut
 func (s *Service) Delete(ctx context.Context, childID, ownerID primitive.ObjectID) error {
 	_, err := s.coll.DeleteOne(ctx, bson.M{"_id": childID, "ownerId": ownerID})
 	return err
 }
+
+// BackfillBirthdays calcule BirthMonthDay des enfants créés avant son ajout
+func (s *Service) BackfillBirthdays(ctx context.Context) error {
+	cursor, err := s.coll.Find(ctx, bson.M{"birthMonthDay": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var c Child
+		if err := cursor.Decode(&c); err != nil {
+			return err
+		}
+		_, err := s.coll.UpdateOne(ctx,
+			bson.M{"_id": c.ID},
+			bson.M{"$set": bson.M{"birthMonthDay": birthMonthDay(c.BirthDate)}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// AddCoParent invite l'utilisateur d'adresse email à partager l'enfant. Il ne
+// le voit et ne le modifie qu'une fois l'invitation acceptée
+// (AcceptCoParentInvite). Seul le propriétaire invite.
+func (s *Service) AddCoParent(ctx context.Context, childID, ownerID primitive.ObjectID, email string) (*Child, error) {
+	c, err := s.get(ctx, childID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if c.OwnerID != ownerID {
+		return nil, ErrNotOwner
+	}
+	var user struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	err = s.userCol.FindOne(ctx, bson.M{"email": strings.TrimSpace(email)}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(c.ParentIDs(), user.ID) {
+		return nil, ErrAlreadyParent
+	}
+	if slices.Contains(c.PendingCoParentIDs, user.ID) {
+		return nil, ErrAlreadyInvited
+	}
+	_, err = s.coll.UpdateOne(ctx,
+		bson.M{"_id": childID, "ownerId": ownerID},
+		bson.M{"$addToSet": bson.M{"pendingCoParentIds": user.ID}, "$set": bson.M{"updatedAt": time.Now().Unix()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, childID, ownerID)
+}
+
+// ListCoParentInvites retourne les enfants auxquels l'utilisateur est invité
+// comme co-parent, réduits à ce qu'il faut pour décider : nom et propriétaire
+func (s *Service) ListCoParentInvites(ctx context.Context, userID primitive.ObjectID) ([]*Child, error) {
+	cursor, err := s.coll.Find(ctx, bson.M{"pendingCoParentIds": userID},
+		options.Find().SetProjection(bson.M{"ownerId": 1, "name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var children []*Child
+	if err := cursor.All(ctx, &children); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// AcceptCoParentInvite fait de l'utilisateur invité un co-parent de l'enfant
+func (s *Service) AcceptCoParentInvite(ctx context.Context, childID, userID primitive.ObjectID) (*Child, error) {
+	res, err := s.coll.UpdateOne(ctx,
+		bson.M{"_id": childID, "pendingCoParentIds": userID},
+		bson.M{
+			"$pull":     bson.M{"pendingCoParentIds": userID},
+			"$addToSet": bson.M{"coParentIds": userID},
+			"$set":      bson.M{"updatedAt": time.Now().Unix()},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrInviteNotFound
+	}
+	return s.get(ctx, childID, userID)
+}
+
+// DeclineCoParentInvite refuse une invitation ; le propriétaire l'annule par
+// RemoveCoParent
+func (s *Service) DeclineCoParentInvite(ctx context.Context, childID, userID primitive.ObjectID) error {
+	res, err := s.coll.UpdateOne(ctx,
+		bson.M{"_id": childID, "pendingCoParentIds": userID},
+		bson.M{"$pull": bson.M{"pendingCoParentIds": userID}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrInviteNotFound
+	}
+	return nil
+}
+
+// RemoveCoParent retire un co-parent ou annule son invitation : le
+// propriétaire retire qui il veut, un co-parent peut se retirer lui-même
+func (s *Service) RemoveCoParent(ctx context.Context, childID, userID, coParentID primitive.ObjectID) (*Child, error) {
+	c, err := s.get(ctx, childID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if c.OwnerID != userID && coParentID != userID {
+		return nil, ErrNotOwner
+	}
+	res, err := s.coll.UpdateOne(ctx,
+		bson.M{"_id": childID, "$or": bson.A{
+			bson.M{"coParentIds": coParentID},
+			bson.M{"pendingCoParentIds": coParentID},
+		}},
+		bson.M{
+			"$pull": bson.M{"coParentIds": coParentID, "pendingCoParentIds": coParentID},
+			"$set":  bson.M{"updatedAt": time.Now().Unix()},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrNotCoParent
+	}
+	isRemoved := func(id primitive.ObjectID) bool { return id == coParentID }
+	c.CoParentIDs = slices.DeleteFunc(c.CoParentIDs, isRemoved)
+	c.PendingCoParentIDs = slices.DeleteFunc(c.PendingCoParentIDs, isRemoved)
+	return c, nil
+}
+
+// get charge un enfant du parent
+func (s *Service) get(ctx context.Context, childID, userID primitive.ObjectID) (*Child, error) {
+	filter, err := FamilyAccessFilter(ctx, s.coll.Database(), userID)
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = childID
+	var c Child
+	if err := s.coll.FindOne(ctx, filter).Decode(&c); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrChildNotFound
+		}
+		return nil, err
+	}
+	return &c, nil
+}
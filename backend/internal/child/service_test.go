@@ -0,0 +1,223 @@
+package child
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func TestChild_Birthday(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+	tests := []struct {
+		name  string
+		birth int64
+	}{
+		{"midnight UTC in ms", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli()},
+		{"midnight Paris in ms", time.Date(2020, 3, 15, 0, 0, 0, 0, paris).UnixMilli()},
+		{"midnight Paris in seconds", time.Date(2020, 3, 15, 0, 0, 0, 0, paris).Unix()},
+	}
+	for _, tt := range tests {
+		c := &Child{BirthDate: tt.birth}
+		if y, m, d := c.Birthday(); y != 2020 || m != time.March || d != 15 {
+			t.Errorf("%s: Birthday = %d-%02d-%02d, want 2020-03-15", tt.name, y, m, d)
+		}
+	}
+}
+
+func TestChild_NextBirthday(t *testing.T) {
+	lea := &Child{BirthDate: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli()}
+	leap := &Child{BirthDate: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC).UnixMilli()}
+	tests := []struct {
+		name    string
+		c       *Child
+		now     time.Time
+		want    string
+		turning int
+	}{
+		{"later this year", lea, time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC), "2026-03-15", 6},
+		{"today", lea, time.Date(2026, 3, 15, 18, 0, 0, 0, time.UTC), "2026-03-15", 6},
+		{"next year", lea, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), "2027-03-15", 7},
+		{"29 february, common year", leap, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), "2026-03-01", 6},
+		{"29 february, leap year", leap, time.Date(2028, 2, 1, 0, 0, 0, 0, time.UTC), "2028-02-29", 8},
+	}
+	for _, tt := range tests {
+		next, turning := tt.c.NextBirthday(tt.now)
+		if got := next.Format("2006-01-02"); got != tt.want || turning != tt.turning {
+			t.Errorf("%s: NextBirthday = %s (%d), want %s (%d)", tt.name, got, turning, tt.want, tt.turning)
+		}
+	}
+}
+
+func TestMonthDaysOn(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		want []int32
+	}{
+		{time.Date(2026, 3, 15, 8, 0, 0, 0, time.UTC), []int32{315}},
+		{time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC), []int32{301, 229}},
+		{time.Date(2028, 3, 1, 8, 0, 0, 0, time.UTC), []int32{301}},
+		{time.Date(2028, 2, 29, 8, 0, 0, 0, time.UTC), []int32{229}},
+	}
+	for _, tt := range tests {
+		if got := MonthDaysOn(tt.day); !slices.Equal(got, tt.want) {
+			t.Errorf("MonthDaysOn(%s) = %v, want %v", tt.day.Format("2006-01-02"), got, tt.want)
+		}
+	}
+	lea := &Child{BirthDate: time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli()}
+	if got := birthMonthDay(lea.BirthDate); got != 315 {
+		t.Errorf("birthMonthDay = %d, want 315", got)
+	}
+}
+
+// Le co-parent voit et modifie l'enfant partagé une fois l'invitation
+// acceptée, sans pouvoir le supprimer ni le partager ; il peut se retirer
+func TestCoParents(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db)
+	mum := primitive.NewObjectID()
+	dad := primitive.NewObjectID()
+	granny := primitive.NewObjectID()
+	for id, email := range map[primitive.ObjectID]string{mum: "mum@example.com", dad: "dad@example.com", granny: "granny@example.com"} {
+		if _, err := db.Collection("users").InsertOne(ctx, bson.M{"_id": id, "email": email}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lea, err := svc.Create(ctx, mum, "Léa", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Create(ctx, dad, "Tom", time.Date(2018, 1, 5, 0, 0, 0, 0, time.UTC).UnixMilli()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.AddCoParent(ctx, lea.ID, dad, "granny@example.com"); !errors.Is(err, ErrChildNotFound) {
+		t.Errorf("stranger share err = %v, want ErrChildNotFound", err)
+	}
+	if _, err := svc.AddCoParent(ctx, lea.ID, mum, "nobody@example.com"); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("unknown email err = %v, want ErrUserNotFound", err)
+	}
+	if _, err := svc.AddCoParent(ctx, lea.ID, mum, "mum@example.com"); !errors.Is(err, ErrAlreadyParent) {
+		t.Errorf("self share err = %v, want ErrAlreadyParent", err)
+	}
+	c, err := svc.AddCoParent(ctx, lea.ID, mum, " dad@example.com ")
+	if err != nil || len(c.CoParentIDs) != 0 || len(c.PendingCoParentIDs) != 1 || c.PendingCoParentIDs[0] != dad {
+		t.Fatalf("invite = %+v, %v", c, err)
+	}
+	if _, err := svc.AddCoParent(ctx, lea.ID, mum, "dad@example.com"); !errors.Is(err, ErrAlreadyInvited) {
+		t.Errorf("invite twice err = %v, want ErrAlreadyInvited", err)
+	}
+
+	// Tant qu'il n'a pas accepté, l'invité ne voit pas l'enfant
+	if kids, _ := svc.List(ctx, dad); len(kids) != 1 {
+		t.Errorf("dad's children before accepting = %v", kids)
+	}
+	if _, err := svc.Update(ctx, lea.ID, dad, "Léa-Rose", lea.BirthDate); err == nil {
+		t.Error("invitee updated the child before accepting")
+	}
+	invites, err := svc.ListCoParentInvites(ctx, dad)
+	if err != nil || len(invites) != 1 || invites[0].Name != "Léa" || invites[0].OwnerID != mum || invites[0].BirthDate != 0 {
+		t.Fatalf("dad's invites = %+v, %v", invites, err)
+	}
+	if _, err := svc.AcceptCoParentInvite(ctx, lea.ID, granny); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("uninvited accept err = %v, want ErrInviteNotFound", err)
+	}
+	c, err = svc.AcceptCoParentInvite(ctx, lea.ID, dad)
+	if err != nil || len(c.CoParentIDs) != 1 || c.CoParentIDs[0] != dad || len(c.PendingCoParentIDs) != 0 {
+		t.Fatalf("accept = %+v, %v", c, err)
+	}
+	if invites, _ := svc.ListCoParentInvites(ctx, dad); len(invites) != 0 {
+		t.Errorf("dad's invites after accepting = %v", invites)
+	}
+
+	kids, err := svc.List(ctx, dad)
+	if err != nil || len(kids) != 2 {
+		t.Fatalf("dad's children = %v, %v", kids, err)
+	}
+	if _, err := svc.Update(ctx, lea.ID, dad, "Léa-Rose", lea.BirthDate); err != nil {
+		t.Errorf("co-parent update: %v", err)
+	}
+	if _, err := svc.AddCoParent(ctx, lea.ID, dad, "granny@example.com"); !errors.Is(err, ErrNotOwner) {
+		t.Errorf("co-parent share err = %v, want ErrNotOwner", err)
+	}
+	if err := svc.Delete(ctx, lea.ID, dad); err != nil {
+		t.Fatal(err)
+	}
+	if kids, _ := svc.List(ctx, mum); len(kids) != 1 || kids[0].Name != "Léa-Rose" {
+		t.Errorf("mum's children after dad's delete = %v", kids)
+	}
+
+	if _, err := svc.RemoveCoParent(ctx, lea.ID, dad, mum); !errors.Is(err, ErrNotOwner) {
+		t.Errorf("co-parent removes owner err = %v, want ErrNotOwner", err)
+	}
+	if _, err := svc.RemoveCoParent(ctx, lea.ID, dad, dad); err != nil {
+		t.Errorf("co-parent leaves: %v", err)
+	}
+	if kids, _ := svc.List(ctx, dad); len(kids) != 1 || kids[0].Name != "Tom" {
+		t.Errorf("dad's children after leaving = %v", kids)
+	}
+	if _, err := svc.RemoveCoParent(ctx, lea.ID, mum, dad); !errors.Is(err, ErrNotCoParent) {
+		t.Errorf("remove twice err = %v, want ErrNotCoParent", err)
+	}
+
+	// L'invité refuse, le propriétaire annule
+	if _, err := svc.AddCoParent(ctx, lea.ID, mum, "granny@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DeclineCoParentInvite(ctx, lea.ID, granny); err != nil {
+		t.Errorf("decline: %v", err)
+	}
+	if _, err := svc.AcceptCoParentInvite(ctx, lea.ID, granny); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("accept after decline err = %v, want ErrInviteNotFound", err)
+	}
+	if _, err := svc.AddCoParent(ctx, lea.ID, mum, "dad@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := svc.RemoveCoParent(ctx, lea.ID, mum, dad); err != nil || len(c.PendingCoParentIDs) != 0 {
+		t.Errorf("cancel invite = %+v, %v", c, err)
+	}
+	if err := svc.DeclineCoParentInvite(ctx, lea.ID, dad); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("decline cancelled invite err = %v, want ErrInviteNotFound", err)
+	}
+}
@@ -62,7 +62,7 @@ func (s *Service) CreateComment(ctx context.Context, linkID, userID, text string
 		return nil, errors.New("invalid link id")
 	}
 
-	count, err := s.linkCol.CountDocuments(ctx, bson.M{"_id": linkOID})
+	count, err := s.linkCol.CountDocuments(ctx, bson.M{"_id": linkOID, "deleted_at": nil})
 	if err != nil {
 		return nil, err
 	}
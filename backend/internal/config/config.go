@@ -1,7 +1,11 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -16,6 +20,30 @@ type Config struct {
 	SearxURL      string
 	GeminiAPIKey  string
 	AdminPassword string
+	// LinkCheckInterval espace les passes du vérificateur de liens morts (0 : désactivé)
+	LinkCheckInterval time.Duration
+	// ReminderInterval espace les passes des rappels d'événements (0 : désactivé)
+	ReminderInterval time.Duration
+	// ReminderOffsets sont les rappels, en jours avant l'événement
+	ReminderOffsets  []int
+	ReminderTimezone string
+	// GeocoderURL est l'API Adresse utilisée pour géocoder les lieux ("off" :
+	// répertoire des communes seul) ; GazetteerPath remplace ce répertoire
+	// par un fichier CSV complet
+	GeocoderURL   string
+	GazetteerPath string
+	// PriceWatchInterval espace les passes du suivi des prix (0 : désactivé)
+	PriceWatchInterval time.Duration
+	// BirthdayReminderOffsets sont les rappels d'anniversaire des enfants, en
+	// jours avant ; le plus grand est l'avance laissée pour les cadeaux, aussi
+	// utilisée par les agendas. BirthdayNotifyCollaborators prévient alors les
+	// collaborateurs des listes de l'enfant.
+	BirthdayReminderOffsets     []int
+	BirthdayNotifyCollaborators bool
+	// SearchTextInterval espace les recalculs du texte de recherche des
+	// dossiers dont les liens ont changé (0 : désactivé, la recherche
+	// communautaire ne voit alors plus les modifications)
+	SearchTextInterval time.Duration
 }
 
 func Load() *Config {
@@ -31,6 +59,20 @@ func Load() *Config {
 		SearxURL:      getEnv("SEARXNG_URL", "http://localhost:8888"),
 		GeminiAPIKey:  getEnv("GEMINI_API_KEY", ""),
 		AdminPassword: getEnv("ADMIN_PASSWORD", "tribbae-admin"),
+
+		LinkCheckInterval: getDuration("LINK_CHECK_INTERVAL", time.Hour),
+		ReminderInterval:  getDuration("REMINDER_INTERVAL", 5*time.Minute),
+		ReminderOffsets:   getInts("REMINDER_OFFSETS", []int{7, 1, 0}),
+		ReminderTimezone:  getEnv("REMINDER_TIMEZONE", "Europe/Paris"),
+		GeocoderURL:       getEnv("GEOCODER_URL", "https://api-adresse.data.gouv.fr"),
+		GazetteerPath:     os.Getenv("GAZETTEER_PATH"),
+
+		PriceWatchInterval: getDuration("PRICE_WATCH_INTERVAL", time.Hour),
+
+		BirthdayReminderOffsets:     getInts("BIRTHDAY_REMINDER_OFFSETS", []int{14, 0}),
+		BirthdayNotifyCollaborators: getBool("BIRTHDAY_NOTIFY_COLLABORATORS", false),
+
+		SearchTextInterval: getDuration("SEARCH_TEXT_INTERVAL", 30*time.Second),
 	}
 }
 
@@ -40,3 +82,47 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("invalid %s %q, using %s", key, v, fallback)
+		return fallback
+	}
+	return d
+}
+
+func getBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("invalid %s %q, using %t", key, v, fallback)
+		return fallback
+	}
+	return b
+}
+
+// getInts lit une liste d'entiers séparés par des virgules (« 7,1,0 »)
+func getInts(key string, fallback []int) []int {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	var out []int
+	for _, part := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < 0 {
+			log.Printf("invalid %s %q, using %v", key, v, fallback)
+			return fallback
+		}
+		out = append(out, n)
+	}
+	return out
+}
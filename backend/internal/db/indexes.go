@@ -3,12 +3,20 @@ package db
 import (
 	"context"
 	"log"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// TrashRetention est la durée pendant laquelle un élément supprimé reste dans la
+// corbeille avant d'être purgé par l'index TTL sur deleted_at.
+const TrashRetention = 30 * 24 * time.Hour
+
+// ReminderRetention est la durée de conservation de la trace des rappels envoyés
+const ReminderRetention = 90 * 24 * time.Hour
+
 // indexDef décrit un index à créer sur une collection.
 type indexDef struct {
 	Collection string
@@ -57,6 +65,30 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 				Options: options.Index().SetName("idx_folders_collaborators_user_id"),
 			},
 		},
+		{
+			// Dossiers rattachés à un enfant (ListChildFolders)
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "child_ids", Value: 1}},
+				Options: options.Index().SetSparse(true).SetName("idx_folders_child_ids"),
+			},
+		},
+		{
+			// Dossiers dont le texte de recherche est à recalculer
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "link_text_stale", Value: 1}},
+				Options: options.Index().SetSparse(true).SetName("idx_folders_link_text_stale"),
+			},
+		},
+		{
+			// Dossiers partagés avec un foyer
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "household_ids", Value: 1}},
+				Options: options.Index().SetSparse(true).SetName("idx_folders_household_ids"),
+			},
+		},
 		{
 			Collection: "folders",
 			Model: mongo.IndexModel{
@@ -68,6 +100,43 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 				Options: options.Index().SetName("idx_folders_visibility_likes_updated"),
 			},
 		},
+		{
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "visibility", Value: 1},
+					{Key: "updated_at", Value: -1},
+					{Key: "_id", Value: 1},
+				},
+				Options: options.Index().SetName("idx_folders_visibility_updated_id"),
+			},
+		},
+		// Recherche communautaire : stemming français, insensible à la casse et
+		// aux accents (index texte v3). link_text est dénormalisé depuis les liens.
+		{
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "name", Value: "text"},
+					{Key: "tags", Value: "text"},
+					{Key: "link_text", Value: "text"},
+				},
+				Options: options.Index().
+					SetWeights(bson.M{"name": 10, "tags": 5, "link_text": 1}).
+					SetDefaultLanguage("french").
+					SetLanguageOverride("search_language").
+					SetName("idx_folders_text"),
+			},
+		},
+		{
+			Collection: "folders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{{Key: "deleted_at", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(int32(TrashRetention.Seconds())).
+					SetName("idx_folders_deleted_at_ttl"),
+			},
+		},
 
 		// ── links ─────────────────────────────────────────────
 		{
@@ -101,6 +170,79 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 				Options: options.Index().SetName("idx_links_folder_id_created_at"),
 			},
 		},
+		// Liens à revérifier par linkcheck, les plus anciens d'abord
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "link_status.checked_at", Value: 1}},
+				Options: options.Index().SetName("idx_links_link_status_checked_at"),
+			},
+		},
+		// Détection des doublons (même page, URL écrite différemment)
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "canonical_key", Value: 1}},
+				Options: options.Index().SetSparse(true).SetName("idx_links_canonical_key"),
+			},
+		},
+		// Recherche personnelle (search.Service), mêmes réglages que idx_folders_text
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "title", Value: "text"},
+					{Key: "description", Value: "text"},
+					{Key: "tags", Value: "text"},
+					{Key: "ingredients", Value: "text"},
+					{Key: "location", Value: "text"},
+				},
+				Options: options.Index().
+					SetWeights(bson.M{"title": 10, "tags": 5, "ingredients": 3, "location": 3, "description": 1}).
+					SetDefaultLanguage("french").
+					SetLanguageOverride("search_language").
+					SetName("idx_links_text"),
+			},
+		},
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys: bson.D{{Key: "deleted_at", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(int32(TrashRetention.Seconds())).
+					SetName("idx_links_deleted_at_ttl"),
+			},
+		},
+		// Recherche « près de chez moi » (link.Service.SearchNearby)
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "geo", Value: "2dsphere"}},
+				Options: options.Index().SetName("idx_links_geo_2dsphere"),
+			},
+		},
+		// Liens dont le prix est suivi (pricewatch), les plus anciens relevés d'abord
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys: bson.D{{Key: "price_watch.checked_at", Value: 1}},
+				Options: options.Index().
+					SetPartialFilterExpression(bson.M{"price_watch": bson.M{"$exists": true}}).
+					SetName("idx_links_price_watch_checked_at"),
+			},
+		},
+		// Liens publics adaptés à un âge (link.Service.RecommendForChild)
+		{
+			Collection: "links",
+			Model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "visibility", Value: 1},
+					{Key: "ages.min_months", Value: 1},
+					{Key: "ages.max_months", Value: 1},
+				},
+				Options: options.Index().SetName("idx_links_visibility_ages"),
+			},
+		},
 
 		// ── link_likes ────────────────────────────────────────
 		{
@@ -129,6 +271,30 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 				Options: options.Index().SetName("idx_children_owner_id"),
 			},
 		},
+		{
+			// Enfants partagés avec un co-parent
+			Collection: "children",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "coParentIds", Value: 1}},
+				Options: options.Index().SetName("idx_children_co_parent_ids"),
+			},
+		},
+		{
+			// Invitations de co-parent en attente
+			Collection: "children",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "pendingCoParentIds", Value: 1}},
+				Options: options.Index().SetName("idx_children_pending_co_parent_ids").SetSparse(true),
+			},
+		},
+		{
+			// Anniversaires d'un jour (rappels)
+			Collection: "children",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "birthMonthDay", Value: 1}},
+				Options: options.Index().SetName("idx_children_birth_month_day"),
+			},
+		},
 
 		// ── follows ───────────────────────────────────────────
 		{
@@ -167,7 +333,165 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 				Options: options.Index().SetName("idx_comments_user_id"),
 			},
 		},
+
+		// ── shopping_lists ────────────────────────────────────
+		{
+			Collection: "shopping_lists",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "updated_at", Value: -1}},
+				Options: options.Index().SetName("idx_shopping_lists_owner_id_updated_at"),
+			},
+		},
+		{
+			Collection: "shopping_lists",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "folder_id", Value: 1}, {Key: "updated_at", Value: -1}},
+				Options: options.Index().SetName("idx_shopping_lists_folder_id_updated_at"),
+			},
+		},
+
+		// ── meal_plans ────────────────────────────────────────
+		{
+			Collection: "meal_plans",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "scope", Value: 1}, {Key: "week_start", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_meal_plans_scope_week_start_unique"),
+			},
+		},
+
+		// ── calendar_feeds ────────────────────────────────────
+		{
+			Collection: "calendar_feeds",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "token", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_calendar_feeds_token_unique"),
+			},
+		},
+		{
+			Collection: "calendar_feeds",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "folder_id", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_calendar_feeds_user_id_folder_id_unique"),
+			},
+		},
+
+		// ── reminders ─────────────────────────────────────────
+		{
+			// Un rappel n'est réclamé, donc envoyé, qu'une fois par date d'événement
+			Collection: "reminders",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "link_id", Value: 1}, {Key: "days", Value: 1}, {Key: "event_at", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_reminders_link_id_days_event_at_unique"),
+			},
+		},
+		{
+			Collection: "reminders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{{Key: "claimed_at", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(int32(ReminderRetention.Seconds())).
+					SetName("idx_reminders_claimed_at_ttl"),
+			},
+		},
+
+		// ── birthday_reminders ────────────────────────────────
+		{
+			// Un rappel d'anniversaire n'est envoyé qu'une fois par destinataire
+			Collection: "birthday_reminders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "child_id", Value: 1},
+					{Key: "user_id", Value: 1},
+					{Key: "days", Value: 1},
+					{Key: "birthday", Value: 1},
+				},
+				Options: options.Index().SetUnique(true).SetName("idx_birthday_reminders_child_id_user_id_days_birthday_unique"),
+			},
+		},
+		{
+			Collection: "birthday_reminders",
+			Model: mongo.IndexModel{
+				Keys: bson.D{{Key: "claimed_at", Value: 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(int32(ReminderRetention.Seconds())).
+					SetName("idx_birthday_reminders_claimed_at_ttl"),
+			},
+		},
+
+		// ── gift_claims ───────────────────────────────────────
+		{
+			// Un cadeau n'est réservé qu'une fois
+			Collection: "gift_claims",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "link_id", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_gift_claims_link_id_unique"),
+			},
+		},
+		{
+			Collection: "gift_claims",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "folder_id", Value: 1}, {Key: "created_at", Value: 1}},
+				Options: options.Index().SetName("idx_gift_claims_folder_id_created_at"),
+			},
+		},
+
+		// ── gift_exchanges ────────────────────────────────────
+		{
+			Collection: "gift_exchanges",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "organizer_id", Value: 1}, {Key: "created_at", Value: -1}},
+				Options: options.Index().SetName("idx_gift_exchanges_organizer_id_created_at"),
+			},
+		},
+		{
+			Collection: "gift_exchanges",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "participants.user_id", Value: 1}},
+				Options: options.Index().SetName("idx_gift_exchanges_participants_user_id"),
+			},
+		},
+		{
+			Collection: "gift_exchanges",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "participants.email", Value: 1}},
+				Options: options.Index().SetName("idx_gift_exchanges_participants_email"),
+			},
+		},
+		{
+			// Un tirage par donneur
+			Collection: "gift_exchange_draws",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "exchange_id", Value: 1}, {Key: "giver_key", Value: 1}},
+				Options: options.Index().SetUnique(true).SetName("idx_gift_exchange_draws_exchange_id_giver_key_unique"),
+			},
+		},
+
+		// ── households ────────────────────────────────────────
+		{
+			Collection: "households",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+				Options: options.Index().SetName("idx_households_members_user_id"),
+			},
+		},
+		{
+			Collection: "households",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "invites.user_id", Value: 1}},
+				Options: options.Index().SetName("idx_households_invites_user_id"),
+			},
+		},
+
+		// ── price_history ─────────────────────────────────────
+		{
+			Collection: "price_history",
+			Model: mongo.IndexModel{
+				Keys:    bson.D{{Key: "link_id", Value: 1}, {Key: "observed_at", Value: -1}},
+				Options: options.Index().SetName("idx_price_history_link_id_observed_at"),
+			},
+		},
 	}
+	indexes = append(indexes, linkListIndexes()...)
 
 	for _, idx := range indexes {
 		col := db.Collection(idx.Collection)
@@ -181,3 +505,27 @@ func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
 
 	return nil
 }
+
+// linkListIndexes couvre les tris de ListLinks pour les deux portées de la
+// requête (liens propres par owner_id, liens des dossiers par folder_id).
+// Le tri se fait sur le champ puis _id dans le même sens, si bien qu'un seul
+// index sert l'ordre croissant et décroissant.
+func linkListIndexes() []indexDef {
+	var defs []indexDef
+	for _, scope := range []string{"owner_id", "folder_id"} {
+		for _, field := range []string{"created_at", "updated_at", "rating", "event_at", "title"} {
+			defs = append(defs, indexDef{
+				Collection: "links",
+				Model: mongo.IndexModel{
+					Keys: bson.D{
+						{Key: scope, Value: 1},
+						{Key: field, Value: 1},
+						{Key: "_id", Value: 1},
+					},
+					Options: options.Index().SetName("idx_links_" + scope + "_" + field + "_id"),
+				},
+			})
+		}
+	}
+	return defs
+}
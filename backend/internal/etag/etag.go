@@ -0,0 +1,46 @@
+// Package etag convertit le numéro de version d'un document (incrémenté à
+// chaque modification) en etag opaque pour la concurrence optimiste.
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	// ErrInvalid est retourné quand un etag ne vient pas de Format
+	ErrInvalid = errors.New("invalid etag")
+	// ErrMismatch est retourné quand le document a changé depuis la lecture de l'etag
+	ErrMismatch = errors.New("etag mismatch: the resource was modified concurrently")
+)
+
+// Format retourne l'etag d'une version
+func Format(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// Parse relit un etag produit par Format
+func Parse(s string) (int64, error) {
+	v, err := strconv.ParseInt(strings.Trim(s, `"`), 10, 64)
+	if err != nil || v < 0 {
+		return 0, ErrInvalid
+	}
+	return v, nil
+}
+
+// Filter retourne la condition sur le champ version correspondant à un etag.
+// Les documents créés avant le suivi des versions n'ont pas de champ version
+// et correspondent à la version 0.
+func Filter(s string) (bson.M, error) {
+	v, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if v == 0 {
+		return bson.M{"version": bson.M{"$in": bson.A{int64(0), nil}}}, nil
+	}
+	return bson.M{"version": v}, nil
+}
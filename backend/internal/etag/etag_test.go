@@ -0,0 +1,34 @@
+package etag
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestFormatParse_RoundTrip(t *testing.T) {
+	for _, v := range []int64{0, 1, 42} {
+		got, err := Parse(Format(v))
+		if err != nil || got != v {
+			t.Errorf("Parse(Format(%d)) = %d, %v", v, got, err)
+		}
+	}
+}
+
+func TestParse_RejectsGarbage(t *testing.T) {
+	for _, s := range []string{"", `"abc"`, `"-1"`, "W/x"} {
+		if _, err := Parse(s); err != ErrInvalid {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
+
+func TestFilter_VersionZeroMatchesMissingField(t *testing.T) {
+	f, err := Filter(Format(0))
+	if err != nil {
+		t.Fatalf("filter: %v", err)
+	}
+	if _, ok := f["version"].(bson.M)["$in"]; !ok {
+		t.Errorf("version 0 should also match documents without version, got %v", f)
+	}
+}
@@ -0,0 +1,86 @@
+package exchange
+
+import (
+	"errors"
+	"sort"
+)
+
+// maxDrawSteps borne la recherche d'un tirage : au-delà, les exclusions sont
+// jugées trop serrées plutôt que d'explorer toutes les permutations
+const maxDrawSteps = 200000
+
+var ErrImpossibleDraw = errors.New("no draw satisfies the exclusions")
+
+// shuffler mélange n éléments, comme rand.Shuffle
+type shuffler func(n int, swap func(i, j int))
+
+// draw attribue à chaque participant un destinataire : personne ne tire son
+// propre nom, chacun est tiré une fois, et aucune paire exclue (dans un sens
+// ou dans l'autre) n'est formée. Le résultat associe chaque clé de donneur à
+// la clé de son destinataire.
+//
+// Les donneurs les plus contraints sont servis en premier et les candidats
+// sont mélangés, puis un retour arrière défait les impasses.
+func draw(keys []string, excluded map[[2]string]bool, shuffle shuffler) (map[string]string, error) {
+	if len(keys) < 2 {
+		return nil, ErrImpossibleDraw
+	}
+	forbidden := func(a, b string) bool {
+		return a == b || excluded[[2]string{a, b}] || excluded[[2]string{b, a}]
+	}
+
+	type giver struct {
+		key        string
+		candidates []string
+	}
+	givers := make([]giver, 0, len(keys))
+	for _, a := range keys {
+		g := giver{key: a}
+		for _, b := range keys {
+			if !forbidden(a, b) {
+				g.candidates = append(g.candidates, b)
+			}
+		}
+		if len(g.candidates) == 0 {
+			return nil, ErrImpossibleDraw
+		}
+		shuffle(len(g.candidates), func(i, j int) {
+			g.candidates[i], g.candidates[j] = g.candidates[j], g.candidates[i]
+		})
+		givers = append(givers, g)
+	}
+	shuffle(len(givers), func(i, j int) { givers[i], givers[j] = givers[j], givers[i] })
+	sort.SliceStable(givers, func(i, j int) bool {
+		return len(givers[i].candidates) < len(givers[j].candidates)
+	})
+
+	out := make(map[string]string, len(keys))
+	taken := make(map[string]bool, len(keys))
+	steps := 0
+	var assign func(i int) bool
+	assign = func(i int) bool {
+		if i == len(givers) {
+			return true
+		}
+		for _, b := range givers[i].candidates {
+			if taken[b] {
+				continue
+			}
+			if steps++; steps > maxDrawSteps {
+				return false
+			}
+			taken[b] = true
+			out[givers[i].key] = b
+			if assign(i + 1) {
+				return true
+			}
+			taken[b] = false
+			delete(out, givers[i].key)
+		}
+		return false
+	}
+	if !assign(0) {
+		return nil, ErrImpossibleDraw
+	}
+	return out, nil
+}
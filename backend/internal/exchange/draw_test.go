@@ -0,0 +1,84 @@
+package exchange
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func checkDraw(t *testing.T, keys []string, excluded map[[2]string]bool, got map[string]string) {
+	t.Helper()
+	if len(got) != len(keys) {
+		t.Fatalf("draw assigned %d givers, want %d", len(got), len(keys))
+	}
+	received := map[string]bool{}
+	for _, a := range keys {
+		b, ok := got[a]
+		if !ok {
+			t.Fatalf("%s has no assignee", a)
+		}
+		if a == b {
+			t.Errorf("%s drew themselves", a)
+		}
+		if excluded[[2]string{a, b}] || excluded[[2]string{b, a}] {
+			t.Errorf("%s drew excluded %s", a, b)
+		}
+		if received[b] {
+			t.Errorf("%s drawn twice", b)
+		}
+		received[b] = true
+	}
+}
+
+func TestDraw(t *testing.T) {
+	keys := []string{"papi", "mamie", "paul", "julie", "leo", "emma"}
+	excluded := map[[2]string]bool{
+		{"papi", "mamie"}: true,
+		{"paul", "julie"}: true,
+		{"leo", "emma"}:   true,
+	}
+	for seed := uint64(0); seed < 200; seed++ {
+		rng := rand.New(rand.NewPCG(seed, 7))
+		got, err := draw(keys, excluded, rng.Shuffle)
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		checkDraw(t, keys, excluded, got)
+	}
+}
+
+func TestDraw_VariesWithSeed(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e"}
+	seen := map[string]bool{}
+	for seed := uint64(0); seed < 50; seed++ {
+		got, err := draw(keys, nil, rand.New(rand.NewPCG(seed, 1)).Shuffle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen[got["a"]] = true
+	}
+	if len(seen) < 3 {
+		t.Errorf("a drew only %v over 50 seeds", seen)
+	}
+}
+
+func TestDraw_Impossible(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		excluded map[[2]string]bool
+	}{
+		{"alone", []string{"a"}, nil},
+		{"couple excluded", []string{"a", "b"}, map[[2]string]bool{{"a", "b"}: true}},
+		// a ne peut offrir qu'à c, b non plus : c serait tiré deux fois
+		{"bottleneck", []string{"a", "b", "c", "d"}, map[[2]string]bool{
+			{"a", "b"}: true, {"a", "d"}: true, {"b", "d"}: true,
+		}},
+	}
+	for _, tt := range tests {
+		_, err := draw(tt.keys, tt.excluded, rand.Shuffle)
+		if !errors.Is(err, ErrImpossibleDraw) {
+			t.Errorf("%s: err = %v, want ErrImpossibleDraw", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedGiftExchangeServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrExchangeNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotOrganizer), errors.Is(err, ErrNotParticipant), errors.Is(err, ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNameRequired), errors.Is(err, ErrTooFewParticipants), errors.Is(err, ErrTooManyParticipants),
+		errors.Is(err, ErrInvalidParticipant), errors.Is(err, ErrDuplicateParticipant), errors.Is(err, ErrInvalidExclusion):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrAlreadyDrawn), errors.Is(err, ErrNotDrawn), errors.Is(err, ErrImpossibleDraw):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+// participantToProto n'expose l'e-mail qu'à l'organisateur, et la liste de
+// souhaits choisie qu'à son auteur
+func participantToProto(p *Participant, userID string, organizer bool) *pb.ExchangeParticipant {
+	out := &pb.ExchangeParticipant{
+		Key:         p.Key,
+		Name:        p.Name,
+		UserId:      p.UserID,
+		HasWishlist: p.FolderID != "",
+		Me:          p.UserID != "" && p.UserID == userID,
+	}
+	if organizer {
+		out.Email = p.Email
+	}
+	if out.Me {
+		out.WishlistFolderId = p.FolderID
+	}
+	return out
+}
+
+func exchangeToProto(e *Exchange, userID string) *pb.GiftExchange {
+	organizer := e.OrganizerID == userID
+	out := &pb.GiftExchange{
+		Id:           e.ID.Hex(),
+		Name:         e.Name,
+		OrganizerId:  e.OrganizerID,
+		IsOrganizer:  organizer,
+		Participants: make([]*pb.ExchangeParticipant, 0, len(e.Participants)),
+		Drawn:        e.DrawnAt != nil,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+	for i := range e.Participants {
+		out.Participants = append(out.Participants, participantToProto(&e.Participants[i], userID, organizer))
+	}
+	if organizer {
+		for _, ex := range e.Exclusions {
+			out.Exclusions = append(out.Exclusions, &pb.ExchangeExclusion{A: ex.A, B: ex.B})
+		}
+	}
+	if e.DrawnAt != nil {
+		out.DrawnAt = timestamppb.New(*e.DrawnAt)
+	}
+	return out
+}
+
+func (h *Handler) CreateGiftExchange(ctx context.Context, req *pb.CreateGiftExchangeRequest) (*pb.CreateGiftExchangeResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	participants := make([]Participant, 0, len(req.Participants))
+	for _, p := range req.Participants {
+		participants = append(participants, Participant{UserID: p.UserId, Email: p.Email, Name: p.Name})
+	}
+	exclusions := make([][2]string, 0, len(req.Exclusions))
+	for _, ex := range req.Exclusions {
+		exclusions = append(exclusions, [2]string{ex.A, ex.B})
+	}
+	e, err := h.svc.Create(ctx, userID, req.Name, participants, exclusions)
+	if err != nil {
+		return nil, serviceError(err, "create gift exchange")
+	}
+	return &pb.CreateGiftExchangeResponse{Exchange: exchangeToProto(e, userID)}, nil
+}
+
+func (h *Handler) GetGiftExchange(ctx context.Context, req *pb.GetGiftExchangeRequest) (*pb.GetGiftExchangeResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	e, err := h.svc.Get(ctx, req.ExchangeId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get gift exchange")
+	}
+	return &pb.GetGiftExchangeResponse{Exchange: exchangeToProto(e, userID)}, nil
+}
+
+func (h *Handler) ListGiftExchanges(ctx context.Context, _ *pb.ListGiftExchangesRequest) (*pb.ListGiftExchangesResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	exchanges, err := h.svc.List(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err, "list gift exchanges")
+	}
+	resp := &pb.ListGiftExchangesResponse{Exchanges: make([]*pb.GiftExchange, 0, len(exchanges))}
+	for _, e := range exchanges {
+		resp.Exchanges = append(resp.Exchanges, exchangeToProto(e, userID))
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeleteGiftExchange(ctx context.Context, req *pb.DeleteGiftExchangeRequest) (*pb.DeleteGiftExchangeResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.Delete(ctx, req.ExchangeId, userID); err != nil {
+		return nil, serviceError(err, "delete gift exchange")
+	}
+	return &pb.DeleteGiftExchangeResponse{}, nil
+}
+
+func (h *Handler) DrawGiftExchange(ctx context.Context, req *pb.DrawGiftExchangeRequest) (*pb.DrawGiftExchangeResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	e, err := h.svc.Draw(ctx, req.ExchangeId, userID)
+	if err != nil {
+		return nil, serviceError(err, "draw gift exchange")
+	}
+	return &pb.DrawGiftExchangeResponse{Exchange: exchangeToProto(e, userID)}, nil
+}
+
+func (h *Handler) SetExchangeWishlist(ctx context.Context, req *pb.SetExchangeWishlistRequest) (*pb.SetExchangeWishlistResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	p, err := h.svc.SetWishlist(ctx, req.ExchangeId, userID, req.FolderId)
+	if err != nil {
+		return nil, serviceError(err, "set exchange wishlist")
+	}
+	return &pb.SetExchangeWishlistResponse{Participant: participantToProto(p, userID, false)}, nil
+}
+
+func (h *Handler) GetMyExchangeAssignment(ctx context.Context, req *pb.GetMyExchangeAssignmentRequest) (*pb.GetMyExchangeAssignmentResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	a, err := h.svc.MyAssignment(ctx, req.ExchangeId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get exchange assignment")
+	}
+	resp := &pb.GetMyExchangeAssignmentResponse{
+		Receiver:     participantToProto(&a.Receiver, userID, false),
+		WishlistName: a.FolderName,
+		Wishes:       make([]*pb.ExchangeWish, 0, len(a.Wishes)),
+	}
+	for _, w := range a.Wishes {
+		resp.Wishes = append(resp.Wishes, &pb.ExchangeWish{
+			LinkId: w.ID.Hex(), Title: w.Title, Url: w.URL, ImageUrl: w.ImageURL, Price: w.Price,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,535 @@
+// Package exchange organise les échanges de cadeaux (« Secret Santa ») : un
+// organisateur inscrit les participants et les exclusions (pas de cadeau
+// entre conjoints), puis lance le tirage. Chacun ne découvre que la personne
+// à qui il offre, et la liste de souhaits de celle-ci ; l'organisateur ne voit
+// jamais les tirages, qui sont gardés à part de l'échange.
+package exchange
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	mrand "math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	minParticipants = 3
+	maxParticipants = 100
+	maxNameLength   = 60
+	// maxWishes borne la liste de souhaits montrée au donneur
+	maxWishes = 200
+)
+
+var (
+	ErrExchangeNotFound     = errors.New("gift exchange not found")
+	ErrNotOrganizer         = errors.New("only the organizer can do this")
+	ErrNotParticipant       = errors.New("not a participant of this exchange")
+	ErrNameRequired         = errors.New("exchange name is required")
+	ErrTooFewParticipants   = errors.New("a gift exchange needs at least 3 participants")
+	ErrTooManyParticipants  = errors.New("too many participants")
+	ErrInvalidParticipant   = errors.New("participant needs a known user_id or a valid email")
+	ErrDuplicateParticipant = errors.New("participant listed twice")
+	ErrInvalidExclusion     = errors.New("exclusion must name two different participants")
+	ErrAlreadyDrawn         = errors.New("names have already been drawn")
+	ErrNotDrawn             = errors.New("names have not been drawn yet")
+	ErrInvalidFolder        = errors.New("folder not found or not owned")
+)
+
+// Participant est une personne de l'échange : un utilisateur, ou une adresse
+// e-mail reconnue quand la personne se connecte avec un compte à cette
+// adresse. Key l'identifie dans l'échange.
+type Participant struct {
+	Key    string `bson:"key"`
+	UserID string `bson:"user_id,omitempty"`
+	Email  string `bson:"email,omitempty"` // en minuscules
+	Name   string `bson:"name"`
+	// FolderID est la liste de souhaits choisie par le participant
+	FolderID string `bson:"folder_id,omitempty"`
+}
+
+// Exclusion interdit à deux participants (par leur clé) de se tirer l'un
+// l'autre
+type Exclusion struct {
+	A string `bson:"a"`
+	B string `bson:"b"`
+}
+
+type Exchange struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	OrganizerID  string             `bson:"organizer_id"`
+	Name         string             `bson:"name"`
+	Participants []Participant      `bson:"participants"`
+	Exclusions   []Exclusion        `bson:"exclusions,omitempty"`
+	DrawnAt      *time.Time         `bson:"drawn_at,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+// assignment est le tirage d'un donneur, seulement lu pour ce donneur
+type assignment struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	ExchangeID  string             `bson:"exchange_id"`
+	GiverKey    string             `bson:"giver_key"`
+	ReceiverKey string             `bson:"receiver_key"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
+
+// Wish est un lien de la liste de souhaits du destinataire
+type Wish struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	Title    string             `bson:"title"`
+	URL      string             `bson:"url"`
+	ImageURL string             `bson:"image_url"`
+	Price    string             `bson:"price"`
+}
+
+// Assignment est ce que découvre un participant : à qui il offre, et la
+// liste de souhaits de cette personne si elle en a choisi une
+type Assignment struct {
+	Receiver   Participant
+	FolderName string
+	Wishes     []*Wish
+}
+
+type Service struct {
+	col       *mongo.Collection
+	drawCol   *mongo.Collection
+	userCol   *mongo.Collection
+	folderCol *mongo.Collection
+	linkCol   *mongo.Collection
+	notifier  notify.Notifier
+	shuffle   shuffler
+	now       func() time.Time
+}
+
+// NewService crée le service. notifier peut être nil : les participants ne
+// sont alors pas prévenus du tirage.
+func NewService(col, drawCol, userCol, folderCol, linkCol *mongo.Collection, notifier notify.Notifier) *Service {
+	return &Service{
+		col: col, drawCol: drawCol, userCol: userCol, folderCol: folderCol, linkCol: linkCol,
+		notifier: notifier, shuffle: mrand.Shuffle, now: time.Now,
+	}
+}
+
+type user struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Email       string             `bson:"email"`
+	DisplayName string             `bson:"display_name"`
+}
+
+func (s *Service) user(ctx context.Context, userID string) (*user, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	var u user
+	if err := s.userCol.FindOne(ctx, bson.M{"_id": oid}).Decode(&u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// email retourne l'adresse (normalisée) de l'utilisateur, vide s'il est inconnu
+func (s *Service) email(ctx context.Context, userID string) string {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return ""
+	}
+	return normalizeEmail(u.Email)
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// Create crée un échange. Chaque participant est désigné par son user_id ou
+// par son e-mail ; une adresse déjà inscrite est rattachée au compte. Les
+// exclusions désignent les participants de la même façon.
+func (s *Service) Create(ctx context.Context, organizerID, name string, participants []Participant, exclusions [][2]string) (*Exchange, error) {
+	name = truncate(name, maxNameLength)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	if len(participants) < minParticipants {
+		return nil, ErrTooFewParticipants
+	}
+	if len(participants) > maxParticipants {
+		return nil, ErrTooManyParticipants
+	}
+
+	e := &Exchange{OrganizerID: organizerID, Name: name, CreatedAt: s.now()}
+	// byRef retrouve la clé d'un participant par son user_id ou son e-mail
+	byRef := map[string]string{}
+	for _, in := range participants {
+		p, err := s.resolve(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		refs := []string{p.UserID, p.Email}
+		for _, ref := range refs {
+			if ref == "" {
+				continue
+			}
+			if _, dup := byRef[ref]; dup {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateParticipant, ref)
+			}
+			byRef[ref] = p.Key
+		}
+		e.Participants = append(e.Participants, p)
+	}
+
+	seen := map[[2]string]bool{}
+	for _, ex := range exclusions {
+		a, okA := byRef[normalizeRef(ex[0])]
+		b, okB := byRef[normalizeRef(ex[1])]
+		if !okA || !okB || a == b {
+			return nil, ErrInvalidExclusion
+		}
+		if seen[[2]string{a, b}] || seen[[2]string{b, a}] {
+			continue
+		}
+		seen[[2]string{a, b}] = true
+		e.Exclusions = append(e.Exclusions, Exclusion{A: a, B: b})
+	}
+
+	res, err := s.col.InsertOne(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	e.ID = res.InsertedID.(primitive.ObjectID)
+	return e, nil
+}
+
+// normalizeRef normalise une référence à un participant : les e-mails sont
+// comparés en minuscules, les user_id tels quels
+func normalizeRef(ref string) string {
+	if strings.Contains(ref, "@") {
+		return normalizeEmail(ref)
+	}
+	return strings.TrimSpace(ref)
+}
+
+// resolve vérifie un participant et lui donne une clé. Le nom par défaut est
+// celui du compte, ou la partie locale de l'adresse.
+func (s *Service) resolve(ctx context.Context, in Participant) (Participant, error) {
+	p := Participant{Name: truncate(in.Name, maxNameLength)}
+	switch {
+	case in.UserID != "":
+		u, err := s.user(ctx, strings.TrimSpace(in.UserID))
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return p, ErrInvalidParticipant
+		}
+		if err != nil {
+			return p, err
+		}
+		p.UserID = u.ID.Hex()
+		p.Email = normalizeEmail(u.Email)
+		if p.Name == "" {
+			p.Name = u.DisplayName
+		}
+	case in.Email != "":
+		p.Email = normalizeEmail(in.Email)
+		at := strings.Index(p.Email, "@")
+		if at <= 0 || at == len(p.Email)-1 {
+			return p, ErrInvalidParticipant
+		}
+		var u user
+		err := s.userCol.FindOne(ctx, bson.M{"email": p.Email}).Decode(&u)
+		if err == nil {
+			p.UserID = u.ID.Hex()
+			if p.Name == "" {
+				p.Name = u.DisplayName
+			}
+		} else if !errors.Is(err, mongo.ErrNoDocuments) {
+			return p, err
+		}
+		if p.Name == "" {
+			p.Name = p.Email[:at]
+		}
+	default:
+		return p, ErrInvalidParticipant
+	}
+	key, err := newKey()
+	if err != nil {
+		return p, err
+	}
+	p.Key = key
+	return p, nil
+}
+
+// load charge un échange visible par l'utilisateur : son organisateur ou un
+// de ses participants. Le participant de l'utilisateur est retourné (nil pour
+// un organisateur qui ne participe pas) ; un participant reconnu par son
+// e-mail est rattaché à son compte.
+func (s *Service) load(ctx context.Context, exchangeID, userID string) (*Exchange, *Participant, error) {
+	oid, err := primitive.ObjectIDFromHex(exchangeID)
+	if err != nil {
+		return nil, nil, ErrExchangeNotFound
+	}
+	var e Exchange
+	if err := s.col.FindOne(ctx, bson.M{"_id": oid}).Decode(&e); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, ErrExchangeNotFound
+		}
+		return nil, nil, err
+	}
+	me, err := s.participant(ctx, &e, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if me == nil && e.OrganizerID != userID {
+		return nil, nil, ErrExchangeNotFound
+	}
+	return &e, me, nil
+}
+
+func (s *Service) participant(ctx context.Context, e *Exchange, userID string) (*Participant, error) {
+	for i := range e.Participants {
+		if e.Participants[i].UserID == userID {
+			return &e.Participants[i], nil
+		}
+	}
+	email := s.email(ctx, userID)
+	if email == "" {
+		return nil, nil
+	}
+	for i := range e.Participants {
+		p := &e.Participants[i]
+		if p.UserID != "" || p.Email != email {
+			continue
+		}
+		_, err := s.col.UpdateOne(ctx,
+			bson.M{"_id": e.ID, "participants.key": p.Key},
+			bson.M{"$set": bson.M{"participants.$.user_id": userID}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		p.UserID = userID
+		return p, nil
+	}
+	return nil, nil
+}
+
+// Get retourne un échange à son organisateur ou à un participant
+func (s *Service) Get(ctx context.Context, exchangeID, userID string) (*Exchange, error) {
+	e, _, err := s.load(ctx, exchangeID, userID)
+	return e, err
+}
+
+// List retourne les échanges organisés par l'utilisateur ou auxquels il
+// participe, du plus récent au plus ancien
+func (s *Service) List(ctx context.Context, userID string) ([]*Exchange, error) {
+	or := bson.A{bson.M{"organizer_id": userID}, bson.M{"participants.user_id": userID}}
+	if email := s.email(ctx, userID); email != "" {
+		or = append(or, bson.M{"participants": bson.M{"$elemMatch": bson.M{"email": email, "user_id": nil}}})
+	}
+	cursor, err := s.col.Find(ctx, bson.M{"$or": or}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	out := []*Exchange{}
+	if err := cursor.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Delete supprime un échange et ses tirages
+func (s *Service) Delete(ctx context.Context, exchangeID, organizerID string) error {
+	e, _, err := s.load(ctx, exchangeID, organizerID)
+	if err != nil {
+		return err
+	}
+	if e.OrganizerID != organizerID {
+		return ErrNotOrganizer
+	}
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": e.ID}); err != nil {
+		return err
+	}
+	_, err = s.drawCol.DeleteMany(ctx, bson.M{"exchange_id": exchangeID})
+	return err
+}
+
+// Draw tire au sort les destinataires. Le tirage n'a lieu qu'une fois :
+// drawn_at est posé avant d'enregistrer les tirages, et retiré si
+// l'enregistrement échoue. Les participants inscrits sont prévenus, sans
+// que la notification ne dise à qui ils offrent.
+func (s *Service) Draw(ctx context.Context, exchangeID, organizerID string) (*Exchange, error) {
+	e, _, err := s.load(ctx, exchangeID, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if e.OrganizerID != organizerID {
+		return nil, ErrNotOrganizer
+	}
+	if e.DrawnAt != nil {
+		return nil, ErrAlreadyDrawn
+	}
+
+	keys := make([]string, 0, len(e.Participants))
+	for _, p := range e.Participants {
+		keys = append(keys, p.Key)
+	}
+	excluded := make(map[[2]string]bool, len(e.Exclusions))
+	for _, ex := range e.Exclusions {
+		excluded[[2]string{ex.A, ex.B}] = true
+	}
+	pairs, err := draw(keys, excluded, s.shuffle)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": e.ID, "drawn_at": nil},
+		bson.M{"$set": bson.M{"drawn_at": now}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.ModifiedCount == 0 {
+		return nil, ErrAlreadyDrawn
+	}
+	docs := make([]interface{}, 0, len(pairs))
+	for giver, receiver := range pairs {
+		docs = append(docs, assignment{ExchangeID: exchangeID, GiverKey: giver, ReceiverKey: receiver, CreatedAt: now})
+	}
+	if _, err := s.drawCol.InsertMany(ctx, docs); err != nil {
+		_, _ = s.drawCol.DeleteMany(ctx, bson.M{"exchange_id": exchangeID})
+		_, _ = s.col.UpdateOne(ctx, bson.M{"_id": e.ID}, bson.M{"$unset": bson.M{"drawn_at": ""}})
+		return nil, err
+	}
+	e.DrawnAt = &now
+
+	if s.notifier != nil {
+		for _, p := range e.Participants {
+			if p.UserID == "" {
+				continue
+			}
+			_ = s.notifier.Notify(ctx, notify.Notification{
+				UserID: p.UserID,
+				Title:  e.Name,
+				Body:   "Le tirage au sort est fait : découvre à qui tu offres un cadeau !",
+			})
+		}
+	}
+	return e, nil
+}
+
+// SetWishlist choisit le dossier qui sert de liste de souhaits au
+// participant ; folderID vide la retire. Le dossier doit lui appartenir.
+func (s *Service) SetWishlist(ctx context.Context, exchangeID, userID, folderID string) (*Participant, error) {
+	e, me, err := s.load(ctx, exchangeID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if me == nil {
+		return nil, ErrNotParticipant
+	}
+	update := bson.M{"$unset": bson.M{"participants.$.folder_id": ""}}
+	if folderID != "" {
+		fid, err := primitive.ObjectIDFromHex(folderID)
+		if err != nil {
+			return nil, ErrInvalidFolder
+		}
+		n, err := s.folderCol.CountDocuments(ctx, bson.M{"_id": fid, "owner_id": userID, "deleted_at": nil})
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, ErrInvalidFolder
+		}
+		update = bson.M{"$set": bson.M{"participants.$.folder_id": folderID}}
+	}
+	if _, err := s.col.UpdateOne(ctx, bson.M{"_id": e.ID, "participants.key": me.Key}, update); err != nil {
+		return nil, err
+	}
+	me.FolderID = folderID
+	return me, nil
+}
+
+// MyAssignment retourne au participant la personne qu'il a tirée et sa liste
+// de souhaits, quel que soit le partage du dossier : le tirage lui en donne
+// l'accès, en lecture seule.
+func (s *Service) MyAssignment(ctx context.Context, exchangeID, userID string) (*Assignment, error) {
+	e, me, err := s.load(ctx, exchangeID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if me == nil {
+		return nil, ErrNotParticipant
+	}
+	if e.DrawnAt == nil {
+		return nil, ErrNotDrawn
+	}
+	var a assignment
+	err = s.drawCol.FindOne(ctx, bson.M{"exchange_id": exchangeID, "giver_key": me.Key}).Decode(&a)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotDrawn
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &Assignment{Wishes: []*Wish{}}
+	for _, p := range e.Participants {
+		if p.Key == a.ReceiverKey {
+			out.Receiver = p
+		}
+	}
+	if out.Receiver.FolderID == "" {
+		return out, nil
+	}
+
+	fid, err := primitive.ObjectIDFromHex(out.Receiver.FolderID)
+	if err != nil {
+		return out, nil
+	}
+	var folder struct {
+		Name string `bson:"name"`
+	}
+	err = s.folderCol.FindOne(ctx, bson.M{"_id": fid, "deleted_at": nil}).Decode(&folder)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out.FolderName = folder.Name
+	cursor, err := s.linkCol.Find(ctx,
+		bson.M{"folder_id": out.Receiver.FolderID, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(maxWishes),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &out.Wishes); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func newKey() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
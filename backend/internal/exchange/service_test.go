@@ -0,0 +1,173 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+// L'organisateur lance le tirage sans jamais le voir ; chaque participant,
+// même inscrit après coup par son e-mail, découvre son destinataire et sa
+// liste de souhaits
+func TestExchange_SecretSanta(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("gift_exchanges"), db.Collection("gift_exchange_draws"),
+		db.Collection("users"), db.Collection("folders"), db.Collection("links"), nil)
+
+	addUser := func(email, name string) string {
+		id := primitive.NewObjectID()
+		if _, err := db.Collection("users").InsertOne(ctx, bson.M{"_id": id, "email": email, "display_name": name}); err != nil {
+			t.Fatalf("insert user: %v", err)
+		}
+		return id.Hex()
+	}
+	organizer := addUser("tata@example.com", "Tata")
+	papi := addUser("papi@example.com", "Papi")
+	mamie := addUser("mamie@example.com", "Mamie")
+
+	participants := []Participant{
+		{UserID: papi},
+		{UserID: mamie},
+		{Email: "Paul@Example.com", Name: "Paul"},
+		{Email: "julie@example.com"},
+	}
+	if _, err := svc.Create(ctx, organizer, "Noël", participants[:2], nil); !errors.Is(err, ErrTooFewParticipants) {
+		t.Errorf("2 participants err = %v, want ErrTooFewParticipants", err)
+	}
+	if _, err := svc.Create(ctx, organizer, "Noël", append(participants, Participant{Email: "papi@example.com"}), nil); !errors.Is(err, ErrDuplicateParticipant) {
+		t.Errorf("duplicate err = %v, want ErrDuplicateParticipant", err)
+	}
+	if _, err := svc.Create(ctx, organizer, "Noël", participants, [][2]string{{papi, "nobody@example.com"}}); !errors.Is(err, ErrInvalidExclusion) {
+		t.Errorf("unknown exclusion err = %v, want ErrInvalidExclusion", err)
+	}
+	e, err := svc.Create(ctx, organizer, "Noël", participants, [][2]string{
+		{papi, "mamie@example.com"},
+		{"paul@example.com", "JULIE@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if len(e.Exclusions) != 2 || e.Participants[3].Name != "julie" || e.Participants[0].Name != "Papi" {
+		t.Fatalf("exchange = %+v", e)
+	}
+	id := e.ID.Hex()
+
+	if _, err := svc.MyAssignment(ctx, id, papi); !errors.Is(err, ErrNotDrawn) {
+		t.Errorf("assignment before draw err = %v, want ErrNotDrawn", err)
+	}
+	if _, err := svc.Draw(ctx, id, papi); !errors.Is(err, ErrNotOrganizer) {
+		t.Errorf("participant draw err = %v, want ErrNotOrganizer", err)
+	}
+	if _, err := svc.Draw(ctx, id, organizer); err != nil {
+		t.Fatalf("draw: %v", err)
+	}
+	if _, err := svc.Draw(ctx, id, organizer); !errors.Is(err, ErrAlreadyDrawn) {
+		t.Errorf("second draw err = %v, want ErrAlreadyDrawn", err)
+	}
+	if _, err := svc.MyAssignment(ctx, id, organizer); !errors.Is(err, ErrNotParticipant) {
+		t.Errorf("organizer assignment err = %v, want ErrNotParticipant", err)
+	}
+	stranger := addUser("stranger@example.com", "X")
+	if _, err := svc.Get(ctx, id, stranger); !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("stranger get err = %v, want ErrExchangeNotFound", err)
+	}
+
+	// Paul et Julie s'inscrivent après le tirage
+	paul := addUser("paul@example.com", "Paul D.")
+	julie := addUser("julie@example.com", "Julie")
+	if got, err := svc.List(ctx, julie); err != nil || len(got) != 1 {
+		t.Fatalf("julie list = %v, %v", got, err)
+	}
+
+	// La liste de souhaits de Julie
+	wishlist := primitive.NewObjectID()
+	if _, err := db.Collection("folders").InsertOne(ctx, bson.M{"_id": wishlist, "owner_id": julie, "name": "Idées Julie", "visibility": "private"}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	if _, err := db.Collection("links").InsertOne(ctx, bson.M{"folder_id": wishlist.Hex(), "owner_id": julie, "title": "Vélo", "created_at": time.Now()}); err != nil {
+		t.Fatalf("insert link: %v", err)
+	}
+	if _, err := svc.SetWishlist(ctx, id, paul, wishlist.Hex()); !errors.Is(err, ErrInvalidFolder) {
+		t.Errorf("foreign wishlist err = %v, want ErrInvalidFolder", err)
+	}
+	if _, err := svc.SetWishlist(ctx, id, julie, wishlist.Hex()); err != nil {
+		t.Fatalf("set wishlist: %v", err)
+	}
+
+	users := map[string]string{papi: "Papi", mamie: "Mamie", paul: "Paul", julie: "julie"}
+	spouse := map[string]string{"Papi": "Mamie", "Mamie": "Papi", "Paul": "julie", "julie": "Paul"}
+	received := map[string]bool{}
+	for uid, name := range users {
+		a, err := svc.MyAssignment(ctx, id, uid)
+		if err != nil {
+			t.Fatalf("%s assignment: %v", name, err)
+		}
+		to := a.Receiver.Name
+		if to == name || to == spouse[name] || received[to] {
+			t.Errorf("%s drew %s", name, to)
+		}
+		received[to] = true
+		if to == "julie" && (a.FolderName != "Idées Julie" || len(a.Wishes) != 1 || a.Wishes[0].Title != "Vélo") {
+			t.Errorf("julie's wishlist = %q %+v", a.FolderName, a.Wishes)
+		}
+	}
+
+	// Les tirages ne sont pas dans l'échange
+	var raw bson.M
+	if err := db.Collection("gift_exchanges").FindOne(ctx, bson.M{"_id": e.ID}).Decode(&raw); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"draws", "assignments", "receiver_key"} {
+		if _, ok := raw[k]; ok {
+			t.Errorf("exchange document exposes %q", k)
+		}
+	}
+
+	if err := svc.Delete(ctx, id, papi); !errors.Is(err, ErrNotOrganizer) {
+		t.Errorf("participant delete err = %v, want ErrNotOrganizer", err)
+	}
+	if err := svc.Delete(ctx, id, organizer); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if n, _ := db.Collection("gift_exchange_draws").CountDocuments(ctx, bson.M{}); n != 0 {
+		t.Errorf("%d draws left after delete", n)
+	}
+}
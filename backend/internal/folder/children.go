@@ -0,0 +1,158 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/tribbae/backend/internal/child"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrInvalidChild est retourné quand un dossier est rattaché à un enfant
+	// qui n'est pas celui de son propriétaire
+	ErrInvalidChild  = errors.New("child not found or not owned by the folder owner")
+	ErrChildNotFound = errors.New("child not found")
+)
+
+// childObjectIDs convertit des identifiants d'enfants, sans doublon
+func childObjectIDs(childIDs []string) ([]primitive.ObjectID, error) {
+	var ids []primitive.ObjectID
+	for _, cid := range childIDs {
+		oid, err := primitive.ObjectIDFromHex(cid)
+		if err != nil {
+			return nil, ErrInvalidChild
+		}
+		if !slices.Contains(ids, oid) {
+			ids = append(ids, oid)
+		}
+	}
+	return ids, nil
+}
+
+// checkChildren vérifie que les enfants sont ceux du propriétaire du dossier,
+// à lui ou partagés par un co-parent ou son foyer
+func (s *Service) checkChildren(ctx context.Context, ownerID string, childIDs []string) error {
+	if len(childIDs) == 0 {
+		return nil
+	}
+	ids, err := childObjectIDs(childIDs)
+	if err != nil {
+		return err
+	}
+	owner, err := primitive.ObjectIDFromHex(ownerID)
+	if err != nil {
+		return ErrInvalidChild
+	}
+	filter, err := child.FamilyAccessFilter(ctx, s.childCol.Database(), owner)
+	if err != nil {
+		return err
+	}
+	filter["_id"] = bson.M{"$in": ids}
+	n, err := s.childCol.CountDocuments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if n != int64(len(ids)) {
+		return ErrInvalidChild
+	}
+	return nil
+}
+
+// ListChildFolders retourne les dossiers rattachés à un enfant de
+// l'utilisateur, parmi ceux qu'il possède ou auxquels il collabore
+func (s *Service) ListChildFolders(ctx context.Context, childID, userID string) ([]*Folder, error) {
+	cid, err := primitive.ObjectIDFromHex(childID)
+	if err != nil {
+		return nil, ErrChildNotFound
+	}
+	owner, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrChildNotFound
+	}
+	filter, err := child.FamilyAccessFilter(ctx, s.childCol.Database(), owner)
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = cid
+	n, err := s.childCol.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrChildNotFound
+	}
+	cursor, err := s.col.Find(ctx, bson.M{
+		"child_ids":  childID,
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"owner_id": userID},
+			bson.M{"collaborators.user_id": userID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	folders := []*Folder{}
+	return folders, cursor.All(ctx, &folders)
+}
+
+// Children retourne les enfants rattachés au dossier, dans l'ordre du dossier
+func (s *Service) Children(ctx context.Context, f *Folder) ([]*child.Child, error) {
+	if len(f.ChildIDs) == 0 {
+		return nil, nil
+	}
+	ids, err := childObjectIDs(f.ChildIDs)
+	if err != nil {
+		return nil, nil
+	}
+	owner, err := primitive.ObjectIDFromHex(f.OwnerID)
+	if err != nil {
+		return nil, nil
+	}
+	filter, err := child.FamilyAccessFilter(ctx, s.childCol.Database(), owner)
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = bson.M{"$in": ids}
+	cursor, err := s.childCol.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var found []*child.Child
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	out := make([]*child.Child, 0, len(found))
+	for _, id := range ids {
+		for _, c := range found {
+			if c.ID == id {
+				out = append(out, c)
+			}
+		}
+	}
+	return out, nil
+}
+
+// GetSharedChild retourne la page d'un enfant ouverte par le lien de partage
+// d'un dossier qui lui est rattaché : l'enfant, le dossier et ses liens
+func (s *Service) GetSharedChild(ctx context.Context, token, childID string) (*child.Child, *Folder, []map[string]any, error) {
+	f, links, err := s.GetByShareToken(ctx, token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !slices.Contains(f.ChildIDs, childID) {
+		return nil, nil, nil, ErrChildNotFound
+	}
+	children, err := s.Children(ctx, &Folder{OwnerID: f.OwnerID, ChildIDs: []string{childID}})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(children) == 0 {
+		return nil, nil, nil, ErrChildNotFound
+	}
+	return children[0], f, links, nil
+}
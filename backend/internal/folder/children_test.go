@@ -0,0 +1,89 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestChildFolders(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "http://localhost")
+	children := child.NewService(db)
+
+	parent := primitive.NewObjectID()
+	other := primitive.NewObjectID()
+	lea, err := children.Create(ctx, parent, "Léa", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tom, err := children.Create(ctx, parent, "Tom", time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stranger, err := children.Create(ctx, other, "Zoé", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.Create(ctx, parent.Hex(), "Liste", "", "", "private", "", nil, []string{stranger.ID.Hex()}); !errors.Is(err, ErrInvalidChild) {
+		t.Errorf("foreign child err = %v, want ErrInvalidChild", err)
+	}
+	wishlist, err := svc.Create(ctx, parent.Hex(), "Anniversaire de Léa", "", "", "private", "", nil, []string{lea.ID.Hex()})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := svc.Create(ctx, parent.Hex(), "Sorties", "", "", "private", "", nil, nil); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	got, err := svc.ListChildFolders(ctx, lea.ID.Hex(), parent.Hex())
+	if err != nil || len(got) != 1 || got[0].Name != "Anniversaire de Léa" {
+		t.Fatalf("ListChildFolders(Léa) = %v, %v", got, err)
+	}
+	if _, err := svc.ListChildFolders(ctx, lea.ID.Hex(), other.Hex()); !errors.Is(err, ErrChildNotFound) {
+		t.Errorf("other parent err = %v, want ErrChildNotFound", err)
+	}
+
+	// Sans masque, les enfants restent rattachés ; avec, ils sont remplacés
+	if _, err := svc.Update(ctx, wishlist.ID.Hex(), parent.Hex(), &Folder{Name: "Léa a 7 ans"}, nil, ""); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got, _ := svc.ListChildFolders(ctx, lea.ID.Hex(), parent.Hex()); len(got) != 1 {
+		t.Errorf("full update detached Léa: %v", got)
+	}
+	if _, err := svc.Update(ctx, wishlist.ID.Hex(), parent.Hex(), &Folder{ChildIDs: []string{stranger.ID.Hex()}}, []string{"child_ids"}, ""); !errors.Is(err, ErrInvalidChild) {
+		t.Errorf("update foreign child err = %v, want ErrInvalidChild", err)
+	}
+	f, err := svc.Update(ctx, wishlist.ID.Hex(), parent.Hex(), &Folder{ChildIDs: []string{lea.ID.Hex(), tom.ID.Hex()}}, []string{"child_ids"}, "")
+	if err != nil || len(f.ChildIDs) != 2 {
+		t.Fatalf("update child_ids = %v, %v", f, err)
+	}
+
+	token, _, err := svc.GenerateShareToken(ctx, wishlist.ID.Hex(), parent.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared, _, err := svc.GetByShareToken(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kids, err := svc.Children(ctx, shared)
+	if err != nil || len(kids) != 2 || kids[0].Name != "Léa" || kids[1].Name != "Tom" {
+		t.Errorf("Children = %v, %v", kids, err)
+	}
+	c, sf, _, err := svc.GetSharedChild(ctx, token, tom.ID.Hex())
+	if err != nil || c.Name != "Tom" || sf.ID != wishlist.ID {
+		t.Errorf("GetSharedChild(Tom) = %v, %v, %v", c, sf, err)
+	}
+	if _, _, _, err := svc.GetSharedChild(ctx, token, stranger.ID.Hex()); !errors.Is(err, ErrChildNotFound) {
+		t.Errorf("GetSharedChild(stranger) err = %v, want ErrChildNotFound", err)
+	}
+}
@@ -2,9 +2,13 @@ package folder
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/agerange"
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/etag"
 	"github.com/tribbae/backend/internal/interceptor"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,6 +55,7 @@ func (h *Handler) toProto(ctx context.Context, f *Folder) *pb.Folder {
 			DisplayName: c.DisplayName,
 			Role:        collabRoleToProto(c.Role),
 			AddedAt:     timestamppb.New(c.AddedAt),
+			HouseholdId: c.HouseholdID,
 		})
 	}
 
@@ -81,6 +86,11 @@ func (h *Handler) toProto(ctx context.Context, f *Folder) *pb.Folder {
 		LikedByMe:        likedByMe,
 		AiGenerated:      f.AiGenerated,
 		OwnerIsAdmin:     ownerIsAdmin,
+		Archived:         f.Archived,
+		Frozen:           f.Frozen,
+		Etag:             etag.Format(f.Version),
+		ChildIds:         f.ChildIDs,
+		HouseholdIds:     f.HouseholdIDs,
 	}
 }
 
@@ -100,8 +110,11 @@ func (h *Handler) CreateFolder(ctx context.Context, req *pb.CreateFolderRequest)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
 	}
-	f, err := h.svc.Create(ctx, ownerID, req.Name, req.Icon, req.Color, visibilityStr(req.Visibility), req.BannerUrl, req.Tags)
-	if err != nil {
+	f, err := h.svc.Create(ctx, ownerID, req.Name, req.Icon, req.Color, visibilityStr(req.Visibility), req.BannerUrl, req.Tags, req.ChildIds)
+	switch {
+	case errors.Is(err, ErrInvalidChild):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &pb.CreateFolderResponse{Folder: h.toProto(ctx, f)}, nil
@@ -120,12 +133,12 @@ func (h *Handler) GetFolder(ctx context.Context, req *pb.GetFolderRequest) (*pb.
 }
 
 
-func (h *Handler) ListFolders(ctx context.Context, _ *pb.ListFoldersRequest) (*pb.ListFoldersResponse, error) {
+func (h *Handler) ListFolders(ctx context.Context, req *pb.ListFoldersRequest) (*pb.ListFoldersResponse, error) {
 	ownerID, err := interceptor.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
 	}
-	folders, err := h.svc.List(ctx, ownerID)
+	folders, err := h.svc.List(ctx, ownerID, req.IncludeArchived)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -141,8 +154,22 @@ func (h *Handler) UpdateFolder(ctx context.Context, req *pb.UpdateFolderRequest)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
 	}
-	f, err := h.svc.Update(ctx, req.FolderId, ownerID, req.Name, req.Icon, req.Color, visibilityStr(req.Visibility), req.BannerUrl, req.Tags)
-	if err != nil {
+	changes := &Folder{
+		Name:       req.Name,
+		Icon:       req.Icon,
+		Color:      req.Color,
+		Visibility: visibilityStr(req.Visibility),
+		BannerURL:  req.BannerUrl,
+		Tags:       req.Tags,
+		ChildIDs:   req.ChildIds,
+	}
+	f, err := h.svc.Update(ctx, req.FolderId, ownerID, changes, req.GetUpdateMask().GetPaths(), req.Etag)
+	switch {
+	case errors.Is(err, etag.ErrMismatch):
+		return nil, status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, etag.ErrInvalid), errors.Is(err, ErrInvalidUpdateMask), errors.Is(err, ErrInvalidChild):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &pb.UpdateFolderResponse{Folder: h.toProto(ctx, f)}, nil
@@ -159,6 +186,54 @@ func (h *Handler) DeleteFolder(ctx context.Context, req *pb.DeleteFolderRequest)
 	return &pb.DeleteFolderResponse{}, nil
 }
 
+func (h *Handler) ArchiveFolder(ctx context.Context, req *pb.ArchiveFolderRequest) (*pb.ArchiveFolderResponse, error) {
+	ownerID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	f, err := h.svc.SetArchived(ctx, req.FolderId, ownerID, true)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &pb.ArchiveFolderResponse{Folder: h.toProto(ctx, f)}, nil
+}
+
+func (h *Handler) UnarchiveFolder(ctx context.Context, req *pb.UnarchiveFolderRequest) (*pb.UnarchiveFolderResponse, error) {
+	ownerID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	f, err := h.svc.SetArchived(ctx, req.FolderId, ownerID, false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &pb.UnarchiveFolderResponse{Folder: h.toProto(ctx, f)}, nil
+}
+
+func (h *Handler) FreezeFolder(ctx context.Context, req *pb.FreezeFolderRequest) (*pb.FreezeFolderResponse, error) {
+	ownerID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	f, err := h.svc.SetFrozen(ctx, req.FolderId, ownerID, true)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &pb.FreezeFolderResponse{Folder: h.toProto(ctx, f)}, nil
+}
+
+func (h *Handler) UnfreezeFolder(ctx context.Context, req *pb.UnfreezeFolderRequest) (*pb.UnfreezeFolderResponse, error) {
+	ownerID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	f, err := h.svc.SetFrozen(ctx, req.FolderId, ownerID, false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &pb.UnfreezeFolderResponse{Folder: h.toProto(ctx, f)}, nil
+}
+
 func (h *Handler) GenerateShareToken(ctx context.Context, req *pb.GenerateShareTokenRequest) (*pb.GenerateShareTokenResponse, error) {
 	ownerID, err := interceptor.UserIDFromContext(ctx)
 	if err != nil {
@@ -176,6 +251,67 @@ func (h *Handler) GetSharedFolder(ctx context.Context, req *pb.GetSharedFolderRe
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	children, err := h.svc.Children(ctx, f)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	now := time.Now()
+	var pbChildren []*pb.SharedChild
+	for _, c := range children {
+		pbChildren = append(pbChildren, sharedChildToProto(c, now))
+	}
+	return &pb.GetSharedFolderResponse{Folder: h.toProto(ctx, f), Links: sharedLinksToProto(rawLinks), Children: pbChildren}, nil
+}
+
+func (h *Handler) GetSharedChild(ctx context.Context, req *pb.GetSharedChildRequest) (*pb.GetSharedChildResponse, error) {
+	c, f, rawLinks, err := h.svc.GetSharedChild(ctx, req.ShareToken, req.ChildId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &pb.GetSharedChildResponse{
+		Child:  sharedChildToProto(c, time.Now()),
+		Folder: h.toProto(ctx, f),
+		Links:  sharedLinksToProto(rawLinks),
+	}, nil
+}
+
+func (h *Handler) ListChildFolders(ctx context.Context, req *pb.ListChildFoldersRequest) (*pb.ListChildFoldersResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	folders, err := h.svc.ListChildFolders(ctx, req.ChildId, userID)
+	switch {
+	case errors.Is(err, ErrChildNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pbFolders := make([]*pb.Folder, 0, len(folders))
+	for _, f := range folders {
+		pbFolders = append(pbFolders, h.toProto(ctx, f))
+	}
+	return &pb.ListChildFoldersResponse{Folders: pbFolders}, nil
+}
+
+// sharedChildToProto présente un enfant sur une page partagée : âge et
+// prochain anniversaire, sans la date de naissance
+func sharedChildToProto(c *child.Child, now time.Time) *pb.SharedChild {
+	next, turning := c.NextBirthday(now)
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	return &pb.SharedChild{
+		Id:                c.ID.Hex(),
+		Name:              c.Name,
+		AgeMonths:         agerange.AgeMonths(c.Birth(), now),
+		NextBirthday:      next.Format("2006-01-02"),
+		Turning:           int32(turning),
+		DaysUntilBirthday: int32(next.Sub(today).Round(24*time.Hour) / (24 * time.Hour)),
+	}
+}
+
+// sharedLinksToProto présente les liens d'un dossier partagé
+func sharedLinksToProto(rawLinks []map[string]any) []*pb.Link {
 	var pbLinks []*pb.Link
 	for _, m := range rawLinks {
 		l := &pb.Link{}
@@ -201,7 +337,7 @@ func (h *Handler) GetSharedFolder(ctx context.Context, req *pb.GetSharedFolderRe
 		}
 		pbLinks = append(pbLinks, l)
 	}
-	return &pb.GetSharedFolderResponse{Folder: h.toProto(ctx, f), Links: pbLinks}, nil
+	return pbLinks
 }
 
 func (h *Handler) AddCollaborator(ctx context.Context, req *pb.AddCollaboratorRequest) (*pb.AddCollaboratorResponse, error) {
@@ -222,7 +358,10 @@ func (h *Handler) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollabor
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
 	}
 	f, err := h.svc.RemoveCollaborator(ctx, req.FolderId, ownerID, req.UserId)
-	if err != nil {
+	switch {
+	case errors.Is(err, ErrHouseholdCollaborator):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &pb.RemoveCollaboratorResponse{Folder: h.toProto(ctx, f)}, nil
@@ -230,6 +369,9 @@ func (h *Handler) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollabor
 
 func (h *Handler) ListCommunityFolders(ctx context.Context, req *pb.ListCommunityFoldersRequest) (*pb.ListCommunityFoldersResponse, error) {
 	folders, nextToken, err := h.svc.ListCommunity(ctx, req.Search, req.PageSize, req.PageToken)
+	if errors.Is(err, ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list community folders: %v", err)
 	}
@@ -6,8 +6,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/tribbae/backend/internal/etag"
+	"github.com/tribbae/backend/internal/pagetoken"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -20,6 +23,9 @@ type CollaboratorEntry struct {
 	DisplayName string    `bson:"display_name"`
 	Role        string    `bson:"role"` // "viewer" | "editor"
 	AddedAt     time.Time `bson:"added_at"`
+	// HouseholdID est renseigné quand l'accès vient d'un foyer avec lequel le
+	// dossier est partagé ; l'entrée est alors tenue à jour par household
+	HouseholdID string `bson:"household_id,omitempty"`
 }
 
 type Folder struct {
@@ -36,25 +42,42 @@ type Folder struct {
 	LikeCount     int32               `bson:"like_count"`
 	LikedBy       []string            `bson:"liked_by,omitempty"`
 	AiGenerated   bool                `bson:"ai_generated"`
+	Archived      bool                `bson:"archived,omitempty"` // masqué des listes par défaut
+	Frozen        bool                `bson:"frozen,omitempty"`   // liens en lecture seule pour tous
 	CreatedAt     time.Time           `bson:"created_at"`
 	UpdatedAt     time.Time           `bson:"updated_at"`
+	DeletedAt     *time.Time          `bson:"deleted_at,omitempty"` // non nil = dans la corbeille
+	Version       int64               `bson:"version"`              // incrémentée à chaque modification (etag)
+	// LinkText agrège titres, descriptions et tags des liens du dossier pour
+	// l'index texte de la recherche communautaire (maintenu par link.Service).
+	LinkText string `bson:"link_text,omitempty"`
+	// ChildIDs rattache le dossier à des enfants de son propriétaire (liste
+	// d'anniversaire…)
+	ChildIDs []string `bson:"child_ids,omitempty"`
+	// HouseholdIDs sont les foyers avec lesquels le dossier est partagé :
+	// leurs membres figurent dans Collaborators
+	HouseholdIDs []string `bson:"household_ids,omitempty"`
 }
 
 type Service struct {
-	col     *mongo.Collection
-	linkCol *mongo.Collection
-	userCol *mongo.Collection
-	baseURL string
+	col      *mongo.Collection
+	linkCol  *mongo.Collection
+	userCol  *mongo.Collection
+	childCol *mongo.Collection
+	baseURL  string
 }
 
 func NewService(col *mongo.Collection, linkCol *mongo.Collection, userCol *mongo.Collection, baseURL string) *Service {
-	return &Service{col: col, linkCol: linkCol, userCol: userCol, baseURL: baseURL}
+	return &Service{col: col, linkCol: linkCol, userCol: userCol, childCol: col.Database().Collection("children"), baseURL: baseURL}
 }
 
-func (s *Service) Create(ctx context.Context, ownerID, name, icon, color, visibility, bannerURL string, tags []string) (*Folder, error) {
+func (s *Service) Create(ctx context.Context, ownerID, name, icon, color, visibility, bannerURL string, tags, childIDs []string) (*Folder, error) {
 	if tags == nil {
 		tags = []string{}
 	}
+	if err := s.checkChildren(ctx, ownerID, childIDs); err != nil {
+		return nil, err
+	}
 	f := &Folder{
 		ID:         primitive.NewObjectID(),
 		OwnerID:    ownerID,
@@ -64,6 +87,7 @@ func (s *Service) Create(ctx context.Context, ownerID, name, icon, color, visibi
 		BannerURL:  bannerURL,
 		Tags:       tags,
 		Visibility: visibility,
+		ChildIDs:   childIDs,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}
@@ -81,7 +105,8 @@ func (s *Service) Get(ctx context.Context, folderID, ownerID string) (*Folder, e
 	var f Folder
 	// Owner OU collaborateur peut accéder
 	filter := bson.M{
-		"_id": id,
+		"_id":        id,
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"owner_id": ownerID},
 			bson.M{"collaborators.user_id": ownerID},
@@ -93,14 +118,18 @@ func (s *Service) Get(ctx context.Context, folderID, ownerID string) (*Folder, e
 	return &f, nil
 }
 
-func (s *Service) List(ctx context.Context, ownerID string) ([]*Folder, error) {
+func (s *Service) List(ctx context.Context, ownerID string, includeArchived bool) ([]*Folder, error) {
 	// Retourne les dossiers dont l'utilisateur est owner OU collaborateur
 	filter := bson.M{
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"owner_id": ownerID},
 			bson.M{"collaborators.user_id": ownerID},
 		},
 	}
+	if !includeArchived {
+		filter["archived"] = bson.M{"$ne": true}
+	}
 	cursor, err := s.col.Find(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -110,66 +139,236 @@ func (s *Service) List(ctx context.Context, ownerID string) ([]*Folder, error) {
 	return folders, cursor.All(ctx, &folders)
 }
 
-func (s *Service) Update(ctx context.Context, folderID, ownerID, name, icon, color, visibility, bannerURL string, tags []string) (*Folder, error) {
-	if tags == nil {
-		tags = []string{}
-	}
+// ErrInvalidUpdateMask est retourné quand un masque de mise à jour cite un
+// champ inconnu ou non modifiable
+var ErrInvalidUpdateMask = errors.New("invalid update mask")
+
+// ErrHouseholdCollaborator est retourné quand on retire un collaborateur qui
+// tient son accès d'un foyer : c'est au foyer de le retirer
+var ErrHouseholdCollaborator = errors.New("collaborator has access through a household; remove them from the household or unshare the folder from it")
+
+// updatableFields associe chaque champ modifiable de l'API à sa valeur en base
+var updatableFields = map[string]func(f *Folder) (string, any){
+	"name":       func(f *Folder) (string, any) { return "name", f.Name },
+	"icon":       func(f *Folder) (string, any) { return "icon", f.Icon },
+	"color":      func(f *Folder) (string, any) { return "color", f.Color },
+	"visibility": func(f *Folder) (string, any) { return "visibility", f.Visibility },
+	"banner_url": func(f *Folder) (string, any) { return "banner_url", f.BannerURL },
+	"tags": func(f *Folder) (string, any) {
+		if f.Tags == nil {
+			return "tags", []string{}
+		}
+		return "tags", f.Tags
+	},
+	"child_ids": func(f *Folder) (string, any) {
+		if f.ChildIDs == nil {
+			return "child_ids", []string{}
+		}
+		return "child_ids", f.ChildIDs
+	},
+}
+
+// fullUpdate liste les champs remplacés sans masque ; child_ids n'est
+// modifié que s'il est cité, pour que les clients qui l'ignorent ne
+// détachent pas les enfants
+var fullUpdate = []string{"name", "icon", "color", "visibility", "banner_url", "tags"}
+
+// Update modifie les champs paths du dossier avec les valeurs de changes.
+// Sans paths (ou avec "*"), tous les champs sont remplacés. Si etagValue est
+// renseigné, la modification n'est faite que si le dossier n'a pas changé depuis.
+func (s *Service) Update(ctx context.Context, folderID, ownerID string, changes *Folder, paths []string, etagValue string) (*Folder, error) {
 	id, err := primitive.ObjectIDFromHex(folderID)
 	if err != nil {
 		return nil, errors.New("invalid folder id")
 	}
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		paths = fullUpdate
+	}
+	set := bson.M{"updated_at": time.Now()}
+	for _, p := range paths {
+		field, ok := updatableFields[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateMask, p)
+		}
+		key, value := field(changes)
+		set[key] = value
+		if p == "child_ids" {
+			// Les enfants sont ceux du propriétaire, même si un éditeur modifie
+			var owner struct {
+				OwnerID string `bson:"owner_id"`
+			}
+			if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&owner); err == nil {
+				if err := s.checkChildren(ctx, owner.OwnerID, changes.ChildIDs); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	// Owner OU éditeur peut modifier
 	filter := bson.M{
-		"_id": id,
+		"_id":        id,
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"owner_id": ownerID},
 			bson.M{"collaborators": bson.M{"$elemMatch": bson.M{"user_id": ownerID, "role": "editor"}}},
 		},
 	}
-	// Update includes visibility field to ensure it's persisted correctly
-	update := bson.M{"$set": bson.M{
-		"name": name, "icon": icon, "color": color,
-		"visibility": visibility, "banner_url": bannerURL, "tags": tags, "updated_at": time.Now(),
-	}}
-	res, err := s.col.UpdateOne(ctx, filter, update)
+	guarded := filter
+	if etagValue != "" {
+		versionFilter, err := etag.Filter(etagValue)
+		if err != nil {
+			return nil, err
+		}
+		guarded = bson.M{"version": versionFilter["version"]}
+		for k, v := range filter {
+			guarded[k] = v
+		}
+	}
+
+	res, err := s.col.UpdateOne(ctx, guarded, bson.M{"$set": set, "$inc": bson.M{"version": 1}})
 	if err != nil {
 		return nil, err
 	}
 	if res.MatchedCount == 0 {
+		// Le dossier existe et est modifiable : c'est l'etag qui ne correspond plus
+		if etagValue != "" {
+			if n, _ := s.col.CountDocuments(ctx, filter); n > 0 {
+				return nil, etag.ErrMismatch
+			}
+		}
 		return nil, errors.New("not found or not authorized")
 	}
 	return s.Get(ctx, folderID, ownerID)
 }
 
+// Delete place le dossier dans la corbeille. Ses liens y partent avec lui
+// (deleted_with_folder) pour pouvoir être restaurés ensemble.
 func (s *Service) Delete(ctx context.Context, folderID, ownerID string) error {
 	id, err := primitive.ObjectIDFromHex(folderID)
 	if err != nil {
 		return errors.New("invalid folder id")
 	}
+	now := time.Now()
 	// Seul le owner peut supprimer
-	res, err := s.col.DeleteOne(ctx, bson.M{"_id": id, "owner_id": ownerID})
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": id, "owner_id": ownerID, "deleted_at": nil},
+		bson.M{"$set": bson.M{"deleted_at": now, "updated_at": now}},
+	)
 	if err != nil {
 		return err
 	}
-	if res.DeletedCount == 0 {
+	if res.MatchedCount == 0 {
 		return errors.New("folder not found or not authorized to delete")
 	}
-	
-	// Orphan associated links by setting their folder_id to empty string
-	// This preserves user data while removing the folder association
+
 	_, err = s.linkCol.UpdateMany(ctx,
-		bson.M{"folder_id": folderID},
-		bson.M{"$set": bson.M{"folder_id": "", "updated_at": time.Now()}},
+		bson.M{"folder_id": folderID, "deleted_at": nil},
+		bson.M{"$set": bson.M{"deleted_at": now, "deleted_with_folder": true}},
 	)
 	if err != nil {
-		// Log the error but don't fail the deletion
-		// The folder is already deleted at this point
-		return fmt.Errorf("folder deleted but failed to orphan links: %w", err)
+		return fmt.Errorf("folder trashed but failed to trash links: %w", err)
 	}
-	
 	return nil
 }
 
+// ListTrash retourne les dossiers de l'utilisateur présents dans la corbeille
+func (s *Service) ListTrash(ctx context.Context, ownerID string) ([]*Folder, error) {
+	opts := options.Find().SetSort(bson.M{"deleted_at": -1})
+	cursor, err := s.col.Find(ctx, bson.M{"owner_id": ownerID, "deleted_at": bson.M{"$ne": nil}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var folders []*Folder
+	return folders, cursor.All(ctx, &folders)
+}
+
+// Restore sort un dossier de la corbeille avec les liens supprimés en même temps que lui
+func (s *Service) Restore(ctx context.Context, folderID, ownerID string) (*Folder, error) {
+	id, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, errors.New("invalid folder id")
+	}
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": id, "owner_id": ownerID, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{"$unset": bson.M{"deleted_at": ""}, "$set": bson.M{"updated_at": time.Now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, errors.New("folder not found in trash")
+	}
+	_, err = s.linkCol.UpdateMany(ctx,
+		bson.M{"folder_id": folderID, "deleted_with_folder": true},
+		bson.M{"$unset": bson.M{"deleted_at": "", "deleted_with_folder": ""}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("folder restored but failed to restore links: %w", err)
+	}
+	return s.Get(ctx, folderID, ownerID)
+}
+
+// EmptyTrash supprime définitivement les dossiers de l'utilisateur qui sont dans
+// la corbeille, ainsi que tous leurs liens : ceux partis avec eux comme ceux
+// supprimés un à un auparavant, qui sans cela pointeraient vers un dossier
+// disparu.
+func (s *Service) EmptyTrash(ctx context.Context, ownerID string) (folders int64, links int64, err error) {
+	trashed, err := s.ListTrash(ctx, ownerID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(trashed) == 0 {
+		return 0, 0, nil
+	}
+	ids := make([]primitive.ObjectID, 0, len(trashed))
+	hexIDs := make([]string, 0, len(trashed))
+	for _, f := range trashed {
+		ids = append(ids, f.ID)
+		hexIDs = append(hexIDs, f.ID.Hex())
+	}
+	linkRes, err := s.linkCol.DeleteMany(ctx, bson.M{"folder_id": bson.M{"$in": hexIDs}})
+	if err != nil {
+		return 0, 0, err
+	}
+	folderRes, err := s.col.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "owner_id": ownerID})
+	if err != nil {
+		return 0, linkRes.DeletedCount, err
+	}
+	return folderRes.DeletedCount, linkRes.DeletedCount, nil
+}
+
+// SetArchived archive ou désarchive un dossier (owner uniquement)
+func (s *Service) SetArchived(ctx context.Context, folderID, ownerID string, archived bool) (*Folder, error) {
+	return s.setFlag(ctx, folderID, ownerID, "archived", archived)
+}
+
+// SetFrozen gèle ou dégèle un dossier (owner uniquement). Un dossier gelé
+// refuse toute création, modification ou suppression de lien.
+func (s *Service) SetFrozen(ctx context.Context, folderID, ownerID string, frozen bool) (*Folder, error) {
+	return s.setFlag(ctx, folderID, ownerID, "frozen", frozen)
+}
+
+func (s *Service) setFlag(ctx context.Context, folderID, ownerID, field string, value bool) (*Folder, error) {
+	id, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, errors.New("invalid folder id")
+	}
+	update := bson.M{"$set": bson.M{field: true, "updated_at": time.Now()}, "$inc": bson.M{"version": 1}}
+	if !value {
+		update = bson.M{"$unset": bson.M{field: ""}, "$set": bson.M{"updated_at": time.Now()}, "$inc": bson.M{"version": 1}}
+	}
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": id, "owner_id": ownerID, "deleted_at": nil}, update)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, errors.New("not found or not authorized")
+	}
+	return s.Get(ctx, folderID, ownerID)
+}
+
 func (s *Service) GenerateShareToken(ctx context.Context, folderID, ownerID string) (string, string, error) {
 	id, err := primitive.ObjectIDFromHex(folderID)
 	if err != nil {
@@ -180,22 +379,25 @@ func (s *Service) GenerateShareToken(ctx context.Context, folderID, ownerID stri
 		return "", "", err
 	}
 	token := hex.EncodeToString(b)
-	_, err = s.col.UpdateOne(ctx,
-		bson.M{"_id": id, "owner_id": ownerID},
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": id, "owner_id": ownerID, "deleted_at": nil},
 		bson.M{"$set": bson.M{"share_token": token, "updated_at": time.Now()}},
 	)
 	if err != nil {
 		return "", "", err
 	}
+	if res.MatchedCount == 0 {
+		return "", "", errors.New("not found or not authorized")
+	}
 	return token, s.baseURL + "/share/" + token, nil
 }
 
 func (s *Service) GetByShareToken(ctx context.Context, token string) (*Folder, []map[string]any, error) {
 	var f Folder
-	if err := s.col.FindOne(ctx, bson.M{"share_token": token}).Decode(&f); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"share_token": token, "deleted_at": nil}).Decode(&f); err != nil {
 		return nil, nil, err
 	}
-	cursor, err := s.linkCol.Find(ctx, bson.M{"folder_id": f.ID.Hex()})
+	cursor, err := s.linkCol.Find(ctx, bson.M{"folder_id": f.ID.Hex(), "deleted_at": nil})
 	if err != nil {
 		return &f, nil, nil
 	}
@@ -216,7 +418,7 @@ func (s *Service) AddCollaborator(ctx context.Context, folderID, ownerID, email,
 
 	// Vérifier que le demandeur est le owner
 	var f Folder
-	if err := s.col.FindOne(ctx, bson.M{"_id": id, "owner_id": ownerID}).Decode(&f); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "owner_id": ownerID, "deleted_at": nil}).Decode(&f); err != nil {
 		return nil, errors.New("not found or not authorized")
 	}
 
@@ -267,16 +469,32 @@ func (s *Service) AddCollaborator(ctx context.Context, folderID, ownerID, email,
 	return s.Get(ctx, folderID, ownerID)
 }
 
+// RemoveCollaborator retire un collaborateur ajouté directement ; ceux venus
+// d'un foyer se retirent par le foyer
 func (s *Service) RemoveCollaborator(ctx context.Context, folderID, ownerID, targetUserID string) (*Folder, error) {
 	id, err := primitive.ObjectIDFromHex(folderID)
 	if err != nil {
 		return nil, errors.New("invalid folder id")
 	}
 
+	// Un membre d'un foyer resterait inscrit par household à la prochaine
+	// mise à jour du foyer
+	n, err := s.col.CountDocuments(ctx, bson.M{
+		"_id":           id,
+		"owner_id":      ownerID,
+		"collaborators": bson.M{"$elemMatch": bson.M{"user_id": targetUserID, "household_id": bson.M{"$nin": bson.A{nil, ""}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, ErrHouseholdCollaborator
+	}
+
 	_, err = s.col.UpdateOne(ctx,
 		bson.M{"_id": id, "owner_id": ownerID},
 		bson.M{
-			"$pull": bson.M{"collaborators": bson.M{"user_id": targetUserID}},
+			"$pull": bson.M{"collaborators": bson.M{"user_id": targetUserID, "household_id": bson.M{"$in": bson.A{nil, ""}}}},
 			"$set":  bson.M{"updated_at": time.Now()},
 		},
 	)
@@ -288,44 +506,86 @@ func (s *Service) RemoveCollaborator(ctx context.Context, folderID, ownerID, tar
 
 // --- Communautaire ---
 
+// ErrInvalidPageToken est retourné quand le page_token fourni n'est pas reconnu
+var ErrInvalidPageToken = pagetoken.ErrInvalid
+
+// scoredFolder porte le score de pertinence calculé par l'index texte
+type scoredFolder struct {
+	Folder `bson:",inline"`
+	Score  float64 `bson:"score"`
+}
+
+// ListCommunity liste les dossiers publics. Sans recherche, ils sont triés du plus
+// récemment modifié au plus ancien ; avec une recherche, l'index texte (stemming
+// français, insensible aux accents) porte sur le nom, les tags et le contenu des
+// liens, et les résultats sont triés par pertinence.
 func (s *Service) ListCommunity(ctx context.Context, search string, pageSize int32, pageToken string) ([]*Folder, string, error) {
 	if pageSize <= 0 || pageSize > 50 {
 		pageSize = 20
 	}
-
-	filter := bson.M{"visibility": "public"}
+	match := bson.M{"visibility": "public", "deleted_at": nil, "archived": bson.M{"$ne": true}}
+	sortField := "updated_at"
+	search = strings.TrimSpace(search)
 	if search != "" {
-		filter["name"] = bson.M{"$regex": search, "$options": "i"}
+		sortField = "score"
 	}
-
-	// Pagination par _id
+	// Le token n'est valable que pour le même tri et la même recherche
+	scope := pagetoken.Scope(sortField, search)
+	var cursor *pagetoken.Cursor
 	if pageToken != "" {
-		if oid, err := primitive.ObjectIDFromHex(pageToken); err == nil {
-			filter["_id"] = bson.M{"$gt": oid}
+		c, err := pagetoken.DecodeScoped(scope, pageToken)
+		if err != nil {
+			return nil, "", err
 		}
+		cursor = c
 	}
 
-	opts := options.Find().
-		SetSort(bson.M{"updated_at": -1}).
-		SetLimit(int64(pageSize + 1))
+	pipeline := mongo.Pipeline{}
+	if search != "" {
+		match["$text"] = bson.M{"$search": search}
+		pipeline = append(pipeline,
+			bson.D{{Key: "$match", Value: match}},
+			bson.D{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+		)
+	} else {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+	}
+	if cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: pagetoken.After(sortField, true, cursor)}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: sortField, Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: int64(pageSize + 1)}},
+	)
 
-	cursor, err := s.col.Find(ctx, filter, opts)
+	cur, err := s.col.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, "", err
 	}
-	defer cursor.Close(ctx)
+	defer cur.Close(ctx)
 
-	var folders []*Folder
-	if err := cursor.All(ctx, &folders); err != nil {
+	var results []scoredFolder
+	if err := cur.All(ctx, &results); err != nil {
 		return nil, "", err
 	}
 
 	var nextToken string
-	if len(folders) > int(pageSize) {
-		nextToken = folders[pageSize].ID.Hex()
-		folders = folders[:pageSize]
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		var value any = last.UpdatedAt
+		if sortField == "score" {
+			value = last.Score
+		}
+		if nextToken, err = pagetoken.EncodeScoped(scope, value, last.ID); err != nil {
+			return nil, "", err
+		}
 	}
 
+	folders := make([]*Folder, len(results))
+	for i := range results {
+		folders[i] = &results[i].Folder
+	}
 	return folders, nextToken, nil
 }
 
@@ -347,7 +607,7 @@ func (s *Service) GetOwnerInfo(ctx context.Context, ownerID string) (string, boo
 
 // CountLinks retourne le nombre de liens dans un dossier
 func (s *Service) CountLinks(ctx context.Context, folderID string) int32 {
-	count, err := s.linkCol.CountDocuments(ctx, bson.M{"folder_id": folderID})
+	count, err := s.linkCol.CountDocuments(ctx, bson.M{"folder_id": folderID, "deleted_at": nil})
 	if err != nil {
 		return 0
 	}
@@ -372,7 +632,7 @@ func (s *Service) Like(ctx context.Context, folderID, userID string) (int32, err
 	}
 	// Ajouter le userID au tableau liked_by (s'il n'y est pas déjà)
 	_, err = s.col.UpdateOne(ctx,
-		bson.M{"_id": id, "liked_by": bson.M{"$ne": userID}},
+		bson.M{"_id": id, "deleted_at": nil, "liked_by": bson.M{"$ne": userID}},
 		bson.M{
 			"$addToSet": bson.M{"liked_by": userID},
 			"$inc":      bson.M{"like_count": 1},
@@ -422,7 +682,12 @@ func (s *Service) ListTop(ctx context.Context, limit int32) ([]*Folder, error) {
 	opts := options.Find().
 		SetSort(bson.M{"like_count": -1, "updated_at": -1}).
 		SetLimit(int64(limit))
-	cursor, err := s.col.Find(ctx, bson.M{"visibility": "public", "like_count": bson.M{"$gt": 0}}, opts)
+	cursor, err := s.col.Find(ctx, bson.M{
+		"visibility": "public",
+		"deleted_at": nil,
+		"archived":   bson.M{"$ne": true},
+		"like_count": bson.M{"$gt": 0},
+	}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,167 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+// Un dossier archivé n'apparaît dans la liste que sur demande, pour son
+// propriétaire comme pour ses collaborateurs
+func TestList_HidesArchived(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	ownerID := primitive.NewObjectID().Hex()
+	editor := primitive.NewObjectID()
+	if _, err := db.Collection("users").InsertOne(ctx, bson.M{"_id": editor, "email": "editor@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	old, _ := svc.Create(ctx, ownerID, "Noël 2024", "", "", "private", "", nil, nil)
+	current, _ := svc.Create(ctx, ownerID, "Noël 2025", "", "", "private", "", nil, nil)
+	for _, f := range []*Folder{old, current} {
+		if _, err := svc.AddCollaborator(ctx, f.ID.Hex(), ownerID, "editor@example.com", "editor"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := svc.SetArchived(ctx, old.ID.Hex(), ownerID, true)
+	if err != nil || !f.Archived {
+		t.Fatalf("archive = %+v, %v", f, err)
+	}
+
+	for _, userID := range []string{ownerID, editor.Hex()} {
+		folders, err := svc.List(ctx, userID, false)
+		if err != nil || len(folders) != 1 || folders[0].ID != current.ID {
+			t.Errorf("%s: list = %v, %v; want only the current folder", userID, folders, err)
+		}
+		if folders, _ := svc.List(ctx, userID, true); len(folders) != 2 {
+			t.Errorf("%s: list with archived = %d folders, want 2", userID, len(folders))
+		}
+	}
+
+	if _, err := svc.SetArchived(ctx, old.ID.Hex(), ownerID, false); err != nil {
+		t.Fatal(err)
+	}
+	if folders, _ := svc.List(ctx, ownerID, false); len(folders) != 2 {
+		t.Errorf("list after unarchive = %d folders, want 2", len(folders))
+	}
+}
+
+// Seul le propriétaire archive ou gèle un dossier, pas même un éditeur
+func TestSetFlags_OwnerOnly(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	ownerID := primitive.NewObjectID().Hex()
+	editor := primitive.NewObjectID()
+	if _, err := db.Collection("users").InsertOne(ctx, bson.M{"_id": editor, "email": "editor@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	f, _ := svc.Create(ctx, ownerID, "Vacances", "", "", "private", "", nil, nil)
+	if _, err := svc.AddCollaborator(ctx, f.ID.Hex(), ownerID, "editor@example.com", "editor"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, userID := range []string{editor.Hex(), primitive.NewObjectID().Hex()} {
+		if _, err := svc.SetArchived(ctx, f.ID.Hex(), userID, true); err == nil {
+			t.Errorf("%s could archive the folder", userID)
+		}
+		if _, err := svc.SetFrozen(ctx, f.ID.Hex(), userID, true); err == nil {
+			t.Errorf("%s could freeze the folder", userID)
+		}
+	}
+	got, err := svc.Get(ctx, f.ID.Hex(), ownerID)
+	if err != nil || got.Archived || got.Frozen {
+		t.Errorf("folder = %+v, %v; want neither archived nor frozen", got, err)
+	}
+}
+
+// Les liens d'un dossier gelé ne peuvent plus être créés, modifiés,
+// supprimés ni restaurés ; dégelé, il redevient modifiable
+func TestFrozen_RejectsLinkChanges(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	f, _ := svc.Create(ctx, ownerID, "Noël", "", "", "private", "", nil, nil)
+	lego, err := links.Create(ctx, ownerID, &link.Link{FolderID: f.ID.Hex(), Title: "Lego"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	puzzle, err := links.Create(ctx, ownerID, &link.Link{FolderID: f.ID.Hex(), Title: "Puzzle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := links.Delete(ctx, puzzle.ID.Hex(), ownerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.SetFrozen(ctx, f.ID.Hex(), ownerID, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := links.Create(ctx, ownerID, &link.Link{FolderID: f.ID.Hex(), Title: "Vélo"}); !errors.Is(err, link.ErrFolderFrozen) {
+		t.Errorf("create err = %v, want ErrFolderFrozen", err)
+	}
+	lego.Title = "Lego Duplo"
+	if _, err := links.Update(ctx, lego.ID.Hex(), ownerID, lego, []string{"title"}, ""); !errors.Is(err, link.ErrFolderFrozen) {
+		t.Errorf("update err = %v, want ErrFolderFrozen", err)
+	}
+	if err := links.Delete(ctx, lego.ID.Hex(), ownerID); !errors.Is(err, link.ErrFolderFrozen) {
+		t.Errorf("delete err = %v, want ErrFolderFrozen", err)
+	}
+	if _, err := links.Restore(ctx, puzzle.ID.Hex(), ownerID); !errors.Is(err, link.ErrFolderFrozen) {
+		t.Errorf("restore err = %v, want ErrFolderFrozen", err)
+	}
+
+	if _, err := svc.SetFrozen(ctx, f.ID.Hex(), ownerID, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := links.Update(ctx, lego.ID.Hex(), ownerID, lego, []string{"title"}, ""); err != nil {
+		t.Errorf("update after unfreeze: %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package folder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Un dossier part dans la corbeille avec ses liens, revient avec ceux-là
+// seulement, et vider la corbeille purge tous ses liens, y compris ceux
+// supprimés un à un avant lui
+func TestTrash_FolderDeleteRestoreEmpty(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	f, err := svc.Create(ctx, ownerID, "Noël", "", "", "private", "", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lego, err := links.Create(ctx, ownerID, &link.Link{FolderID: f.ID.Hex(), Title: "Lego"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	puzzle, err := links.Create(ctx, ownerID, &link.Link{FolderID: f.ID.Hex(), Title: "Puzzle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := links.Delete(ctx, puzzle.ID.Hex(), ownerID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.Delete(ctx, f.ID.Hex(), primitive.NewObjectID().Hex()); err == nil {
+		t.Error("stranger should not be able to delete the folder")
+	}
+	if err := svc.Delete(ctx, f.ID.Hex(), ownerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Get(ctx, f.ID.Hex(), ownerID); err == nil {
+		t.Error("trashed folder should not be returned by Get")
+	}
+	if trashed, err := svc.ListTrash(ctx, ownerID); err != nil || len(trashed) != 1 {
+		t.Fatalf("trash = %v, %v", trashed, err)
+	}
+
+	// Seul le lien parti avec le dossier revient avec lui
+	if _, err := svc.Restore(ctx, f.ID.Hex(), ownerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := links.Get(ctx, lego.ID.Hex(), ownerID); err != nil {
+		t.Errorf("link trashed with the folder should be restored: %v", err)
+	}
+	if _, err := links.Get(ctx, puzzle.ID.Hex(), ownerID); err == nil {
+		t.Error("link trashed on its own should stay in the trash")
+	}
+
+	if err := svc.Delete(ctx, f.ID.Hex(), ownerID); err != nil {
+		t.Fatal(err)
+	}
+	folders, purged, err := svc.EmptyTrash(ctx, ownerID)
+	if err != nil || folders != 1 || purged != 2 {
+		t.Fatalf("empty trash = %d folders, %d links, %v; want 1, 2", folders, purged, err)
+	}
+	if left, _ := links.ListTrash(ctx, ownerID); len(left) != 0 {
+		t.Errorf("orphaned links left in trash: %v", left)
+	}
+}
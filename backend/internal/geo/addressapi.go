@@ -0,0 +1,82 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultAddressAPIURL est l'API Adresse de la Base Adresse Nationale
+const DefaultAddressAPIURL = "https://api-adresse.data.gouv.fr"
+
+// minAddressScore écarte les résultats trop incertains de l'API Adresse
+const minAddressScore = 0.5
+
+// AddressAPI géocode les adresses françaises avec l'API Adresse
+// (api-adresse.data.gouv.fr ou une instance addok compatible)
+type AddressAPI struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewAddressAPI(baseURL string) *AddressAPI {
+	return &AddressAPI{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type addressResponse struct {
+	Features []struct {
+		Geometry struct {
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties struct {
+			Label    string  `json:"label"`
+			Postcode string  `json:"postcode"`
+			Score    float64 `json:"score"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+func (a *AddressAPI) Geocode(ctx context.Context, query string) (*Place, error) {
+	query = strings.TrimSpace(query)
+	// L'API refuse les requêtes de moins de 3 caractères
+	if len([]rune(query)) < 3 {
+		return nil, ErrNotFound
+	}
+	u := a.baseURL + "/search/?" + url.Values{"q": {query}, "limit": {"1"}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("address api: status %d", resp.StatusCode)
+	}
+	var body addressResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("address api: %w", err)
+	}
+	if len(body.Features) == 0 {
+		return nil, ErrNotFound
+	}
+	f := body.Features[0]
+	if f.Properties.Score < minAddressScore || len(f.Geometry.Coordinates) != 2 {
+		return nil, ErrNotFound
+	}
+	return &Place{
+		Label:    f.Properties.Label,
+		Postcode: f.Properties.Postcode,
+		Lng:      f.Geometry.Coordinates[0],
+		Lat:      f.Geometry.Coordinates[1],
+	}, nil
+}
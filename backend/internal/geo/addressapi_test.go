@@ -0,0 +1,56 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddressAPI_Geocode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("q") {
+		case "8 rue de la Paix Paris":
+			w.Write([]byte(`{"features":[{"geometry":{"type":"Point","coordinates":[2.3316,48.8686]},"properties":{"label":"8 Rue de la Paix 75002 Paris","postcode":"75002","score":0.93}}]}`))
+		case "quelque part":
+			w.Write([]byte(`{"features":[{"geometry":{"type":"Point","coordinates":[1,2]},"properties":{"label":"Partout","score":0.2}}]}`))
+		case "panne":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"features":[]}`))
+		}
+	}))
+	defer srv.Close()
+	api := NewAddressAPI(srv.URL + "/")
+	ctx := context.Background()
+
+	p, err := api.Geocode(ctx, "8 rue de la Paix Paris")
+	if err != nil || p.Postcode != "75002" || p.Lat != 48.8686 || p.Lng != 2.3316 {
+		t.Errorf("Geocode = %+v, %v", p, err)
+	}
+	for _, q := range []string{"quelque part", "nulle part", "ab"} {
+		if _, err := api.Geocode(ctx, q); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Geocode(%q) err = %v, want ErrNotFound", q, err)
+		}
+	}
+	if _, err := api.Geocode(ctx, "panne"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("server error = %v", err)
+	}
+
+	// Le répertoire hors ligne prend le relais de l'API
+	chain := Chain{api, DefaultGazetteer()}
+	if p, err := chain.Geocode(ctx, "Lyon"); err != nil || p.Label != "Lyon" {
+		t.Errorf("chain Lyon = %+v, %v", p, err)
+	}
+	if p, err := chain.Geocode(ctx, "8 rue de la Paix Paris"); err != nil || p.Postcode != "75002" {
+		t.Errorf("chain address = %+v, %v", p, err)
+	}
+	if _, err := chain.Geocode(ctx, "nulle part"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("chain not found err = %v", err)
+	}
+	if _, err := chain.Geocode(ctx, "panne"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("chain should report the API failure, got %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package geo
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// communes.csv liste les principales communes françaises (préfectures,
+// grandes villes, lieux de vacances) ; un fichier complet peut être chargé
+// avec LoadGazetteer
+//
+//go:embed communes.csv
+var defaultCommunes []byte
+
+type commune struct {
+	name      string
+	postcodes []string
+	lat, lng  float64
+}
+
+// Gazetteer géocode hors ligne les lieux qui citent une commune ou un code
+// postal : « Parc de la Tête d'Or, Lyon », « 13100 », « St Malo »
+type Gazetteer struct {
+	communes   []commune
+	byName     map[string][]int // mots repliés du nom → communes, dans l'ordre du fichier
+	byPostcode map[string][]int
+	maxWords   int
+}
+
+var defaultGazetteer = sync.OnceValue(func() *Gazetteer {
+	g, err := LoadGazetteer(bytes.NewReader(defaultCommunes))
+	if err != nil {
+		panic(err)
+	}
+	return g
+})
+
+// DefaultGazetteer retourne le répertoire des communes intégré au serveur
+func DefaultGazetteer() *Gazetteer {
+	return defaultGazetteer()
+}
+
+// LoadGazetteer lit un répertoire CSV de colonnes nom, codes_postaux
+// (séparés par des espaces), latitude, longitude, avec une ligne d'en-tête.
+// Quand un nom est partagé, les premières communes du fichier sont préférées.
+func LoadGazetteer(r io.Reader) (*Gazetteer, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 4
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("gazetteer: %w", err)
+	}
+	g := &Gazetteer{byName: map[string][]int{}, byPostcode: map[string][]int{}}
+	for i, rec := range records {
+		if i == 0 {
+			continue
+		}
+		lat, err1 := strconv.ParseFloat(rec[2], 64)
+		lng, err2 := strconv.ParseFloat(rec[3], 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("gazetteer: line %d: invalid coordinates", i+1)
+		}
+		c := commune{name: rec[0], postcodes: strings.Fields(rec[1]), lat: lat, lng: lng}
+		idx := len(g.communes)
+		g.communes = append(g.communes, c)
+		words := nameWords(c.name)
+		g.index(words, idx)
+		// « Le Touquet-Paris-Plage » est souvent cité sans son article
+		if len(words) > 1 && (words[0] == "le" || words[0] == "la" || words[0] == "les") {
+			g.index(words[1:], idx)
+		}
+		for _, pc := range c.postcodes {
+			g.byPostcode[pc] = append(g.byPostcode[pc], idx)
+		}
+	}
+	return g, nil
+}
+
+func (g *Gazetteer) index(words []string, idx int) {
+	key := strings.Join(words, " ")
+	g.byName[key] = append(g.byName[key], idx)
+	g.maxWords = max(g.maxWords, len(words))
+}
+
+// Geocode retrouve la commune citée par query. Le nom le plus long est
+// retenu, puis le plus à droite (« Parc de Versailles, Paris » → Paris) ; un
+// code postal départage les homonymes et suffit seul.
+func (g *Gazetteer) Geocode(_ context.Context, query string) (*Place, error) {
+	words := nameWords(query)
+	var postcode string
+	for _, w := range words {
+		if isPostcode(w) {
+			if _, ok := g.byPostcode[w]; ok {
+				postcode = w
+			}
+		}
+	}
+
+	var named []int
+	bestLen, bestPos := 0, -1
+	for i := range words {
+		for n := min(g.maxWords, len(words)-i); n >= 1; n-- {
+			key := strings.Join(words[i:i+n], " ")
+			ids, ok := g.byName[key]
+			// Les noms d'un seul mot très court (Y, Eu) sont trop ambigus
+			if !ok || (n == 1 && len(key) < 3) {
+				continue
+			}
+			if n > bestLen || (n == bestLen && i > bestPos) {
+				named, bestLen, bestPos = ids, n, i
+			}
+			break
+		}
+	}
+
+	switch {
+	case postcode != "":
+		ids := g.byPostcode[postcode]
+		for _, id := range named {
+			for _, pid := range ids {
+				if id == pid {
+					return g.place(id, postcode), nil
+				}
+			}
+		}
+		return g.place(ids[0], postcode), nil
+	case len(named) > 0:
+		return g.place(named[0], ""), nil
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+func (g *Gazetteer) place(id int, postcode string) *Place {
+	c := g.communes[id]
+	if postcode == "" && len(c.postcodes) > 0 {
+		postcode = c.postcodes[0]
+	}
+	return &Place{Label: c.name, Postcode: postcode, Lat: c.lat, Lng: c.lng}
+}
+
+// nameWords découpe un nom de lieu en mots repliés, les abréviations de
+// « saint » développées
+func nameWords(s string) []string {
+	tokens := textutil.Tokenize(s)
+	words := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		switch t.Text {
+		case "st":
+			words = append(words, "saint")
+		case "ste":
+			words = append(words, "sainte")
+		default:
+			words = append(words, t.Text)
+		}
+	}
+	return words
+}
+
+func isPostcode(w string) bool {
+	if len(w) != 5 {
+		return false
+	}
+	for _, r := range w {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
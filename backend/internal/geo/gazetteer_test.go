@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGazetteer_Geocode(t *testing.T) {
+	g := DefaultGazetteer()
+	tests := []struct {
+		query    string
+		label    string
+		postcode string
+	}{
+		{"Lyon", "Lyon", "69001"},
+		{"Parc de la Tête d'Or, 69006 Lyon", "Lyon", "69006"},
+		{"st etienne", "Saint-Étienne", "42000"},
+		{"SAINT-ÉTIENNE", "Saint-Étienne", "42000"},
+		{"13100", "Aix-en-Provence", "13100"},
+		{"Château de Versailles, près de Paris", "Paris", "75001"},
+		{"Plage du Touquet-Paris-Plage", "Le Touquet-Paris-Plage", "62520"},
+		{"Saint-Denis", "Saint-Denis", "93200"},
+		{"Jardin de l'État, 97400 Saint-Denis", "Saint-Denis", "97400"},
+	}
+	for _, tt := range tests {
+		p, err := g.Geocode(context.Background(), tt.query)
+		if err != nil {
+			t.Errorf("Geocode(%q): %v", tt.query, err)
+			continue
+		}
+		if p.Label != tt.label || p.Postcode != tt.postcode {
+			t.Errorf("Geocode(%q) = %s %s, want %s %s", tt.query, p.Label, p.Postcode, tt.label, tt.postcode)
+		}
+	}
+
+	reunion, _ := g.Geocode(context.Background(), "97400 Saint-Denis")
+	if reunion == nil || reunion.Lat > 0 {
+		t.Errorf("homonym resolved by postcode = %+v", reunion)
+	}
+	for _, q := range []string{"", "à la maison", "99999"} {
+		if _, err := g.Geocode(context.Background(), q); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Geocode(%q) err = %v, want ErrNotFound", q, err)
+		}
+	}
+}
+
+func TestLoadGazetteer(t *testing.T) {
+	g, err := LoadGazetteer(strings.NewReader("nom,codes_postaux,latitude,longitude\nY,80190,49.80,2.99\nSaint-Paul,97460,-21.01,55.27\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Geocode(context.Background(), "Il y a un parc"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("one-letter name should not match: %v", err)
+	}
+	if p, err := g.Geocode(context.Background(), "80190"); err != nil || p.Label != "Y" {
+		t.Errorf("postcode lookup = %+v, %v", p, err)
+	}
+	if _, err := LoadGazetteer(strings.NewReader("nom,codes_postaux,latitude,longitude\nX,1,nord,sud\n")); err == nil {
+		t.Error("invalid coordinates should fail")
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	// Paris – Lyon : environ 392 km à vol d'oiseau
+	if d := DistanceKm(48.8566, 2.3522, 45.7640, 4.8357); d < 385 || d > 400 {
+		t.Errorf("Paris–Lyon = %.1f km", d)
+	}
+	if d := DistanceKm(45.76, 4.83, 45.76, 4.83); d != 0 {
+		t.Errorf("same point = %f", d)
+	}
+}
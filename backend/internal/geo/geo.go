@@ -0,0 +1,72 @@
+// Package geo géocode les lieux des liens (texte libre) en coordonnées et
+// calcule des distances pour la recherche « près de chez moi ».
+package geo
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// ErrNotFound est retourné quand un géocodeur ne reconnaît pas le lieu
+var ErrNotFound = errors.New("place not found")
+
+// earthRadiusKm est le rayon moyen de la Terre, celui qu'utilise MongoDB
+// pour les requêtes sphériques
+const earthRadiusKm = 6378.1
+
+// Point est un point GeoJSON, indexable par un index 2dsphere.
+// Coordinates vaut [longitude, latitude].
+type Point struct {
+	Type        string     `bson:"type"        json:"type"`
+	Coordinates [2]float64 `bson:"coordinates" json:"coordinates"`
+}
+
+func NewPoint(lat, lng float64) *Point {
+	return &Point{Type: "Point", Coordinates: [2]float64{lng, lat}}
+}
+
+func (p *Point) Lat() float64 { return p.Coordinates[1] }
+func (p *Point) Lng() float64 { return p.Coordinates[0] }
+
+// Place est le résultat d'un géocodage
+type Place struct {
+	Label    string // nom reconnu : « Lyon », « 8 Rue de la Paix 75002 Paris »
+	Postcode string
+	Lat      float64
+	Lng      float64
+}
+
+// Geocoder convertit un lieu en texte libre en coordonnées
+type Geocoder interface {
+	Geocode(ctx context.Context, query string) (*Place, error)
+}
+
+// Chain essaie ses géocodeurs dans l'ordre, typiquement un service distant
+// précis à l'adresse puis le répertoire hors ligne quand il est injoignable.
+// ErrNotFound n'est retourné que si aucun géocodeur n'a échoué autrement.
+type Chain []Geocoder
+
+func (c Chain) Geocode(ctx context.Context, query string) (*Place, error) {
+	err := ErrNotFound
+	for _, g := range c {
+		p, gErr := g.Geocode(ctx, query)
+		if gErr == nil {
+			return p, nil
+		}
+		if !errors.Is(gErr, ErrNotFound) {
+			err = gErr
+		}
+	}
+	return nil, err
+}
+
+// DistanceKm retourne la distance à vol d'oiseau entre deux points (haversine)
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const rad = math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLng := (lng2 - lng1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,130 @@
+package gift
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedGiftServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrGiftNotFound), errors.Is(err, ErrClaimNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrOwnerCannot), errors.Is(err, ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrAlreadyClaimed):
+		return status.Errorf(codes.AlreadyExists, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNameRequired):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+// visitor retourne le visiteur de la requête. Les méthodes sont publiques :
+// sans compte, le lien de partage est obligatoire.
+func visitor(ctx context.Context, shareToken string) (Visitor, error) {
+	userID, _ := interceptor.UserIDFromContext(ctx)
+	if userID == "" && shareToken == "" {
+		return Visitor{}, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return Visitor{UserID: userID, ShareToken: shareToken}, nil
+}
+
+func claimToProto(c *Claim, userID string) *pb.GiftClaim {
+	out := &pb.GiftClaim{
+		LinkId: c.LinkID,
+		Name:   c.Name,
+		Mine:   userID != "" && c.UserID == userID,
+	}
+	if !c.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(c.CreatedAt)
+	}
+	return out
+}
+
+func settingsToProto(st Settings) *pb.GiftSettings {
+	out := &pb.GiftSettings{RevealAfterEvent: st.RevealAfterEvent}
+	if st.EventAt != nil {
+		out.EventDate = timestamppb.New(*st.EventAt)
+	}
+	return out
+}
+
+func (h *Handler) ClaimGift(ctx context.Context, req *pb.ClaimGiftRequest) (*pb.ClaimGiftResponse, error) {
+	v, err := visitor(ctx, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	c, err := h.svc.Claim(ctx, req.LinkId, v, req.Name)
+	if err != nil {
+		return nil, serviceError(err, "claim gift")
+	}
+	return &pb.ClaimGiftResponse{Claim: claimToProto(c, v.UserID), GuestKey: c.GuestKey}, nil
+}
+
+func (h *Handler) UnclaimGift(ctx context.Context, req *pb.UnclaimGiftRequest) (*pb.UnclaimGiftResponse, error) {
+	v, err := visitor(ctx, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.Unclaim(ctx, req.LinkId, v, req.GuestKey); err != nil {
+		return nil, serviceError(err, "unclaim gift")
+	}
+	return &pb.UnclaimGiftResponse{}, nil
+}
+
+func (h *Handler) ListGiftClaims(ctx context.Context, req *pb.ListGiftClaimsRequest) (*pb.ListGiftClaimsResponse, error) {
+	v, err := visitor(ctx, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	v.GuestKey = req.GuestKey
+	claims, err := h.svc.List(ctx, req.FolderId, v)
+	if err != nil {
+		return nil, serviceError(err, "list gift claims")
+	}
+	resp := &pb.ListGiftClaimsResponse{Claims: make([]*pb.GiftClaim, 0, len(claims.Claims)), Hidden: claims.Hidden, NamesHidden: claims.NamesHidden}
+	for _, c := range claims.Claims {
+		resp.Claims = append(resp.Claims, claimToProto(c, v.UserID))
+	}
+	if claims.IsOwner {
+		resp.Settings = settingsToProto(claims.Settings)
+	}
+	return resp, nil
+}
+
+func (h *Handler) UpdateGiftSettings(ctx context.Context, req *pb.UpdateGiftSettingsRequest) (*pb.UpdateGiftSettingsResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	var st Settings
+	if req.Settings != nil {
+		st.RevealAfterEvent = req.Settings.RevealAfterEvent
+		if req.Settings.EventDate != nil {
+			t := req.Settings.EventDate.AsTime()
+			st.EventAt = &t
+		}
+	}
+	saved, err := h.svc.UpdateSettings(ctx, req.FolderId, userID, st)
+	if err != nil {
+		return nil, serviceError(err, "update gift settings")
+	}
+	return &pb.UpdateGiftSettingsResponse{Settings: settingsToProto(*saved)}, nil
+}
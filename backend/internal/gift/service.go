@@ -0,0 +1,344 @@
+// Package gift permet aux proches de réserver les cadeaux d'une liste
+// partagée (« je l'achète ») sans que le propriétaire de la liste le voie.
+// Les réservations sont gardées à part des liens : aucune réponse de l'API
+// des liens ou des dossiers ne peut les laisser fuiter.
+package gift
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// giftCategory est la catégorie des liens qui peuvent être réservés
+	giftCategory = "LINK_CATEGORY_CADEAU"
+	// maxNameLength borne le nom donné par un visiteur anonyme
+	maxNameLength = 60
+)
+
+var (
+	ErrGiftNotFound   = errors.New("gift not found")
+	ErrOwnerCannot    = errors.New("the list owner cannot see or claim reservations")
+	ErrAlreadyClaimed = errors.New("gift already claimed")
+	ErrNameRequired   = errors.New("a name is required to claim anonymously")
+	ErrClaimNotFound  = errors.New("claim not found")
+	ErrInvalidFolder  = errors.New("folder not found or not owned")
+)
+
+// Claim est la réservation d'un cadeau. Un cadeau n'est réservé qu'une fois
+// (index unique sur link_id).
+type Claim struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	LinkID   string             `bson:"link_id"`
+	FolderID string             `bson:"folder_id"`
+	UserID   string             `bson:"user_id,omitempty"` // vide pour un visiteur anonyme
+	Name     string             `bson:"name"`              // nom affiché aux autres proches
+	// GuestKey est remis au visiteur anonyme pour qu'il puisse annuler
+	GuestKey  string    `bson:"guest_key,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// Settings sont les réglages de réservation d'un dossier, choisis par son
+// propriétaire
+type Settings struct {
+	EventAt *time.Time `bson:"event_at,omitempty"` // date de la fête
+	// RevealAfterEvent montre les réservations au propriétaire une fois la
+	// date de la fête passée
+	RevealAfterEvent bool `bson:"reveal_after_event"`
+}
+
+// Visitor est la personne qui consulte ou réserve : un utilisateur connecté,
+// un visiteur muni du lien de partage du dossier, ou les deux
+type Visitor struct {
+	UserID     string
+	ShareToken string
+	// GuestKey est la clé reçue par un visiteur sans compte qui a réservé un
+	// cadeau du dossier
+	GuestKey string
+}
+
+// Claims est la vue des réservations d'un dossier
+type Claims struct {
+	Claims []*Claim
+	// IsOwner indique que le visiteur est le propriétaire du dossier, qui
+	// seul voit Settings ; Hidden que les réservations sont cachées au
+	// visiteur
+	IsOwner  bool
+	Hidden   bool
+	Settings Settings
+	// NamesHidden indique un visiteur sans compte : Claims ne donne au mieux
+	// que les cadeaux réservés, sans savoir par qui ni quand
+	NamesHidden bool
+}
+
+type Service struct {
+	col       *mongo.Collection
+	linkCol   *mongo.Collection
+	folderCol *mongo.Collection
+	userCol   *mongo.Collection
+	now       func() time.Time
+}
+
+func NewService(col, linkCol, folderCol, userCol *mongo.Collection) *Service {
+	return &Service{col: col, linkCol: linkCol, folderCol: folderCol, userCol: userCol, now: time.Now}
+}
+
+// folderDoc est la partie d'un dossier utile aux réservations
+type folderDoc struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	OwnerID       string             `bson:"owner_id"`
+	Visibility    string             `bson:"visibility"`
+	ShareToken    string             `bson:"share_token"`
+	Collaborators []struct {
+		UserID string `bson:"user_id"`
+	} `bson:"collaborators"`
+	GiftSettings Settings `bson:"gift_settings"`
+}
+
+// canView indique si le visiteur voit le dossier : lien de partage,
+// collaborateur, propriétaire ou dossier public
+func (f *folderDoc) canView(v Visitor) bool {
+	if v.ShareToken != "" && v.ShareToken == f.ShareToken {
+		return true
+	}
+	if v.UserID == "" {
+		return false
+	}
+	if v.UserID == f.OwnerID || f.Visibility == "public" {
+		return true
+	}
+	for _, c := range f.Collaborators {
+		if c.UserID == v.UserID {
+			return true
+		}
+	}
+	return false
+}
+
+// revealed indique si le propriétaire peut voir les réservations
+func (f *folderDoc) revealed(now time.Time) bool {
+	st := f.GiftSettings
+	return st.RevealAfterEvent && st.EventAt != nil && !now.Before(*st.EventAt)
+}
+
+func (s *Service) folder(ctx context.Context, folderID string) (*folderDoc, error) {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, ErrGiftNotFound
+	}
+	var f folderDoc
+	if err := s.folderCol.FindOne(ctx, bson.M{"_id": fid, "deleted_at": nil}).Decode(&f); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrGiftNotFound
+		}
+		return nil, err
+	}
+	return &f, nil
+}
+
+// gift charge un cadeau et son dossier visibles par le visiteur
+func (s *Service) gift(ctx context.Context, linkID string, v Visitor) (string, *folderDoc, error) {
+	id, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return "", nil, ErrGiftNotFound
+	}
+	var l struct {
+		FolderID string `bson:"folder_id"`
+	}
+	err = s.linkCol.FindOne(ctx, bson.M{"_id": id, "category": giftCategory, "deleted_at": nil}).Decode(&l)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && l.FolderID == "") {
+		return "", nil, ErrGiftNotFound
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	f, err := s.folder(ctx, l.FolderID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !f.canView(v) {
+		return "", nil, ErrGiftNotFound
+	}
+	return l.FolderID, f, nil
+}
+
+// Claim réserve un cadeau. Un visiteur anonyme donne son nom et reçoit une
+// clé (Claim.GuestKey) pour annuler ; un utilisateur connecté est affiché
+// sous son nom, sauf s'il en donne un autre.
+func (s *Service) Claim(ctx context.Context, linkID string, v Visitor, name string) (*Claim, error) {
+	folderID, f, err := s.gift(ctx, linkID, v)
+	if err != nil {
+		return nil, err
+	}
+	if v.UserID == f.OwnerID {
+		return nil, ErrOwnerCannot
+	}
+	name = strings.TrimSpace(name)
+	if len([]rune(name)) > maxNameLength {
+		name = string([]rune(name)[:maxNameLength])
+	}
+	c := &Claim{LinkID: linkID, FolderID: folderID, UserID: v.UserID, Name: name, CreatedAt: s.now()}
+	if v.UserID == "" {
+		if name == "" {
+			return nil, ErrNameRequired
+		}
+		if c.GuestKey, err = newKey(); err != nil {
+			return nil, err
+		}
+	} else if name == "" {
+		c.Name = s.displayName(ctx, v.UserID)
+	}
+	res, err := s.col.InsertOne(ctx, c)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrAlreadyClaimed
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.ID = res.InsertedID.(primitive.ObjectID)
+	return c, nil
+}
+
+// Unclaim annule une réservation. Seul son auteur peut l'annuler : par son
+// compte, ou par la clé reçue s'il était anonyme.
+func (s *Service) Unclaim(ctx context.Context, linkID string, v Visitor, guestKey string) error {
+	if _, _, err := s.gift(ctx, linkID, v); err != nil {
+		return err
+	}
+	var by bson.A
+	if v.UserID != "" {
+		by = append(by, bson.M{"user_id": v.UserID})
+	}
+	if guestKey != "" {
+		by = append(by, bson.M{"guest_key": guestKey})
+	}
+	if len(by) == 0 {
+		return ErrClaimNotFound
+	}
+	res, err := s.col.DeleteOne(ctx, bson.M{"link_id": linkID, "$or": by})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrClaimNotFound
+	}
+	return nil
+}
+
+// List retourne les réservations d'un dossier, désigné par folderID ou par
+// le lien de partage du visiteur. Le propriétaire ne les voit qu'une fois la
+// fête passée, s'il l'a choisi. Rien ne distingue le propriétaire venu sans
+// compte par son propre lien de partage : un visiteur sans compte ne voit
+// les cadeaux réservés, jamais les noms, que s'il présente la clé d'une de
+// ses réservations dans le dossier, ce que le propriétaire ne peut avoir.
+func (s *Service) List(ctx context.Context, folderID string, v Visitor) (*Claims, error) {
+	var f *folderDoc
+	var err error
+	if folderID == "" && v.ShareToken != "" {
+		f = &folderDoc{}
+		err = s.folderCol.FindOne(ctx, bson.M{"share_token": v.ShareToken, "deleted_at": nil}).Decode(f)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrGiftNotFound
+		}
+	} else {
+		f, err = s.folder(ctx, folderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !f.canView(v) {
+		return nil, ErrGiftNotFound
+	}
+	out := &Claims{Claims: []*Claim{}}
+	if v.UserID == f.OwnerID {
+		out.IsOwner = true
+		out.Settings = f.GiftSettings
+		if !f.revealed(s.now()) {
+			out.Hidden = true
+			return out, nil
+		}
+	}
+	cursor, err := s.col.Find(ctx, bson.M{"folder_id": f.ID.Hex()}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &out.Claims); err != nil {
+		return nil, err
+	}
+	if v.UserID == "" {
+		out.NamesHidden = true
+		if !hasGuestKey(out.Claims, v.GuestKey) {
+			out.Hidden = true
+			out.Claims = []*Claim{}
+			return out, nil
+		}
+		for i, c := range out.Claims {
+			out.Claims[i] = &Claim{LinkID: c.LinkID, FolderID: c.FolderID}
+		}
+	}
+	return out, nil
+}
+
+// hasGuestKey indique si key est la clé d'une des réservations
+func hasGuestKey(claims []*Claim, key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, c := range claims {
+		if c.GuestKey == key {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateSettings règle la date de la fête et la révélation des réservations
+// d'un dossier de son propriétaire
+func (s *Service) UpdateSettings(ctx context.Context, folderID, ownerID string, st Settings) (*Settings, error) {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, ErrInvalidFolder
+	}
+	res, err := s.folderCol.UpdateOne(ctx,
+		bson.M{"_id": fid, "owner_id": ownerID, "deleted_at": nil},
+		bson.M{"$set": bson.M{"gift_settings": st}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrInvalidFolder
+	}
+	return &st, nil
+}
+
+func (s *Service) displayName(ctx context.Context, userID string) string {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ""
+	}
+	var user struct {
+		DisplayName string `bson:"display_name"`
+	}
+	if err := s.userCol.FindOne(ctx, bson.M{"_id": oid}).Decode(&user); err != nil {
+		return ""
+	}
+	return user.DisplayName
+}
+
+func newKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
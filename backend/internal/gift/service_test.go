@@ -0,0 +1,212 @@
+package gift
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func TestFolder_Revealed(t *testing.T) {
+	party := time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		st   Settings
+		now  time.Time
+		want bool
+	}{
+		{"not opted in", Settings{EventAt: &party}, party.AddDate(0, 0, 1), false},
+		{"no date", Settings{RevealAfterEvent: true}, party, false},
+		{"before the party", Settings{EventAt: &party, RevealAfterEvent: true}, party.Add(-time.Minute), false},
+		{"party day", Settings{EventAt: &party, RevealAfterEvent: true}, party, true},
+	}
+	for _, tt := range tests {
+		f := folderDoc{GiftSettings: tt.st}
+		if got := f.revealed(tt.now); got != tt.want {
+			t.Errorf("%s: revealed = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// Les proches, connectés ou venus par le lien de partage, réservent les
+// cadeaux ; les proches connectés voient qui a réservé, pas le propriétaire
+// jusqu'à la fête s'il a choisi de les voir
+func TestClaims_HiddenFromOwner(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	claims := db.Collection("gift_claims")
+	if _, err := claims.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "link_id", Value: 1}}, Options: options.Index().SetUnique(true),
+	}); err != nil {
+		t.Fatalf("index: %v", err)
+	}
+	svc := NewService(claims, db.Collection("links"), db.Collection("folders"), db.Collection("users"))
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+
+	parent := primitive.NewObjectID().Hex()
+	granny := primitive.NewObjectID()
+	stranger := primitive.NewObjectID().Hex()
+	if _, err := db.Collection("users").InsertOne(ctx, bson.M{"_id": granny, "display_name": "Mamie"}); err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	folder := primitive.NewObjectID()
+	if _, err := db.Collection("folders").InsertOne(ctx, bson.M{
+		"_id": folder, "owner_id": parent, "name": "Anniversaire de Léa", "visibility": "private",
+		"share_token": "tok", "collaborators": bson.A{bson.M{"user_id": granny.Hex(), "role": "viewer"}},
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	insertLink := func(category string) string {
+		id := primitive.NewObjectID()
+		if _, err := db.Collection("links").InsertOne(ctx, bson.M{
+			"_id": id, "owner_id": parent, "folder_id": folder.Hex(), "title": "Cadeau", "category": category,
+		}); err != nil {
+			t.Fatalf("insert link: %v", err)
+		}
+		return id.Hex()
+	}
+	bike := insertLink(giftCategory)
+	puzzle := insertLink(giftCategory)
+	recipe := insertLink("LINK_CATEGORY_RECETTE")
+
+	owner := Visitor{UserID: parent}
+	grandma := Visitor{UserID: granny.Hex()}
+	guest := Visitor{ShareToken: "tok"}
+
+	c, err := svc.Claim(ctx, bike, grandma, "")
+	if err != nil || c.Name != "Mamie" || c.GuestKey != "" {
+		t.Fatalf("grandma claim = %+v, %v", c, err)
+	}
+	if _, err := svc.Claim(ctx, bike, guest, "Tonton Paul"); !errors.Is(err, ErrAlreadyClaimed) {
+		t.Errorf("double claim err = %v, want ErrAlreadyClaimed", err)
+	}
+	if _, err := svc.Claim(ctx, puzzle, guest, " "); !errors.Is(err, ErrNameRequired) {
+		t.Errorf("nameless guest err = %v, want ErrNameRequired", err)
+	}
+	paul, err := svc.Claim(ctx, puzzle, guest, "Tonton Paul")
+	if err != nil || paul.GuestKey == "" {
+		t.Fatalf("guest claim = %+v, %v", paul, err)
+	}
+	if _, err := svc.Claim(ctx, recipe, grandma, ""); !errors.Is(err, ErrGiftNotFound) {
+		t.Errorf("recipe claim err = %v, want ErrGiftNotFound", err)
+	}
+	if _, err := svc.Claim(ctx, bike, Visitor{UserID: stranger}, ""); !errors.Is(err, ErrGiftNotFound) {
+		t.Errorf("stranger claim err = %v, want ErrGiftNotFound", err)
+	}
+	if _, err := svc.Claim(ctx, bike, Visitor{ShareToken: "wrong"}, "X"); !errors.Is(err, ErrGiftNotFound) {
+		t.Errorf("wrong token claim err = %v, want ErrGiftNotFound", err)
+	}
+	if _, err := svc.Claim(ctx, puzzle, Visitor{UserID: parent, ShareToken: "tok"}, ""); !errors.Is(err, ErrOwnerCannot) {
+		t.Errorf("owner claim err = %v, want ErrOwnerCannot", err)
+	}
+
+	got, err := svc.List(ctx, folder.Hex(), grandma)
+	if err != nil || got.Hidden || got.IsOwner || got.NamesHidden || len(got.Claims) != 2 {
+		t.Fatalf("grandma list = %+v, %v; want 2 claims", got, err)
+	}
+	for _, c := range got.Claims {
+		if c.Name != "Mamie" && c.Name != "Tonton Paul" {
+			t.Errorf("grandma sees claim %+v", c)
+		}
+	}
+
+	// Sans compte, le lien de partage seul ne dit rien : le propriétaire
+	// pourrait s'en servir
+	for name, v := range map[string]Visitor{
+		"owner by token": {ShareToken: "tok"},
+		"wrong key":      {ShareToken: "tok", GuestKey: "not-a-key"},
+	} {
+		got, err := svc.List(ctx, "", v)
+		if err != nil || !got.Hidden || !got.NamesHidden || got.IsOwner || len(got.Claims) != 0 {
+			t.Errorf("%s list = %+v, %v; want hidden", name, got, err)
+		}
+	}
+	// Avec la clé de sa réservation, un invité voit ce qui est réservé, sans
+	// les noms
+	got, err = svc.List(ctx, "", Visitor{ShareToken: "tok", GuestKey: paul.GuestKey})
+	if err != nil || got.Hidden || !got.NamesHidden || got.IsOwner || len(got.Claims) != 2 {
+		t.Fatalf("guest list = %+v, %v; want 2 anonymous claims", got, err)
+	}
+	for _, c := range got.Claims {
+		if c.LinkID == "" || c.Name != "" || c.UserID != "" || c.GuestKey != "" || !c.CreatedAt.IsZero() {
+			t.Errorf("guest sees claim %+v", c)
+		}
+	}
+
+	// Le propriétaire connecté ne voit rien, même avec son lien de partage
+	got, err = svc.List(ctx, folder.Hex(), owner)
+	if err != nil || !got.Hidden || len(got.Claims) != 0 {
+		t.Errorf("owner list = %+v, %v; want hidden", got, err)
+	}
+	got, _ = svc.List(ctx, "", Visitor{UserID: parent, ShareToken: "tok"})
+	if got == nil || !got.Hidden || len(got.Claims) != 0 {
+		t.Errorf("owner list by token = %+v; want hidden", got)
+	}
+
+	// Après la fête, s'il l'a choisi
+	party := now.AddDate(0, 0, 10)
+	if _, err := svc.UpdateSettings(ctx, folder.Hex(), granny.Hex(), Settings{EventAt: &party, RevealAfterEvent: true}); !errors.Is(err, ErrInvalidFolder) {
+		t.Errorf("collaborator settings err = %v, want ErrInvalidFolder", err)
+	}
+	if _, err := svc.UpdateSettings(ctx, folder.Hex(), parent, Settings{EventAt: &party, RevealAfterEvent: true}); err != nil {
+		t.Fatalf("settings: %v", err)
+	}
+	if got, _ := svc.List(ctx, folder.Hex(), owner); got == nil || !got.Hidden {
+		t.Errorf("owner list before party = %+v; want hidden", got)
+	}
+	now = party.Add(time.Hour)
+	if got, _ := svc.List(ctx, folder.Hex(), owner); got == nil || got.Hidden || len(got.Claims) != 2 {
+		t.Errorf("owner list after party = %+v; want 2 claims", got)
+	}
+
+	// Seul l'auteur annule sa réservation
+	if err := svc.Unclaim(ctx, puzzle, grandma, ""); !errors.Is(err, ErrClaimNotFound) {
+		t.Errorf("unclaim by other err = %v, want ErrClaimNotFound", err)
+	}
+	if err := svc.Unclaim(ctx, puzzle, guest, paul.GuestKey); err != nil {
+		t.Errorf("guest unclaim: %v", err)
+	}
+	if err := svc.Unclaim(ctx, bike, grandma, ""); err != nil {
+		t.Errorf("grandma unclaim: %v", err)
+	}
+	if got, _ := svc.List(ctx, folder.Hex(), grandma); got == nil || len(got.Claims) != 0 {
+		t.Errorf("list after unclaim = %+v", got)
+	}
+}
@@ -0,0 +1,253 @@
+package household
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedHouseholdServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrHouseholdNotFound), errors.Is(err, ErrUserNotFound), errors.Is(err, ErrNotMember), errors.Is(err, ErrInviteNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotAdmin), errors.Is(err, ErrNotOwner), errors.Is(err, ErrFolderNotFound):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrAlreadyMember), errors.Is(err, ErrAlreadyInvited):
+		return status.Errorf(codes.AlreadyExists, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNameRequired), errors.Is(err, ErrInvalidRole):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrOwnerMember), errors.Is(err, ErrTooManyMembers):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrSyncConflict):
+		return status.Errorf(codes.Aborted, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func roleToProto(role string) pb.HouseholdRole {
+	switch role {
+	case RoleAdmin:
+		return pb.HouseholdRole_HOUSEHOLD_ROLE_ADMIN
+	case RoleViewer:
+		return pb.HouseholdRole_HOUSEHOLD_ROLE_VIEWER
+	default:
+		return pb.HouseholdRole_HOUSEHOLD_ROLE_EDITOR
+	}
+}
+
+// roleToStr retourne le rôle demandé, editor s'il n'est pas précisé
+func roleToStr(role pb.HouseholdRole) string {
+	switch role {
+	case pb.HouseholdRole_HOUSEHOLD_ROLE_ADMIN:
+		return RoleAdmin
+	case pb.HouseholdRole_HOUSEHOLD_ROLE_VIEWER:
+		return RoleViewer
+	case pb.HouseholdRole_HOUSEHOLD_ROLE_EDITOR, pb.HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED:
+		return RoleEditor
+	default:
+		return ""
+	}
+}
+
+func memberToProto(m Member, ownerID string) *pb.HouseholdMember {
+	return &pb.HouseholdMember{
+		UserId:      m.UserID,
+		Email:       m.Email,
+		DisplayName: m.DisplayName,
+		Role:        roleToProto(m.Role),
+		AddedAt:     timestamppb.New(m.AddedAt),
+		Owner:       m.UserID == ownerID,
+	}
+}
+
+func householdToProto(h *Household) *pb.Household {
+	if h == nil {
+		return nil
+	}
+	out := &pb.Household{
+		Id:        h.ID.Hex(),
+		Name:      h.Name,
+		OwnerId:   h.OwnerID,
+		Members:   make([]*pb.HouseholdMember, 0, len(h.Members)),
+		FolderIds: h.FolderIDs,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+		UpdatedAt: timestamppb.New(h.UpdatedAt),
+		Invites:   make([]*pb.HouseholdMember, 0, len(h.Invites)),
+	}
+	for _, m := range h.Members {
+		out.Members = append(out.Members, memberToProto(m, h.OwnerID))
+	}
+	for _, m := range h.Invites {
+		out.Invites = append(out.Invites, memberToProto(m, h.OwnerID))
+	}
+	return out
+}
+
+func (h *Handler) CreateHousehold(ctx context.Context, req *pb.CreateHouseholdRequest) (*pb.CreateHouseholdResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.Create(ctx, userID, req.Name)
+	if err != nil {
+		return nil, serviceError(err, "create household")
+	}
+	return &pb.CreateHouseholdResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) GetHousehold(ctx context.Context, req *pb.GetHouseholdRequest) (*pb.GetHouseholdResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.Get(ctx, req.HouseholdId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get household")
+	}
+	return &pb.GetHouseholdResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) ListHouseholds(ctx context.Context, _ *pb.ListHouseholdsRequest) (*pb.ListHouseholdsResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	households, err := h.svc.List(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err, "list households")
+	}
+	out := make([]*pb.Household, 0, len(households))
+	for _, hh := range households {
+		out = append(out, householdToProto(hh))
+	}
+	return &pb.ListHouseholdsResponse{Households: out}, nil
+}
+
+func (h *Handler) DeleteHousehold(ctx context.Context, req *pb.DeleteHouseholdRequest) (*pb.DeleteHouseholdResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.Delete(ctx, req.HouseholdId, userID); err != nil {
+		return nil, serviceError(err, "delete household")
+	}
+	return &pb.DeleteHouseholdResponse{}, nil
+}
+
+func (h *Handler) AddHouseholdMember(ctx context.Context, req *pb.AddHouseholdMemberRequest) (*pb.AddHouseholdMemberResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.AddMember(ctx, req.HouseholdId, userID, req.Email, roleToStr(req.Role))
+	if err != nil {
+		return nil, serviceError(err, "add household member")
+	}
+	return &pb.AddHouseholdMemberResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) UpdateHouseholdMember(ctx context.Context, req *pb.UpdateHouseholdMemberRequest) (*pb.UpdateHouseholdMemberResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.Role == pb.HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	hh, err := h.svc.UpdateMember(ctx, req.HouseholdId, userID, req.UserId, roleToStr(req.Role))
+	if err != nil {
+		return nil, serviceError(err, "update household member")
+	}
+	return &pb.UpdateHouseholdMemberResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) RemoveHouseholdMember(ctx context.Context, req *pb.RemoveHouseholdMemberRequest) (*pb.RemoveHouseholdMemberResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.RemoveMember(ctx, req.HouseholdId, userID, req.UserId)
+	if err != nil {
+		return nil, serviceError(err, "remove household member")
+	}
+	return &pb.RemoveHouseholdMemberResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) ShareFolderWithHousehold(ctx context.Context, req *pb.ShareFolderWithHouseholdRequest) (*pb.ShareFolderWithHouseholdResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.ShareFolder(ctx, req.HouseholdId, req.FolderId, userID)
+	if err != nil {
+		return nil, serviceError(err, "share folder with household")
+	}
+	return &pb.ShareFolderWithHouseholdResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) UnshareFolderFromHousehold(ctx context.Context, req *pb.UnshareFolderFromHouseholdRequest) (*pb.UnshareFolderFromHouseholdResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.UnshareFolder(ctx, req.HouseholdId, req.FolderId, userID)
+	if err != nil {
+		return nil, serviceError(err, "unshare folder from household")
+	}
+	return &pb.UnshareFolderFromHouseholdResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) ListHouseholdInvites(ctx context.Context, _ *pb.ListHouseholdInvitesRequest) (*pb.ListHouseholdInvitesResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	households, err := h.svc.ListInvites(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err, "list household invites")
+	}
+	out := make([]*pb.Household, 0, len(households))
+	for _, hh := range households {
+		out = append(out, householdToProto(hh))
+	}
+	return &pb.ListHouseholdInvitesResponse{Households: out}, nil
+}
+
+func (h *Handler) AcceptHouseholdInvite(ctx context.Context, req *pb.AcceptHouseholdInviteRequest) (*pb.AcceptHouseholdInviteResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	hh, err := h.svc.AcceptInvite(ctx, req.HouseholdId, userID)
+	if err != nil {
+		return nil, serviceError(err, "accept household invite")
+	}
+	return &pb.AcceptHouseholdInviteResponse{Household: householdToProto(hh)}, nil
+}
+
+func (h *Handler) DeclineHouseholdInvite(ctx context.Context, req *pb.DeclineHouseholdInviteRequest) (*pb.DeclineHouseholdInviteResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.DeclineInvite(ctx, req.HouseholdId, userID); err != nil {
+		return nil, serviceError(err, "decline household invite")
+	}
+	return &pb.DeclineHouseholdInviteResponse{}, nil
+}
@@ -0,0 +1,665 @@
+// Package household regroupe les membres d'une famille en un foyer. On y est
+// invité, et on n'en devient membre qu'en acceptant l'invitation. Un
+// dossier partagé avec un foyer l'est avec chacun de ses membres : ils sont
+// inscrits parmi ses collaborateurs (marqués du foyer), et toute arrivée,
+// départ ou changement de rôle est reporté sur tous les dossiers du foyer.
+// Les admins et editors d'un foyer en sont les parents : ils partagent leurs
+// enfants comme des co-parents (voir child.FamilyIDs).
+package household
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/folder"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	maxNameLength = 60
+	maxMembers    = 50
+	// maxSyncAttempts borne les nouveaux essais d'un dossier modifié pendant
+	// le recalcul de ses collaborateurs
+	maxSyncAttempts = 10
+)
+
+// Rôles d'un membre. Les administrateurs gèrent les membres ; sur les
+// dossiers partagés avec le foyer, admin et editor modifient, viewer consulte.
+// Admin et editor voient et modifient aussi les enfants des autres parents
+// du foyer.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+var (
+	ErrHouseholdNotFound = errors.New("household not found")
+	ErrNameRequired      = errors.New("household name is required")
+	ErrNotAdmin          = errors.New("only a household admin can do this")
+	ErrNotOwner          = errors.New("only the household owner can do this")
+	ErrInvalidRole       = errors.New("invalid household role")
+	ErrUserNotFound      = errors.New("user not found with this email")
+	ErrAlreadyMember     = errors.New("user is already a member")
+	ErrAlreadyInvited    = errors.New("user is already invited to this household")
+	ErrInviteNotFound    = errors.New("no pending invitation to this household")
+	ErrNotMember         = errors.New("user is not a member")
+	ErrOwnerMember       = errors.New("the owner stays an admin of the household")
+	ErrTooManyMembers    = errors.New("too many household members")
+	ErrFolderNotFound    = errors.New("folder not found or not owned")
+	ErrSyncConflict      = errors.New("folder kept changing while updating its household members")
+)
+
+type Member struct {
+	UserID      string    `bson:"user_id"`
+	Email       string    `bson:"email"`
+	DisplayName string    `bson:"display_name"`
+	Role        string    `bson:"role"` // "admin" | "editor" | "viewer"
+	AddedAt     time.Time `bson:"added_at"`
+}
+
+// Household est un foyer. Son propriétaire en est toujours membre, admin.
+type Household struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OwnerID   string             `bson:"owner_id"`
+	Name      string             `bson:"name"`
+	Members   []Member           `bson:"members"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+	// Invites sont les invitations en attente, avec le rôle proposé : un
+	// invité n'a accès à rien tant qu'il n'a pas accepté (AcceptInvite)
+	Invites []Member `bson:"invites,omitempty"`
+	// FolderIDs sont les dossiers partagés avec le foyer (non stocké)
+	FolderIDs []string `bson:"-"`
+}
+
+// member retourne le membre userID, nil s'il n'en fait pas partie
+func (h *Household) member(userID string) *Member {
+	for i := range h.Members {
+		if h.Members[i].UserID == userID {
+			return &h.Members[i]
+		}
+	}
+	return nil
+}
+
+// invited indique si userID a une invitation en attente
+func (h *Household) invited(userID string) bool {
+	return slices.ContainsFunc(h.Invites, func(m Member) bool { return m.UserID == userID })
+}
+
+func (h *Household) isAdmin(userID string) bool {
+	m := h.member(userID)
+	return m != nil && m.Role == RoleAdmin
+}
+
+// folderRole est le rôle de collaborateur que donne un rôle de membre
+func folderRole(role string) string {
+	if role == RoleViewer {
+		return "viewer"
+	}
+	return "editor"
+}
+
+func validRole(role string) bool {
+	return role == RoleAdmin || role == RoleEditor || role == RoleViewer
+}
+
+type Service struct {
+	col       *mongo.Collection
+	folderCol *mongo.Collection
+	userCol   *mongo.Collection
+	now       func() time.Time
+}
+
+func NewService(col, folderCol, userCol *mongo.Collection) *Service {
+	return &Service{col: col, folderCol: folderCol, userCol: userCol, now: time.Now}
+}
+
+type user struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Email       string             `bson:"email"`
+	DisplayName string             `bson:"display_name"`
+}
+
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// Create crée un foyer dont l'utilisateur est le propriétaire et le premier
+// membre
+func (s *Service) Create(ctx context.Context, ownerID, name string) (*Household, error) {
+	name = truncate(name, maxNameLength)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	oid, err := primitive.ObjectIDFromHex(ownerID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	var u user
+	if err := s.userCol.FindOne(ctx, bson.M{"_id": oid}).Decode(&u); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	now := s.now()
+	h := &Household{
+		OwnerID: ownerID,
+		Name:    name,
+		Members: []Member{{
+			UserID: ownerID, Email: u.Email, DisplayName: u.DisplayName, Role: RoleAdmin, AddedAt: now,
+		}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	res, err := s.col.InsertOne(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	h.ID = res.InsertedID.(primitive.ObjectID)
+	return h, nil
+}
+
+// load retourne un foyer dont l'utilisateur est membre
+func (s *Service) load(ctx context.Context, householdID, userID string) (*Household, error) {
+	id, err := primitive.ObjectIDFromHex(householdID)
+	if err != nil {
+		return nil, ErrHouseholdNotFound
+	}
+	var h Household
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "members.user_id": userID}).Decode(&h); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrHouseholdNotFound
+		}
+		return nil, err
+	}
+	return &h, nil
+}
+
+// withFolders renseigne FolderIDs des foyers
+func (s *Service) withFolders(ctx context.Context, households ...*Household) error {
+	if len(households) == 0 {
+		return nil
+	}
+	byID := map[string]*Household{}
+	ids := bson.A{}
+	for _, h := range households {
+		h.FolderIDs = []string{}
+		byID[h.ID.Hex()] = h
+		ids = append(ids, h.ID.Hex())
+	}
+	cursor, err := s.folderCol.Find(ctx, bson.M{"household_ids": bson.M{"$in": ids}, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+	var folders []*folder.Folder
+	if err := cursor.All(ctx, &folders); err != nil {
+		return err
+	}
+	for _, f := range folders {
+		for _, hid := range f.HouseholdIDs {
+			if h := byID[hid]; h != nil {
+				h.FolderIDs = append(h.FolderIDs, f.ID.Hex())
+			}
+		}
+	}
+	return nil
+}
+
+// Get retourne un foyer, à ses membres seulement
+func (s *Service) Get(ctx context.Context, householdID, userID string) (*Household, error) {
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.withFolders(ctx, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// List retourne les foyers dont l'utilisateur est membre
+func (s *Service) List(ctx context.Context, userID string) ([]*Household, error) {
+	cursor, err := s.col.Find(ctx, bson.M{"members.user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	households := []*Household{}
+	if err := cursor.All(ctx, &households); err != nil {
+		return nil, err
+	}
+	if err := s.withFolders(ctx, households...); err != nil {
+		return nil, err
+	}
+	return households, nil
+}
+
+// Delete supprime un foyer ; ses dossiers ne sont plus partagés avec ses
+// membres
+func (s *Service) Delete(ctx context.Context, householdID, userID string) error {
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return err
+	}
+	if h.OwnerID != userID {
+		return ErrNotOwner
+	}
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": h.ID}); err != nil {
+		return err
+	}
+	// Les dossiers du foyer, y compris ceux de la corbeille
+	hid := h.ID.Hex()
+	cursor, err := s.folderCol.Find(ctx, bson.M{"household_ids": hid}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	var folders []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &folders); err != nil {
+		return err
+	}
+	if len(folders) == 0 {
+		return nil
+	}
+	ids := bson.A{}
+	for _, f := range folders {
+		ids = append(ids, f.ID)
+	}
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	if _, err := s.folderCol.UpdateMany(ctx, filter, bson.M{"$pull": bson.M{"household_ids": hid}}); err != nil {
+		return err
+	}
+	return s.syncFolders(ctx, filter)
+}
+
+// AddMember invite au foyer l'utilisateur inscrit à cette adresse. Il n'en
+// devient membre, et n'a accès aux dossiers et aux enfants du foyer, qu'une
+// fois l'invitation acceptée (AcceptInvite).
+func (s *Service) AddMember(ctx context.Context, householdID, userID, email, role string) (*Household, error) {
+	if !validRole(role) {
+		return nil, ErrInvalidRole
+	}
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !h.isAdmin(userID) {
+		return nil, ErrNotAdmin
+	}
+	if len(h.Members)+len(h.Invites) >= maxMembers {
+		return nil, ErrTooManyMembers
+	}
+	var u user
+	if err := s.userCol.FindOne(ctx, bson.M{"email": strings.TrimSpace(email)}).Decode(&u); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	m := Member{UserID: u.ID.Hex(), Email: u.Email, DisplayName: u.DisplayName, Role: role, AddedAt: s.now()}
+	if h.member(m.UserID) != nil {
+		return nil, ErrAlreadyMember
+	}
+	if h.invited(m.UserID) {
+		return nil, ErrAlreadyInvited
+	}
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": h.ID, "members.user_id": bson.M{"$ne": m.UserID}, "invites.user_id": bson.M{"$ne": m.UserID}},
+		bson.M{"$push": bson.M{"invites": m}, "$set": bson.M{"updated_at": s.now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrAlreadyInvited
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// ListInvites retourne les foyers auxquels l'utilisateur est invité, réduits
+// à ce qu'il faut pour décider : nom et propriétaire
+func (s *Service) ListInvites(ctx context.Context, userID string) ([]*Household, error) {
+	cursor, err := s.col.Find(ctx, bson.M{"invites.user_id": userID},
+		options.Find().SetProjection(bson.M{"owner_id": 1, "name": 1, "created_at": 1, "updated_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	households := []*Household{}
+	if err := cursor.All(ctx, &households); err != nil {
+		return nil, err
+	}
+	return households, nil
+}
+
+// AcceptInvite fait de l'utilisateur invité un membre du foyer, avec le rôle
+// proposé, et lui ouvre les dossiers du foyer
+func (s *Service) AcceptInvite(ctx context.Context, householdID, userID string) (*Household, error) {
+	id, err := primitive.ObjectIDFromHex(householdID)
+	if err != nil {
+		return nil, ErrInviteNotFound
+	}
+	filter := bson.M{"_id": id, "invites.user_id": userID}
+	var h Household
+	if err := s.col.FindOne(ctx, filter).Decode(&h); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrInviteNotFound
+		}
+		return nil, err
+	}
+	var m Member
+	for _, inv := range h.Invites {
+		if inv.UserID == userID {
+			m = inv
+		}
+	}
+	m.AddedAt = s.now()
+	filter["members.user_id"] = bson.M{"$ne": userID}
+	res, err := s.col.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"invites": bson.M{"user_id": userID}},
+		"$push": bson.M{"members": m},
+		"$set":  bson.M{"updated_at": s.now()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrInviteNotFound
+	}
+	if err := s.syncFolders(ctx, bson.M{"household_ids": householdID}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// DeclineInvite refuse une invitation ; un admin l'annule par RemoveMember
+func (s *Service) DeclineInvite(ctx context.Context, householdID, userID string) error {
+	id, err := primitive.ObjectIDFromHex(householdID)
+	if err != nil {
+		return ErrInviteNotFound
+	}
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": id, "invites.user_id": userID},
+		bson.M{"$pull": bson.M{"invites": bson.M{"user_id": userID}}, "$set": bson.M{"updated_at": s.now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrInviteNotFound
+	}
+	return nil
+}
+
+// UpdateMember change le rôle d'un membre, sur le foyer et ses dossiers
+func (s *Service) UpdateMember(ctx context.Context, householdID, userID, memberID, role string) (*Household, error) {
+	if !validRole(role) {
+		return nil, ErrInvalidRole
+	}
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !h.isAdmin(userID) {
+		return nil, ErrNotAdmin
+	}
+	if h.member(memberID) == nil {
+		return nil, ErrNotMember
+	}
+	if memberID == h.OwnerID && role != RoleAdmin {
+		return nil, ErrOwnerMember
+	}
+	_, err = s.col.UpdateOne(ctx,
+		bson.M{"_id": h.ID, "members.user_id": memberID},
+		bson.M{"$set": bson.M{"members.$.role": role, "updated_at": s.now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncFolders(ctx, bson.M{"household_ids": h.ID.Hex()}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// RemoveMember retire un membre du foyer et de ses dossiers : un admin
+// retire qui il veut sauf le propriétaire, un membre peut partir de lui-même.
+// Le foyer n'est alors plus retourné à qui vient de le quitter. Un admin
+// annule de même une invitation en attente.
+func (s *Service) RemoveMember(ctx context.Context, householdID, userID, memberID string) (*Household, error) {
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if memberID != userID && !h.isAdmin(userID) {
+		return nil, ErrNotAdmin
+	}
+	if h.member(memberID) == nil && h.invited(memberID) {
+		_, err := s.col.UpdateOne(ctx,
+			bson.M{"_id": h.ID},
+			bson.M{"$pull": bson.M{"invites": bson.M{"user_id": memberID}}, "$set": bson.M{"updated_at": s.now()}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return s.Get(ctx, householdID, userID)
+	}
+	if h.member(memberID) == nil {
+		return nil, ErrNotMember
+	}
+	if memberID == h.OwnerID {
+		return nil, ErrOwnerMember
+	}
+	_, err = s.col.UpdateOne(ctx,
+		bson.M{"_id": h.ID},
+		bson.M{"$pull": bson.M{"members": bson.M{"user_id": memberID}}, "$set": bson.M{"updated_at": s.now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncFolders(ctx, bson.M{"household_ids": h.ID.Hex()}); err != nil {
+		return nil, err
+	}
+	if memberID == userID {
+		return nil, nil
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// ShareFolder partage un dossier avec tous les membres du foyer. Seul le
+// propriétaire du dossier le partage, avec un foyer dont il est membre.
+func (s *Service) ShareFolder(ctx context.Context, householdID, folderID, userID string) (*Household, error) {
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, ErrFolderNotFound
+	}
+	res, err := s.folderCol.UpdateOne(ctx,
+		bson.M{"_id": fid, "owner_id": userID, "deleted_at": nil},
+		bson.M{"$addToSet": bson.M{"household_ids": h.ID.Hex()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrFolderNotFound
+	}
+	if err := s.syncFolders(ctx, bson.M{"_id": fid}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// UnshareFolder retire le partage d'un dossier avec le foyer, à la demande
+// du propriétaire du dossier ou d'un admin du foyer
+func (s *Service) UnshareFolder(ctx context.Context, householdID, folderID, userID string) (*Household, error) {
+	h, err := s.load(ctx, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, ErrFolderNotFound
+	}
+	hid := h.ID.Hex()
+	filter := bson.M{"_id": fid, "household_ids": hid}
+	if !h.isAdmin(userID) {
+		filter["owner_id"] = userID
+	}
+	res, err := s.folderCol.UpdateOne(ctx, filter, bson.M{"$pull": bson.M{"household_ids": hid}})
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrFolderNotFound
+	}
+	if err := s.syncFolders(ctx, bson.M{"_id": fid}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, householdID, userID)
+}
+
+// syncFolders recalcule les collaborateurs venus des foyers des dossiers du
+// filtre
+func (s *Service) syncFolders(ctx context.Context, filter bson.M) error {
+	cursor, err := s.folderCol.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	var folders []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &folders); err != nil {
+		return err
+	}
+	for _, f := range folders {
+		if err := s.syncFolder(ctx, f.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncFolder remplace les collaborateurs venus des foyers du dossier par les
+// membres actuels de ces foyers. Les collaborateurs ajoutés directement sont
+// gardés tels quels ; un membre de plusieurs foyers n'est inscrit qu'une fois,
+// au titre du premier. L'écriture n'a lieu que si les collaborateurs et les
+// foyers du dossier n'ont pas changé depuis la lecture : sinon le calcul est
+// refait, pour ne pas écraser un ajout ou un autre foyer.
+func (s *Service) syncFolder(ctx context.Context, folderID primitive.ObjectID) error {
+	for range maxSyncAttempts {
+		var raw bson.Raw
+		err := s.folderCol.FindOne(ctx, bson.M{"_id": folderID}).Decode(&raw)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var f folder.Folder
+		if err := bson.Unmarshal(raw, &f); err != nil {
+			return err
+		}
+		set, err := s.folderCollaborators(ctx, &f)
+		if err != nil {
+			return err
+		}
+		filter := bson.M{"_id": folderID}
+		for _, key := range []string{"collaborators", "household_ids"} {
+			if v, err := raw.LookupErr(key); err == nil {
+				filter[key] = v
+			} else {
+				filter[key] = bson.M{"$exists": false}
+			}
+		}
+		res, err := s.folderCol.UpdateOne(ctx, filter, bson.M{"$set": set})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount > 0 {
+			return nil
+		}
+	}
+	return ErrSyncConflict
+}
+
+// folderCollaborators calcule les champs à écrire sur le dossier : ses
+// collaborateurs directs suivis des membres de ses foyers
+func (s *Service) folderCollaborators(ctx context.Context, f *folder.Folder) (bson.M, error) {
+	collabs := []folder.CollaboratorEntry{}
+	present := map[string]bool{f.OwnerID: true}
+	// addedAt garde la date d'arrivée de ceux qui avaient déjà accès
+	addedAt := map[[2]string]time.Time{}
+	for _, c := range f.Collaborators {
+		if c.HouseholdID != "" {
+			addedAt[[2]string{c.HouseholdID, c.UserID}] = c.AddedAt
+			continue
+		}
+		collabs = append(collabs, c)
+		present[c.UserID] = true
+	}
+
+	var households []*Household
+	if len(f.HouseholdIDs) > 0 {
+		ids := bson.A{}
+		for _, hid := range f.HouseholdIDs {
+			if oid, err := primitive.ObjectIDFromHex(hid); err == nil {
+				ids = append(ids, oid)
+			}
+		}
+		cursor, err := s.col.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err != nil {
+			return nil, err
+		}
+		if err := cursor.All(ctx, &households); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(households, func(a, b *Household) int {
+		return slices.Index(f.HouseholdIDs, a.ID.Hex()) - slices.Index(f.HouseholdIDs, b.ID.Hex())
+	})
+	now := s.now()
+	for _, h := range households {
+		hid := h.ID.Hex()
+		for _, m := range h.Members {
+			if present[m.UserID] {
+				continue
+			}
+			present[m.UserID] = true
+			at, ok := addedAt[[2]string{hid, m.UserID}]
+			if !ok {
+				at = now
+			}
+			collabs = append(collabs, folder.CollaboratorEntry{
+				UserID:      m.UserID,
+				Email:       m.Email,
+				DisplayName: m.DisplayName,
+				Role:        folderRole(m.Role),
+				AddedAt:     at,
+				HouseholdID: hid,
+			})
+		}
+	}
+
+	set := bson.M{"collaborators": collabs, "updated_at": now}
+	// Comme AddCollaborator : un dossier privé partagé devient "shared"
+	if f.Visibility == "private" && len(collabs) > 0 {
+		set["visibility"] = "shared"
+	}
+	return set, nil
+}
@@ -0,0 +1,456 @@
+package household
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/folder"
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func newUser(t *testing.T, db *mongo.Database, email string) string {
+	id := primitive.NewObjectID()
+	if _, err := db.Collection("users").InsertOne(context.Background(), bson.M{"_id": id, "email": email, "display_name": email}); err != nil {
+		t.Fatal(err)
+	}
+	return id.Hex()
+}
+
+// join invite userID, inscrit à l'adresse email, au foyer et lui fait
+// accepter l'invitation
+func join(t *testing.T, svc *Service, householdID, adminID, email, userID, role string) {
+	t.Helper()
+	if _, err := svc.AddMember(context.Background(), householdID, adminID, email, role); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AcceptInvite(context.Background(), householdID, userID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// collaborator retourne l'entrée de userID parmi les collaborateurs du dossier
+func collaborator(t *testing.T, folders *folder.Service, folderID, ownerID, userID string) *folder.CollaboratorEntry {
+	t.Helper()
+	f, err := folders.Get(context.Background(), folderID, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range f.Collaborators {
+		if c.UserID == userID {
+			return &c
+		}
+	}
+	return nil
+}
+
+// Partager un dossier avec le foyer l'ouvre à tous ses membres, et les
+// changements de membres sont reportés sur ses dossiers
+func TestShareFolder_FollowsMembership(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("households"), db.Collection("folders"), db.Collection("users"))
+	folders := folder.NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	mum := newUser(t, db, "mum@example.com")
+	dad := newUser(t, db, "dad@example.com")
+	teen := newUser(t, db, "teen@example.com")
+	granny := newUser(t, db, "granny@example.com")
+
+	h, err := svc.Create(ctx, mum, " Famille Martin ")
+	if err != nil || h.Name != "Famille Martin" || len(h.Members) != 1 || h.Members[0].Role != RoleAdmin {
+		t.Fatalf("create = %+v, %v", h, err)
+	}
+	noel, _ := folders.Create(ctx, mum, "Noël", "", "", "private", "", nil, nil)
+	vacances, _ := folders.Create(ctx, mum, "Vacances", "", "", "private", "", nil, nil)
+	if _, err := folders.AddCollaborator(ctx, vacances.ID.Hex(), mum, "granny@example.com", "viewer"); err != nil {
+		t.Fatal(err)
+	}
+	join(t, svc, h.ID.Hex(), mum, "dad@example.com", dad, RoleEditor)
+
+	for _, f := range []*folder.Folder{noel, vacances} {
+		if _, err := svc.ShareFolder(ctx, h.ID.Hex(), f.ID.Hex(), mum); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := svc.ShareFolder(ctx, h.ID.Hex(), noel.ID.Hex(), dad); !errors.Is(err, ErrFolderNotFound) {
+		t.Errorf("share someone else's folder err = %v, want ErrFolderNotFound", err)
+	}
+	if c := collaborator(t, folders, noel.ID.Hex(), mum, dad); c == nil || c.Role != "editor" || c.HouseholdID != h.ID.Hex() {
+		t.Errorf("dad on Noël = %+v", c)
+	}
+	if f, _ := folders.Get(ctx, noel.ID.Hex(), mum); f.Visibility != "shared" {
+		t.Errorf("visibility = %q, want shared", f.Visibility)
+	}
+
+	// Un invité n'accède à rien avant d'accepter ; il accède alors d'un
+	// coup à tous les dossiers du foyer
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), dad, "teen@example.com", RoleViewer); !errors.Is(err, ErrNotAdmin) {
+		t.Errorf("editor adds member err = %v, want ErrNotAdmin", err)
+	}
+	got, err := svc.AddMember(ctx, h.ID.Hex(), mum, "teen@example.com", RoleViewer)
+	if err != nil || len(got.Members) != 2 || len(got.Invites) != 1 || got.Invites[0].UserID != teen {
+		t.Fatalf("invite teen = %+v, %v", got, err)
+	}
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), mum, "teen@example.com", RoleEditor); !errors.Is(err, ErrAlreadyInvited) {
+		t.Errorf("invite twice err = %v, want ErrAlreadyInvited", err)
+	}
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), mum, "dad@example.com", RoleEditor); !errors.Is(err, ErrAlreadyMember) {
+		t.Errorf("invite member err = %v, want ErrAlreadyMember", err)
+	}
+	if ids, _ := links.AccessibleFolderIDs(ctx, teen); len(ids) != 0 {
+		t.Errorf("invited teen's folders = %v, want none", ids)
+	}
+	if _, err := svc.Get(ctx, h.ID.Hex(), teen); !errors.Is(err, ErrHouseholdNotFound) {
+		t.Errorf("invited teen gets household err = %v, want ErrHouseholdNotFound", err)
+	}
+	if invites, err := svc.ListInvites(ctx, teen); err != nil || len(invites) != 1 || invites[0].Name != "Famille Martin" || len(invites[0].Members) != 0 {
+		t.Errorf("teen's invites = %+v, %v", invites, err)
+	}
+	if _, err := svc.AcceptInvite(ctx, h.ID.Hex(), granny); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("uninvited accept err = %v, want ErrInviteNotFound", err)
+	}
+	if got, err := svc.AcceptInvite(ctx, h.ID.Hex(), teen); err != nil || len(got.Members) != 3 || len(got.Invites) != 0 {
+		t.Fatalf("accept = %+v, %v", got, err)
+	}
+	ids, err := links.AccessibleFolderIDs(ctx, teen)
+	if err != nil || len(ids) != 2 || !slices.Contains(ids, noel.ID.Hex()) || !slices.Contains(ids, vacances.ID.Hex()) {
+		t.Errorf("teen's folders = %v, %v", ids, err)
+	}
+	if c := collaborator(t, folders, vacances.ID.Hex(), mum, teen); c == nil || c.Role != "viewer" {
+		t.Errorf("teen on Vacances = %+v", c)
+	}
+
+	// Un changement de rôle est reporté, le collaborateur direct est gardé
+	if _, err := svc.UpdateMember(ctx, h.ID.Hex(), mum, teen, RoleEditor); err != nil {
+		t.Fatal(err)
+	}
+	if c := collaborator(t, folders, vacances.ID.Hex(), mum, teen); c == nil || c.Role != "editor" {
+		t.Errorf("teen on Vacances after promotion = %+v", c)
+	}
+	if c := collaborator(t, folders, vacances.ID.Hex(), mum, granny); c == nil || c.HouseholdID != "" {
+		t.Errorf("granny on Vacances = %+v", c)
+	}
+
+	// Un membre du foyer se retire par le foyer, pas comme collaborateur
+	if _, err := folders.RemoveCollaborator(ctx, vacances.ID.Hex(), mum, teen); !errors.Is(err, folder.ErrHouseholdCollaborator) {
+		t.Errorf("remove household collaborator err = %v, want ErrHouseholdCollaborator", err)
+	}
+	if c := collaborator(t, folders, vacances.ID.Hex(), mum, teen); c == nil {
+		t.Error("teen removed from Vacances as a collaborator")
+	}
+	if _, err := svc.UpdateMember(ctx, h.ID.Hex(), mum, mum, RoleViewer); !errors.Is(err, ErrOwnerMember) {
+		t.Errorf("demote owner err = %v, want ErrOwnerMember", err)
+	}
+
+	// Partir du foyer retire l'accès à ses dossiers
+	if got, err := svc.RemoveMember(ctx, h.ID.Hex(), teen, teen); err != nil || got != nil {
+		t.Fatalf("leave = %+v, %v", got, err)
+	}
+	if ids, _ := links.AccessibleFolderIDs(ctx, teen); len(ids) != 0 {
+		t.Errorf("teen's folders after leaving = %v", ids)
+	}
+	if _, err := svc.Get(ctx, h.ID.Hex(), teen); !errors.Is(err, ErrHouseholdNotFound) {
+		t.Errorf("get after leaving err = %v, want ErrHouseholdNotFound", err)
+	}
+
+	got, err = svc.UnshareFolder(ctx, h.ID.Hex(), vacances.ID.Hex(), mum)
+	if err != nil || len(got.FolderIDs) != 1 || got.FolderIDs[0] != noel.ID.Hex() {
+		t.Fatalf("unshare = %+v, %v", got, err)
+	}
+	if collaborator(t, folders, vacances.ID.Hex(), mum, dad) != nil {
+		t.Error("dad still on Vacances after unshare")
+	}
+
+	// Supprimer le foyer retire ses membres des dossiers
+	if err := svc.Delete(ctx, h.ID.Hex(), dad); !errors.Is(err, ErrNotOwner) {
+		t.Errorf("delete by member err = %v, want ErrNotOwner", err)
+	}
+	if err := svc.Delete(ctx, h.ID.Hex(), mum); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ := links.AccessibleFolderIDs(ctx, dad); len(ids) != 0 {
+		t.Errorf("dad's folders after delete = %v", ids)
+	}
+	if f, _ := folders.Get(ctx, noel.ID.Hex(), mum); len(f.HouseholdIDs) != 0 || len(f.Collaborators) != 0 {
+		t.Errorf("Noël after delete = %+v", f)
+	}
+}
+
+// Un membre de deux foyers n'est inscrit qu'une fois, au titre du premier,
+// et garde l'accès par l'autre quand il quitte l'un
+func TestShareFolder_SeveralHouseholds(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("households"), db.Collection("folders"), db.Collection("users"))
+	folders := folder.NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	mum := newUser(t, db, "mum@example.com")
+	aunt := newUser(t, db, "aunt@example.com")
+	uncle := newUser(t, db, "uncle@example.com")
+
+	home, _ := svc.Create(ctx, mum, "Maison")
+	cousins, _ := svc.Create(ctx, mum, "Cousins")
+	f, _ := folders.Create(ctx, mum, "Anniversaires", "", "", "private", "", nil, nil)
+	if _, err := folders.AddCollaborator(ctx, f.ID.Hex(), mum, "uncle@example.com", "viewer"); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range []*Household{home, cousins} {
+		join(t, svc, h.ID.Hex(), mum, "aunt@example.com", aunt, RoleViewer)
+		join(t, svc, h.ID.Hex(), mum, "uncle@example.com", uncle, RoleEditor)
+		if _, err := svc.ShareFolder(ctx, h.ID.Hex(), f.ID.Hex(), mum); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, _ := folders.Get(ctx, f.ID.Hex(), mum); len(got.Collaborators) != 2 {
+		t.Fatalf("collaborators = %+v, want uncle and aunt once", got.Collaborators)
+	}
+	if c := collaborator(t, folders, f.ID.Hex(), mum, aunt); c == nil || c.HouseholdID != home.ID.Hex() || c.Role != "viewer" {
+		t.Errorf("aunt = %+v, want a viewer from Maison", c)
+	}
+	// Le collaborateur direct garde son entrée et son rôle
+	if c := collaborator(t, folders, f.ID.Hex(), mum, uncle); c == nil || c.HouseholdID != "" || c.Role != "viewer" {
+		t.Errorf("uncle = %+v, want a direct viewer", c)
+	}
+
+	// Le rôle suit le foyer qui donne l'accès
+	if _, err := svc.UpdateMember(ctx, cousins.ID.Hex(), mum, aunt, RoleEditor); err != nil {
+		t.Fatal(err)
+	}
+	if c := collaborator(t, folders, f.ID.Hex(), mum, aunt); c == nil || c.Role != "viewer" {
+		t.Errorf("aunt after promotion in Cousins = %+v, want still a viewer from Maison", c)
+	}
+	if _, err := svc.UpdateMember(ctx, home.ID.Hex(), mum, aunt, RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if c := collaborator(t, folders, f.ID.Hex(), mum, aunt); c == nil || c.Role != "editor" {
+		t.Errorf("aunt after promotion in Maison = %+v, want an editor", c)
+	}
+
+	if _, err := svc.RemoveMember(ctx, home.ID.Hex(), mum, aunt); err != nil {
+		t.Fatal(err)
+	}
+	if c := collaborator(t, folders, f.ID.Hex(), mum, aunt); c == nil || c.HouseholdID != cousins.ID.Hex() || c.Role != "editor" {
+		t.Errorf("aunt after leaving Maison = %+v", c)
+	}
+	if got, _ := folders.Get(ctx, f.ID.Hex(), mum); len(got.Collaborators) != 2 {
+		t.Errorf("collaborators after leaving Maison = %+v", got.Collaborators)
+	}
+}
+
+// Les collaborateurs ajoutés pendant une mise à jour du foyer ne sont pas
+// perdus
+func TestShareFolder_ConcurrentCollaborators(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("households"), db.Collection("folders"), db.Collection("users"))
+	folders := folder.NewService(db.Collection("folders"), db.Collection("links"), db.Collection("users"), "")
+	mum := newUser(t, db, "mum@example.com")
+	h, _ := svc.Create(ctx, mum, "Maison")
+	f, _ := folders.Create(ctx, mum, "Vacances", "", "", "private", "", nil, nil)
+	if _, err := svc.ShareFolder(ctx, h.ID.Hex(), f.ID.Hex(), mum); err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 5
+	members := make([]string, n)
+	friends := make([]string, n)
+	for i := range n {
+		members[i] = newUser(t, db, fmt.Sprintf("member%d@example.com", i))
+		friends[i] = newUser(t, db, fmt.Sprintf("friend%d@example.com", i))
+	}
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := svc.AddMember(ctx, h.ID.Hex(), mum, fmt.Sprintf("member%d@example.com", i), RoleEditor); err != nil {
+				t.Errorf("add member %d: %v", i, err)
+				return
+			}
+			if _, err := svc.AcceptInvite(ctx, h.ID.Hex(), members[i]); err != nil {
+				t.Errorf("accept member %d: %v", i, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := folders.AddCollaborator(ctx, f.ID.Hex(), mum, fmt.Sprintf("friend%d@example.com", i), "viewer"); err != nil {
+				t.Errorf("add collaborator %d: %v", i, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, id := range append(members, friends...) {
+		if collaborator(t, folders, f.ID.Hex(), mum, id) == nil {
+			t.Errorf("collaborator %s lost", id)
+		}
+	}
+}
+
+// Les admins et editors d'un foyer partagent leurs enfants ; pas les viewers
+func TestFamily_SharesChildren(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("households"), db.Collection("folders"), db.Collection("users"))
+	children := child.NewService(db)
+	mum := newUser(t, db, "mum@example.com")
+	dad := newUser(t, db, "dad@example.com")
+	granny := newUser(t, db, "granny@example.com")
+	mumID, _ := primitive.ObjectIDFromHex(mum)
+	dadID, _ := primitive.ObjectIDFromHex(dad)
+	grannyID, _ := primitive.ObjectIDFromHex(granny)
+
+	lea, err := children.Create(ctx, mumID, "Léa", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := svc.Create(ctx, mum, "Maison")
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), mum, "dad@example.com", RoleEditor); err != nil {
+		t.Fatal(err)
+	}
+	// L'invité ne partage rien avant d'accepter
+	if kids, _ := children.List(ctx, dadID); len(kids) != 0 {
+		t.Errorf("invited dad's children = %v, want none", kids)
+	}
+	if _, err := children.Update(ctx, lea.ID, dadID, "Léa-Rose", lea.BirthDate); err == nil {
+		t.Error("invited dad updated Léa")
+	}
+	if family, _ := child.FamilyIDs(ctx, db, mumID); len(family) != 0 {
+		t.Errorf("mum's family with dad invited = %v, want none", family)
+	}
+	if _, err := svc.AcceptInvite(ctx, h.ID.Hex(), dad); err != nil {
+		t.Fatal(err)
+	}
+	join(t, svc, h.ID.Hex(), mum, "granny@example.com", granny, RoleViewer)
+
+	if kids, err := children.List(ctx, dadID); err != nil || len(kids) != 1 || kids[0].ID != lea.ID {
+		t.Errorf("dad's children = %v, %v; want Léa", kids, err)
+	}
+	if _, err := children.Update(ctx, lea.ID, dadID, "Léa-Rose", lea.BirthDate); err != nil {
+		t.Errorf("dad updates Léa: %v", err)
+	}
+	if kids, _ := children.List(ctx, grannyID); len(kids) != 0 {
+		t.Errorf("granny's children = %v, want none", kids)
+	}
+	if family, err := child.FamilyIDs(ctx, db, mumID); err != nil || len(family) != 1 || family[0] != dadID {
+		t.Errorf("mum's family = %v, %v; want dad", family, err)
+	}
+
+	// Passé viewer, ou hors du foyer, il n'y a plus accès
+	if _, err := svc.UpdateMember(ctx, h.ID.Hex(), mum, dad, RoleViewer); err != nil {
+		t.Fatal(err)
+	}
+	if kids, _ := children.List(ctx, dadID); len(kids) != 0 {
+		t.Errorf("viewer dad's children = %v, want none", kids)
+	}
+	if _, err := svc.UpdateMember(ctx, h.ID.Hex(), mum, dad, RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RemoveMember(ctx, h.ID.Hex(), dad, dad); err != nil {
+		t.Fatal(err)
+	}
+	if kids, _ := children.List(ctx, dadID); len(kids) != 0 {
+		t.Errorf("dad's children after leaving = %v, want none", kids)
+	}
+	if kids, _ := children.List(ctx, mumID); len(kids) != 1 || kids[0].Name != "Léa-Rose" {
+		t.Errorf("mum's children = %v", kids)
+	}
+}
+
+// Une invitation venue d'un inconnu ne lui donne aucun accès aux enfants de
+// l'invité tant qu'elle n'est pas acceptée, et peut être refusée
+func TestFamily_PendingInviteGrantsNothing(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("households"), db.Collection("folders"), db.Collection("users"))
+	children := child.NewService(db)
+	mum := newUser(t, db, "mum@example.com")
+	stranger := newUser(t, db, "stranger@example.com")
+	mumID, _ := primitive.ObjectIDFromHex(mum)
+	strangerID, _ := primitive.ObjectIDFromHex(stranger)
+
+	lea, err := children.Create(ctx, mumID, "Léa", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := svc.Create(ctx, stranger, "Inconnus")
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), stranger, "mum@example.com", RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+
+	if kids, _ := children.List(ctx, strangerID); len(kids) != 0 {
+		t.Errorf("stranger's children = %v, want none", kids)
+	}
+	if _, err := children.Update(ctx, lea.ID, strangerID, "X", lea.BirthDate); err == nil {
+		t.Error("stranger updated Léa")
+	}
+	if family, _ := child.FamilyIDs(ctx, db, strangerID); len(family) != 0 {
+		t.Errorf("stranger's family = %v, want none", family)
+	}
+
+	if err := svc.DeclineInvite(ctx, h.ID.Hex(), mum); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AcceptInvite(ctx, h.ID.Hex(), mum); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("accept declined invite err = %v, want ErrInviteNotFound", err)
+	}
+	if invites, _ := svc.ListInvites(ctx, mum); len(invites) != 0 {
+		t.Errorf("mum's invites after decline = %+v", invites)
+	}
+
+	// Un admin annule une invitation par RemoveMember
+	if _, err := svc.AddMember(ctx, h.ID.Hex(), stranger, "mum@example.com", RoleEditor); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := svc.RemoveMember(ctx, h.ID.Hex(), stranger, mum); err != nil || len(got.Invites) != 0 {
+		t.Errorf("cancel invite = %+v, %v", got, err)
+	}
+}
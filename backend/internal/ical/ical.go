@@ -0,0 +1,164 @@
+// Package ical écrit des calendriers iCalendar (RFC 5545) pour l'export des
+// menus et des événements vers les agendas des utilisateurs.
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Calendar est un VCALENDAR
+type Calendar struct {
+	Name   string // X-WR-CALNAME, affiché par la plupart des agendas
+	Events []Event
+	// RefreshInterval est la fréquence de mise à jour conseillée aux agendas
+	// abonnés au calendrier ; zéro pour un export ponctuel
+	RefreshInterval time.Duration
+}
+
+// Event est un VEVENT. Un événement AllDay n'utilise que la date de Start et
+// End (exclue). Un événement Floating est à l'heure locale de l'agenda qui
+// l'affiche ; sinon les heures sont écrites en UTC.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Floating    bool
+	Updated     time.Time // DTSTAMP ; l'heure d'écriture si zéro
+	// Alarms sont les rappels, chacun à une durée avant Start
+	Alarms []time.Duration
+}
+
+const prodID = "-//Tribbae//Tribbae//FR"
+
+// Write écrit le calendrier au format iCalendar
+func (c *Calendar) Write(w io.Writer) error {
+	lw := &lineWriter{w: w}
+	lw.line("BEGIN:VCALENDAR")
+	lw.line("VERSION:2.0")
+	lw.line("PRODID:" + prodID)
+	lw.line("CALSCALE:GREGORIAN")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME:" + Escape(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		lw.line("REFRESH-INTERVAL;VALUE=DURATION:" + Duration(c.RefreshInterval))
+		lw.line("X-PUBLISHED-TTL:" + Duration(c.RefreshInterval))
+	}
+	now := time.Now()
+	for _, e := range c.Events {
+		lw.line("BEGIN:VEVENT")
+		lw.line("UID:" + e.UID)
+		stamp := e.Updated
+		if stamp.IsZero() {
+			stamp = now
+		}
+		lw.line("DTSTAMP:" + utcTime(stamp))
+		switch {
+		case e.AllDay:
+			lw.line("DTSTART;VALUE=DATE:" + e.Start.Format("20060102"))
+			if !e.End.IsZero() {
+				lw.line("DTEND;VALUE=DATE:" + e.End.Format("20060102"))
+			}
+		case e.Floating:
+			lw.line("DTSTART:" + e.Start.Format("20060102T150405"))
+			if !e.End.IsZero() {
+				lw.line("DTEND:" + e.End.Format("20060102T150405"))
+			}
+		default:
+			lw.line("DTSTART:" + utcTime(e.Start))
+			if !e.End.IsZero() {
+				lw.line("DTEND:" + utcTime(e.End))
+			}
+		}
+		lw.line("SUMMARY:" + Escape(e.Summary))
+		if e.Description != "" {
+			lw.line("DESCRIPTION:" + Escape(e.Description))
+		}
+		if e.Location != "" {
+			lw.line("LOCATION:" + Escape(e.Location))
+		}
+		if e.URL != "" {
+			lw.line("URL:" + e.URL)
+		}
+		for _, before := range e.Alarms {
+			lw.line("BEGIN:VALARM")
+			lw.line("ACTION:DISPLAY")
+			lw.line("DESCRIPTION:" + Escape(e.Summary))
+			lw.line("TRIGGER:-" + Duration(before))
+			lw.line("END:VALARM")
+		}
+		lw.line("END:VEVENT")
+	}
+	lw.line("END:VCALENDAR")
+	return lw.err
+}
+
+// Escape protège un texte pour une valeur de type TEXT
+func Escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "").Replace(s)
+}
+
+// Duration écrit une durée positive au format iCalendar (P1D, PT1H30M…),
+// à la minute près
+func Duration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	out := "P"
+	if days > 0 {
+		out += fmt.Sprintf("%dD", days)
+	}
+	if d > 0 || days == 0 {
+		out += "T"
+		if h := d / time.Hour; h > 0 {
+			out += fmt.Sprintf("%dH", h)
+		}
+		if m := (d % time.Hour) / time.Minute; m > 0 || d < time.Hour {
+			out += fmt.Sprintf("%dM", m)
+		}
+	}
+	return out
+}
+
+func utcTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// lineWriter termine les lignes par CRLF et les plie à 75 octets sans couper
+// de caractère UTF-8
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (lw *lineWriter) line(s string) {
+	if lw.err != nil {
+		return
+	}
+	const max = 75
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := utf8.RuneLen(r)
+		if width+size > max {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, lw.err = io.WriteString(lw.w, b.String())
+}
@@ -0,0 +1,111 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	cal := &Calendar{
+		Name: "Menus",
+		Events: []Event{
+			{
+				UID:         "a@tribbae",
+				Summary:     "Dîner : crêpes, salade; dessert",
+				Description: "Pour 4\nVoir la recette",
+				Start:       time.Date(2026, 10, 19, 19, 30, 0, 0, time.UTC),
+				End:         time.Date(2026, 10, 19, 20, 30, 0, 0, time.UTC),
+				Floating:    true,
+				Updated:     time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+			},
+			{UID: "b@tribbae", Summary: "Anniversaire", Start: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC), AllDay: true},
+			{UID: "c@tribbae", Summary: "Spectacle", Start: time.Date(2026, 12, 5, 15, 0, 0, 0, paris)},
+		},
+	}
+	var buf bytes.Buffer
+	if err := cal.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"X-WR-CALNAME:Menus\r\n",
+		"DTSTAMP:20261001T080000Z\r\n",
+		"DTSTART:20261019T193000\r\n",
+		`SUMMARY:Dîner : crêpes\, salade\; dessert` + "\r\n",
+		`DESCRIPTION:Pour 4\nVoir la recette` + "\r\n",
+		"DTSTART;VALUE=DATE:20261102\r\nDTEND;VALUE=DATE:20261103\r\n",
+		"DTSTART:20261205T140000Z\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in\n%s", want, out)
+		}
+	}
+}
+
+func TestWrite_FoldsLongLines(t *testing.T) {
+	cal := &Calendar{Events: []Event{{UID: "x", Summary: strings.Repeat("é", 60), Start: time.Now()}}}
+	var buf bytes.Buffer
+	if err := cal.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+	}
+	if !strings.Contains(buf.String(), "\r\n é") {
+		t.Error("long summary should be folded")
+	}
+}
+
+func TestWrite_Alarms(t *testing.T) {
+	cal := &Calendar{
+		Name:            "Événements",
+		RefreshInterval: time.Hour,
+		Events: []Event{{
+			UID:     "x@tribbae",
+			Summary: "Fête de l'école",
+			Start:   time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+			Alarms:  []time.Duration{24 * time.Hour},
+		}},
+	}
+	var buf bytes.Buffer
+	if err := cal.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"REFRESH-INTERVAL;VALUE=DURATION:PT1H\r\nX-PUBLISHED-TTL:PT1H\r\n",
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\nDESCRIPTION:Fête de l'école\r\nTRIGGER:-P1D\r\nEND:VALARM\r\nEND:VEVENT\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in\n%s", want, out)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0M"},
+		{15 * time.Minute, "PT15M"},
+		{2 * time.Hour, "PT2H"},
+		{90 * time.Minute, "PT1H30M"},
+		{24 * time.Hour, "P1D"},
+		{26 * time.Hour, "P1DT2H"},
+		{-7 * 24 * time.Hour, "P7D"},
+	}
+	for _, tt := range tests {
+		if got := Duration(tt.in); got != tt.want {
+			t.Errorf("Duration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
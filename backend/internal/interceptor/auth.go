@@ -26,10 +26,15 @@ var publicMethods = map[string]bool{
 	"/tribbae.v1.AuthService/Login":                  true,
 	"/tribbae.v1.AuthService/RefreshToken":           true,
 	"/tribbae.v1.FolderService/GetSharedFolder":      true,
+	"/tribbae.v1.FolderService/GetSharedChild":       true,
 	"/tribbae.v1.FolderService/ListCommunityFolders": true,
 	"/tribbae.v1.FolderService/ListTopFolders":       true,
 	"/tribbae.v1.LinkService/ListCommunityLinks":     true,
 	"/tribbae.v1.LinkService/ListNewLinks":            true,
+	// Les réservations de cadeaux acceptent les visiteurs du lien de partage
+	"/tribbae.v1.GiftService/ClaimGift":      true,
+	"/tribbae.v1.GiftService/UnclaimGift":    true,
+	"/tribbae.v1.GiftService/ListGiftClaims": true,
 }
 
 // UnaryAuth vérifie le token JWT pour les méthodes protégées.
@@ -63,7 +68,7 @@ func authenticate(ctx context.Context, validator TokenValidator) (context.Contex
 	if err != nil {
 		return ctx, status.Errorf(codes.Unauthenticated, "invalid token")
 	}
-	return context.WithValue(ctx, userIDKey, userID), nil
+	return ContextWithUserID(ctx, userID), nil
 }
 
 // tryAuthenticate tente d'extraire le userID sans retourner d'erreur si absent/invalide
@@ -81,7 +86,13 @@ func tryAuthenticate(ctx context.Context, validator TokenValidator) (context.Con
 	if err != nil {
 		return ctx, nil
 	}
-	return context.WithValue(ctx, userIDKey, userID), nil
+	return ContextWithUserID(ctx, userID), nil
+}
+
+// ContextWithUserID retourne ctx authentifié pour userID, comme après la
+// validation du token
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
 }
 
 func UserIDFromContext(ctx context.Context) (string, error) {
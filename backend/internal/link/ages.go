@@ -0,0 +1,149 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tribbae/backend/internal/agerange"
+	"github.com/tribbae/backend/internal/child"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultRecommendLimit = 20
+	maxRecommendLimit     = 100
+)
+
+// ErrChildNotFound est retourné quand l'enfant n'existe pas ou n'appartient
+// pas à l'utilisateur
+var ErrChildNotFound = errors.New("child not found")
+
+// parseAges retourne la tranche d'âge lue dans AgeRange, nil si elle n'est
+// pas reconnue
+func parseAges(ageRange string) *agerange.Range {
+	r, ok := agerange.Parse(ageRange)
+	if !ok {
+		return nil
+	}
+	return &r
+}
+
+// ageRangeFields retourne l'âge conseillé et sa forme analysée, à écrire ensemble
+func (l *Link) ageRangeFields() bson.M {
+	return bson.M{"age_range": l.AgeRange, "ages": parseAges(l.AgeRange)}
+}
+
+// agesFilter retient les liens adaptés à au moins un des âges, en mois. Les
+// liens sans âge conseillé reconnu conviennent à tous.
+func agesFilter(months []int32) bson.M {
+	or := bson.A{bson.M{"ages": nil}}
+	for _, m := range months {
+		or = append(or, bson.M{"ages.min_months": bson.M{"$lte": m}, "ages.max_months": bson.M{"$gte": m}})
+	}
+	return bson.M{"$or": or}
+}
+
+// childrenAges retourne l'âge actuel, en mois, des enfants de l'utilisateur,
+// y compris ceux qu'un co-parent ou son foyer partage avec lui
+func (s *Service) childrenAges(ctx context.Context, userID string, now time.Time) ([]int32, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, nil
+	}
+	filter, err := child.FamilyAccessFilter(ctx, s.childCol.Database(), oid)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.childCol.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var children []*child.Child
+	if err := cursor.All(ctx, &children); err != nil {
+		return nil, err
+	}
+	ages := make([]int32, 0, len(children))
+	for _, c := range children {
+		ages = append(ages, agerange.AgeMonths(c.Birth(), now))
+	}
+	return ages, nil
+}
+
+// RecommendForChild retourne les liens de la communauté adaptés à l'âge
+// actuel, en mois, d'un enfant de l'utilisateur, les mieux notés d'abord.
+// Les liens sans âge conseillé et ceux de l'utilisateur sont écartés.
+func (s *Service) RecommendForChild(ctx context.Context, userID, childID, category string, limit int32) ([]*Link, int32, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, 0, ErrChildNotFound
+	}
+	cid, err := primitive.ObjectIDFromHex(childID)
+	if err != nil {
+		return nil, 0, ErrChildNotFound
+	}
+	mine, err := child.FamilyAccessFilter(ctx, s.childCol.Database(), oid)
+	if err != nil {
+		return nil, 0, err
+	}
+	mine["_id"] = cid
+	var c child.Child
+	if err := s.childCol.FindOne(ctx, mine).Decode(&c); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, 0, ErrChildNotFound
+		}
+		return nil, 0, err
+	}
+	if limit <= 0 {
+		limit = defaultRecommendLimit
+	}
+	limit = min(limit, maxRecommendLimit)
+
+	months := agerange.AgeMonths(c.Birth(), time.Now())
+	filter := bson.M{
+		"visibility":      "public",
+		"deleted_at":      nil,
+		"owner_id":        bson.M{"$ne": userID},
+		"ages.min_months": bson.M{"$lte": months},
+		"ages.max_months": bson.M{"$gte": months},
+	}
+	if category != "" {
+		filter["category"] = category
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "rating", Value: -1}, {Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	links := []*Link{}
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, 0, err
+	}
+	return links, months, nil
+}
+
+// BackfillAges analyse l'âge conseillé des liens créés avant l'ajout de ages
+func (s *Service) BackfillAges(ctx context.Context) error {
+	filter := bson.M{"age_range": bson.M{"$nin": bson.A{"", nil}}, "ages": bson.M{"$exists": false}}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "age_range": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l Link
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		// Une tranche non reconnue est enregistrée à null pour ne pas être relue
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": bson.M{"ages": parseAges(l.AgeRange)}}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,92 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Le filtre « adapté à mes enfants » et les recommandations comparent la
+// tranche d'âge analysée à l'âge actuel des enfants enregistrés
+func TestAges_ForMyKidsAndRecommend(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	userOID := primitive.NewObjectID()
+	userID := userOID.Hex()
+	strangerID := primitive.NewObjectID().Hex()
+
+	// Un enfant de 4 ans et demi
+	now := time.Now().UTC()
+	birth := time.Date(now.Year()-4, now.Month()-6, 1, 0, 0, 0, 0, time.UTC)
+	kid, err := child.NewService(db).Create(ctx, userOID, "Léa", birth.UnixMilli())
+	if err != nil {
+		t.Fatalf("create child: %v", err)
+	}
+
+	create := func(ownerID, title, ageRange, visibility string, rating int32) *Link {
+		l, err := svc.Create(ctx, ownerID, &Link{Title: title, AgeRange: ageRange, Visibility: visibility, Rating: rating})
+		if err != nil {
+			t.Fatalf("create %s: %v", title, err)
+		}
+		return l
+	}
+	puzzle := create(userID, "Puzzle", "3-6 ans", "", 0)
+	create(userID, "Hochet", "0-12 mois", "", 0)
+	create(userID, "Parc", "", "", 0)
+	create(strangerID, "Vélo", "dès 4 ans", "public", 3)
+	create(strangerID, "Pâte à sel", "3-6 ans", "public", 5)
+	create(strangerID, "Escape game", "12 ans et +", "public", 5)
+	create(strangerID, "Tablier", "3-6 ans", "private", 5)
+	if puzzle.Ages == nil || puzzle.Ages.MinMonths != 36 || puzzle.Ages.MaxMonths != 83 {
+		t.Fatalf("puzzle ages = %+v", puzzle.Ages)
+	}
+
+	links, _, err := svc.List(ctx, userID, ListOptions{ForMyKids: true, SortBy: SortByTitle})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if titles := linkTitles(links); len(titles) != 2 || titles[0] != "Parc" || titles[1] != "Puzzle" {
+		t.Errorf("for my kids = %v, want [Parc Puzzle]", titles)
+	}
+
+	recs, months, err := svc.RecommendForChild(ctx, userID, kid.ID.Hex(), "", 0)
+	if err != nil {
+		t.Fatalf("recommend: %v", err)
+	}
+	if months != 54 {
+		t.Errorf("age = %d months, want 54", months)
+	}
+	if titles := linkTitles(recs); len(titles) != 2 || titles[0] != "Pâte à sel" || titles[1] != "Vélo" {
+		t.Errorf("recommendations = %v, want [Pâte à sel Vélo]", titles)
+	}
+
+	// L'âge modifié est analysé à nouveau
+	puzzle.AgeRange = "moins de 3 ans"
+	if _, err := svc.Update(ctx, puzzle.ID.Hex(), userID, puzzle, []string{"age_range"}, ""); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	links, _, _ = svc.List(ctx, userID, ListOptions{ForMyKids: true})
+	if titles := linkTitles(links); len(titles) != 1 || titles[0] != "Parc" {
+		t.Errorf("after update = %v, want [Parc]", titles)
+	}
+
+	// L'enfant d'un autre utilisateur est introuvable
+	if _, _, err := svc.RecommendForChild(ctx, strangerID, kid.ID.Hex(), "", 0); !errors.Is(err, ErrChildNotFound) {
+		t.Errorf("stranger err = %v, want ErrChildNotFound", err)
+	}
+}
+
+func linkTitles(links []*Link) []string {
+	titles := make([]string, 0, len(links))
+	for _, l := range links {
+		titles = append(titles, l.Title)
+	}
+	return titles
+}
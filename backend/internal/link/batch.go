@@ -0,0 +1,219 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/tribbae/backend/internal/etag"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MaxBatchSize limite le nombre de liens d'une opération groupée
+const MaxBatchSize = 500
+
+var (
+	ErrBatchTooLarge    = errors.New("too many links in batch")
+	ErrLinkNotFound     = errors.New("link not found")
+	ErrNotAuthorized    = errors.New("not authorized")
+	ErrInvalidFolder    = errors.New("target folder not found or not editable")
+	ErrInvalidBatchEdit = errors.New("invalid batch change")
+	// ErrNotApplied marque les éléments d'un lot atomique annulé à cause d'un autre élément
+	ErrNotApplied = errors.New("not applied: another link in the atomic batch failed")
+)
+
+// BatchResult est le résultat d'une opération groupée pour un lien
+type BatchResult struct {
+	LinkID string
+	Err    error
+}
+
+// BatchChange décrit une modification appliquée à tous les liens d'un lot
+type BatchChange struct {
+	AddTags    []string
+	RemoveTags []string
+	Visibility string // vide : inchangée
+}
+
+// batchOp applique l'opération à un lien déjà chargé et dont l'utilisateur
+// peut éditer le dossier ; elle retourne les dossiers dont le texte de
+// recherche est à recalculer.
+type batchOp func(ctx context.Context, l *Link) ([]string, error)
+
+// BatchUpdate ajoute ou retire des tags et change la visibilité de plusieurs liens
+func (s *Service) BatchUpdate(ctx context.Context, userID string, linkIDs []string, change BatchChange, atomic bool) ([]BatchResult, error) {
+	if change.Visibility != "" && change.Visibility != "private" && change.Visibility != "public" {
+		return nil, ErrInvalidBatchEdit
+	}
+	if len(change.AddTags) == 0 && len(change.RemoveTags) == 0 && change.Visibility == "" {
+		return nil, ErrInvalidBatchEdit
+	}
+	return s.runBatch(ctx, userID, linkIDs, atomic, func(ctx context.Context, l *Link) ([]string, error) {
+		tags := make([]string, 0, len(l.Tags)+len(change.AddTags))
+		for _, t := range l.Tags {
+			if !slices.Contains(change.RemoveTags, t) {
+				tags = append(tags, t)
+			}
+		}
+		for _, t := range change.AddTags {
+			if !slices.Contains(tags, t) {
+				tags = append(tags, t)
+			}
+		}
+		set := bson.M{"tags": tags}
+		if change.Visibility != "" {
+			set["visibility"] = change.Visibility
+		}
+		if err := s.updateVersioned(ctx, l, set); err != nil {
+			return nil, err
+		}
+		return []string{l.FolderID}, nil
+	})
+}
+
+// BatchMove déplace plusieurs liens dans le dossier targetFolderID
+func (s *Service) BatchMove(ctx context.Context, userID string, linkIDs []string, targetFolderID string, atomic bool) ([]BatchResult, error) {
+	if !s.canEditFolder(ctx, targetFolderID, userID) {
+		return nil, ErrInvalidFolder
+	}
+	if s.folderFrozen(ctx, targetFolderID) {
+		return nil, ErrFolderFrozen
+	}
+	return s.runBatch(ctx, userID, linkIDs, atomic, func(ctx context.Context, l *Link) ([]string, error) {
+		if l.FolderID == targetFolderID {
+			return nil, nil
+		}
+		if err := s.updateVersioned(ctx, l, bson.M{"folder_id": targetFolderID}); err != nil {
+			return nil, err
+		}
+		return []string{l.FolderID, targetFolderID}, nil
+	})
+}
+
+// BatchDelete place plusieurs liens dans la corbeille
+func (s *Service) BatchDelete(ctx context.Context, userID string, linkIDs []string, atomic bool) ([]BatchResult, error) {
+	return s.runBatch(ctx, userID, linkIDs, atomic, func(ctx context.Context, l *Link) ([]string, error) {
+		if err := s.updateVersioned(ctx, l, bson.M{"deleted_at": time.Now()}); err != nil {
+			return nil, err
+		}
+		return []string{l.FolderID}, nil
+	})
+}
+
+// updateVersioned écrit set sur le lien s'il n'a pas changé depuis son chargement
+func (s *Service) updateVersioned(ctx context.Context, l *Link, set bson.M) error {
+	filter, err := etag.Filter(etag.Format(l.Version))
+	if err != nil {
+		return err
+	}
+	filter["_id"] = l.ID
+	filter["deleted_at"] = nil
+	set["updated_at"] = time.Now()
+	res, err := s.col.UpdateOne(ctx, filter, bson.M{"$set": set, "$inc": bson.M{"version": 1}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return etag.ErrMismatch
+	}
+	return nil
+}
+
+// runBatch applique op à chaque lien après vérification des droits. En mode
+// atomique, tout se fait dans une transaction annulée au premier échec ; sinon
+// chaque lien est traité indépendamment.
+func (s *Service) runBatch(ctx context.Context, userID string, linkIDs []string, atomic bool, op batchOp) ([]BatchResult, error) {
+	if len(linkIDs) > MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+
+	var touched []string
+	apply := func(ctx context.Context) ([]BatchResult, bool) {
+		touched = touched[:0]
+		results := make([]BatchResult, len(linkIDs))
+		failed := false
+		for i, id := range linkIDs {
+			results[i].LinkID = id
+			folders, err := s.applyOne(ctx, userID, id, op)
+			if err != nil {
+				results[i].Err = err
+				failed = true
+				if atomic {
+					for j := i + 1; j < len(linkIDs); j++ {
+						results[j] = BatchResult{LinkID: linkIDs[j], Err: ErrNotApplied}
+					}
+					break
+				}
+				continue
+			}
+			touched = append(touched, folders...)
+		}
+		return results, failed
+	}
+
+	var results []BatchResult
+	if !atomic {
+		results, _ = apply(ctx)
+	} else {
+		session, err := s.col.Database().Client().StartSession()
+		if err != nil {
+			return nil, err
+		}
+		defer session.EndSession(ctx)
+
+		errRollback := errors.New("rollback")
+		_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+			var failed bool
+			results, failed = apply(sc)
+			if failed {
+				return nil, errRollback
+			}
+			return nil, nil
+		})
+		if errors.Is(err, errRollback) {
+			// Les éléments qui avaient réussi ont été annulés avec la transaction
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = ErrNotApplied
+				}
+			}
+			touched = nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, folderID := range touched {
+		if folderID != "" && !seen[folderID] {
+			seen[folderID] = true
+			s.markFolderSearchText(ctx, folderID)
+		}
+	}
+	return results, nil
+}
+
+// applyOne charge un lien, vérifie que l'utilisateur peut le modifier et applique op
+func (s *Service) applyOne(ctx context.Context, userID, linkID string, op batchOp) ([]string, error) {
+	id, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	var l Link
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&l); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	if l.OwnerID != userID && !s.canEditFolder(ctx, l.FolderID, userID) {
+		return nil, ErrNotAuthorized
+	}
+	if l.FolderID != "" && s.folderFrozen(ctx, l.FolderID) {
+		return nil, ErrFolderFrozen
+	}
+	return op(ctx, &l)
+}
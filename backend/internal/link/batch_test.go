@@ -0,0 +1,86 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Chaque lien a son propre résultat : ceux d'un autre utilisateur sont refusés
+// sans empêcher la modification des autres
+func TestBatch_PerItemPermissions(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	foldersCol := db.Collection("folders")
+	svc := NewService(db.Collection("links"), foldersCol)
+
+	ownerID := primitive.NewObjectID().Hex()
+	strangerID := primitive.NewObjectID().Hex()
+	target := primitive.NewObjectID()
+	if _, err := foldersCol.InsertOne(ctx, bson.M{
+		"_id": target, "owner_id": ownerID, "name": "Vacances",
+		"visibility": "private", "created_at": time.Now(), "updated_at": time.Now(),
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+
+	mine, err := svc.Create(ctx, ownerID, &Link{Title: "Plage", Tags: []string{"ete", "old"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	theirs, err := svc.Create(ctx, strangerID, &Link{Title: "Secret"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	ids := []string{mine.ID.Hex(), theirs.ID.Hex(), primitive.NewObjectID().Hex()}
+
+	results, err := svc.BatchUpdate(ctx, ownerID, ids, BatchChange{AddTags: []string{"mer"}, RemoveTags: []string{"old"}}, false)
+	if err != nil {
+		t.Fatalf("batch update: %v", err)
+	}
+	if results[0].Err != nil || !errors.Is(results[1].Err, ErrNotAuthorized) || !errors.Is(results[2].Err, ErrLinkNotFound) {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	updated, _ := svc.Get(ctx, mine.ID.Hex(), ownerID)
+	if len(updated.Tags) != 2 || updated.Tags[0] != "ete" || updated.Tags[1] != "mer" {
+		t.Errorf("tags = %v, want [ete mer]", updated.Tags)
+	}
+
+	results, err = svc.BatchMove(ctx, ownerID, ids[:2], target.Hex(), false)
+	if err != nil {
+		t.Fatalf("batch move: %v", err)
+	}
+	if results[0].Err != nil || results[1].Err == nil {
+		t.Fatalf("unexpected move results: %+v", results)
+	}
+	moved, _ := svc.Get(ctx, mine.ID.Hex(), ownerID)
+	if moved.FolderID != target.Hex() {
+		t.Errorf("folder = %q, want %q", moved.FolderID, target.Hex())
+	}
+
+	if _, err := svc.BatchMove(ctx, strangerID, ids[1:2], target.Hex(), false); !errors.Is(err, ErrInvalidFolder) {
+		t.Errorf("moving into someone else's folder: err = %v, want ErrInvalidFolder", err)
+	}
+
+	results, err = svc.BatchDelete(ctx, ownerID, ids[:1], false)
+	if err != nil || results[0].Err != nil {
+		t.Fatalf("batch delete: %v %+v", err, results)
+	}
+	if _, err := svc.Get(ctx, mine.ID.Hex(), ownerID); err == nil {
+		t.Error("deleted link should be in the trash")
+	}
+}
+
+func TestBatch_TooLarge(t *testing.T) {
+	svc := &Service{}
+	ids := make([]string, MaxBatchSize+1)
+	if _, err := svc.BatchDelete(context.Background(), "user", ids, false); !errors.Is(err, ErrBatchTooLarge) {
+		t.Errorf("err = %v, want ErrBatchTooLarge", err)
+	}
+}
@@ -0,0 +1,98 @@
+package link
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tribbae/backend/internal/price"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BudgetTotal additionne les prix des liens d'un dossier dans une devise
+type BudgetTotal struct {
+	Currency  string
+	Total     int64 // en centimes
+	Spent     int64 // liens achetés
+	Planned   int64 // liens pas encore achetés
+	Count     int32
+	Purchased int32
+}
+
+// FolderBudget est le budget d'un dossier, une ligne par devise
+type FolderBudget struct {
+	Totals   []BudgetTotal // la devise la plus utilisée d'abord
+	Unpriced int32         // liens sans prix reconnu
+}
+
+// parsePrice retourne le montant lu dans Price, nil s'il n'est pas reconnu
+func parsePrice(s string) *price.Amount {
+	a, ok := price.Parse(s)
+	if !ok {
+		return nil
+	}
+	return &a
+}
+
+// FolderBudget retourne le budget d'un dossier visible par l'utilisateur :
+// total, déjà dépensé et reste à acheter
+func (s *Service) FolderBudget(ctx context.Context, folderID, userID string) (*FolderBudget, error) {
+	if !s.canViewFolder(ctx, folderID, userID) {
+		return nil, ErrNotAuthorized
+	}
+	opts := options.Find().SetProjection(bson.M{"price_amount": 1, "purchased": 1})
+	cursor, err := s.col.Find(ctx, bson.M{"folder_id": folderID, "deleted_at": nil}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var links []*Link
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, err
+	}
+
+	b := &FolderBudget{Totals: []BudgetTotal{}}
+	for _, l := range links {
+		if l.PriceAmount == nil {
+			b.Unpriced++
+			continue
+		}
+		i := slices.IndexFunc(b.Totals, func(t BudgetTotal) bool { return t.Currency == l.PriceAmount.Currency })
+		if i < 0 {
+			b.Totals = append(b.Totals, BudgetTotal{Currency: l.PriceAmount.Currency})
+			i = len(b.Totals) - 1
+		}
+		t := &b.Totals[i]
+		t.Total += l.PriceAmount.Cents
+		t.Count++
+		if l.Purchased {
+			t.Spent += l.PriceAmount.Cents
+			t.Purchased++
+		} else {
+			t.Planned += l.PriceAmount.Cents
+		}
+	}
+	slices.SortStableFunc(b.Totals, func(a, c BudgetTotal) int { return int(c.Count - a.Count) })
+	return b, nil
+}
+
+// BackfillPriceAmounts analyse le prix des liens créés avant l'ajout de
+// price_amount
+func (s *Service) BackfillPriceAmounts(ctx context.Context) error {
+	filter := bson.M{"price": bson.M{"$nin": bson.A{"", nil}}, "price_amount": bson.M{"$exists": false}}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "price": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l Link
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		// Un prix non reconnu est enregistré à null pour ne pas être relu
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": bson.M{"price_amount": parsePrice(l.Price)}}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
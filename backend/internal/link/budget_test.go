@@ -0,0 +1,81 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Les prix analysés sont filtrables et additionnés par devise dans le budget
+// du dossier, en séparant le dépensé du reste à acheter
+func TestFolderBudget(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	foldersCol := db.Collection("folders")
+	svc := NewService(db.Collection("links"), foldersCol)
+
+	ownerID := primitive.NewObjectID().Hex()
+	strangerID := primitive.NewObjectID().Hex()
+	folder := primitive.NewObjectID()
+	if _, err := foldersCol.InsertOne(ctx, bson.M{
+		"_id": folder, "owner_id": ownerID, "name": "Noël",
+		"visibility": "private", "created_at": time.Now(), "updated_at": time.Now(),
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+
+	create := func(title, p string, purchased bool) *Link {
+		l, err := svc.Create(ctx, ownerID, &Link{FolderID: folder.Hex(), Title: title, Price: p, Purchased: purchased})
+		if err != nil {
+			t.Fatalf("create %s: %v", title, err)
+		}
+		return l
+	}
+	lego := create("Lego", "49,90 €", false)
+	create("Livre", "12€", true)
+	create("Concert", "gratuit", true)
+	create("Mug", "$15", false)
+	create("Surprise", "", false)
+	if lego.PriceAmount == nil || lego.PriceAmount.Cents != 4990 || lego.PriceAmount.Currency != "EUR" {
+		t.Fatalf("lego price = %+v", lego.PriceAmount)
+	}
+
+	links, _, err := svc.List(ctx, ownerID, ListOptions{FolderID: folder.Hex(), MinPrice: 1000, MaxPrice: 2000, SortBy: SortByTitle})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if titles := linkTitles(links); len(titles) != 2 || titles[0] != "Livre" || titles[1] != "Mug" {
+		t.Errorf("10-20 = %v, want [Livre Mug]", titles)
+	}
+	links, _, _ = svc.List(ctx, ownerID, ListOptions{FolderID: folder.Hex(), MaxPrice: 2000, Currency: "EUR", SortBy: SortByTitle})
+	if titles := linkTitles(links); len(titles) != 2 || titles[0] != "Concert" || titles[1] != "Livre" {
+		t.Errorf("≤ 20 EUR = %v, want [Concert Livre]", titles)
+	}
+
+	// Le cadeau acheté passe du prévu au dépensé
+	lego.Purchased = true
+	if _, err := svc.Update(ctx, lego.ID.Hex(), ownerID, lego, []string{"purchased"}, ""); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	b, err := svc.FolderBudget(ctx, folder.Hex(), ownerID)
+	if err != nil {
+		t.Fatalf("budget: %v", err)
+	}
+	want := []BudgetTotal{
+		{Currency: "EUR", Total: 6190, Spent: 6190, Planned: 0, Count: 3, Purchased: 3},
+		{Currency: "USD", Total: 1500, Spent: 0, Planned: 1500, Count: 1, Purchased: 0},
+	}
+	if len(b.Totals) != len(want) || b.Totals[0] != want[0] || b.Totals[1] != want[1] || b.Unpriced != 1 {
+		t.Errorf("budget = %+v, want %+v and 1 unpriced", b, want)
+	}
+
+	if _, err := svc.FolderBudget(ctx, folder.Hex(), strangerID); !errors.Is(err, ErrNotAuthorized) {
+		t.Errorf("stranger err = %v, want ErrNotAuthorized", err)
+	}
+}
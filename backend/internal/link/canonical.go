@@ -0,0 +1,157 @@
+package link
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// trackingParams sont les paramètres de suivi publicitaire retirés des URLs
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "dclid": true, "gbraid": true, "wbraid": true,
+	"msclkid": true, "yclid": true, "twclid": true, "igshid": true,
+	"mc_cid": true, "mc_eid": true, "_hsenc": true, "_hsmi": true,
+}
+
+func isTrackingParam(name string) bool {
+	name = strings.ToLower(name)
+	return trackingParams[name] || strings.HasPrefix(name, "utm_")
+}
+
+// NormalizeURL met le schéma et l'hôte en minuscules et retire les paramètres
+// de suivi (utm_*, fbclid…) en gardant l'ordre des autres paramètres.
+// Une URL illisible est retournée telle quelle.
+func NormalizeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if u.RawQuery != "" {
+		var kept []string
+		for _, pair := range strings.Split(u.RawQuery, "&") {
+			name, _, _ := strings.Cut(pair, "=")
+			if unescaped, err := url.QueryUnescape(name); err == nil {
+				name = unescaped
+			}
+			if pair != "" && !isTrackingParam(name) {
+				kept = append(kept, pair)
+			}
+		}
+		u.RawQuery = strings.Join(kept, "&")
+	}
+	return u.String()
+}
+
+// CanonicalKey retourne la clé qui identifie une même page quelle que soit la
+// façon dont son URL est écrite : sans schéma, sans "www.", sans port par
+// défaut, sans fragment ni "/" final, avec les paramètres triés.
+func CanonicalKey(raw string) string {
+	u, err := url.Parse(NormalizeURL(raw))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(raw)
+	}
+	host := strings.TrimPrefix(u.Hostname(), "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+	path := strings.TrimRight(u.EscapedPath(), "/")
+
+	key := host + path
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		sort.Strings(params)
+		key += "?" + strings.Join(params, "&")
+	}
+	return key
+}
+
+// canonicalKey retourne la clé de doublon d'un lien : celle de l'URL canonique
+// annoncée par la page si elle est connue, sinon celle de l'URL saisie
+func (l *Link) canonicalKey() string {
+	if l.CanonicalURL != "" {
+		return CanonicalKey(l.CanonicalURL)
+	}
+	if l.URL == "" {
+		return ""
+	}
+	return CanonicalKey(l.URL)
+}
+
+// urlFields retourne l'URL normalisée et les champs qui en dépendent, à écrire ensemble
+func (l *Link) urlFields() bson.M {
+	normalized := *l
+	normalized.URL = NormalizeURL(l.URL)
+	return bson.M{
+		"url":           normalized.URL,
+		"canonical_url": l.CanonicalURL,
+		"canonical_key": normalized.canonicalKey(),
+		"link_status":   nil, // à revérifier
+	}
+}
+
+// FindDuplicates retourne les liens accessibles à l'utilisateur qui pointent
+// déjà vers la même page que l. C'est un avertissement : un proche peut
+// vouloir ajouter à sa liste un produit déjà présent sur une liste partagée.
+// excludeID permet d'ignorer le lien en cours de modification.
+func (s *Service) FindDuplicates(ctx context.Context, userID string, l *Link, excludeID string) ([]string, error) {
+	key := l.canonicalKey()
+	if key == "" {
+		return nil, nil
+	}
+	folderIDs, err := s.AccessibleFolderIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	scope := bson.A{bson.M{"owner_id": userID}}
+	if len(folderIDs) > 0 {
+		scope = append(scope, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+	}
+	filter := bson.M{"canonical_key": key, "deleted_at": nil, "$or": scope}
+	if oid, err := primitive.ObjectIDFromHex(excludeID); err == nil {
+		filter["_id"] = bson.M{"$ne": oid}
+	}
+
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}).SetLimit(10))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var ids []string
+	for cursor.Next(ctx) {
+		var d struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&d); err == nil {
+			ids = append(ids, d.ID.Hex())
+		}
+	}
+	return ids, cursor.Err()
+}
+
+// BackfillCanonicalKeys calcule canonical_key pour les liens créés avant son ajout
+func (s *Service) BackfillCanonicalKeys(ctx context.Context) error {
+	filter := bson.M{"url": bson.M{"$nin": bson.A{"", nil}}, "canonical_key": bson.M{"$exists": false}}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "url": 1, "canonical_url": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l Link
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": bson.M{"canonical_key": l.canonicalKey()}}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,172 @@
+package link
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	cases := map[string]string{
+		"HTTPS://WWW.Example.COM/Produit?utm_source=fb&id=3&fbclid=abc": "https://www.example.com/Produit?id=3",
+		"https://example.com/p?UTM_Campaign=x":                          "https://example.com/p",
+		"https://example.com/p?b=2&a=1#avis":                            "https://example.com/p?b=2&a=1#avis",
+		"pas une url":                                                   "pas une url",
+	}
+	for in, want := range cases {
+		if got := NormalizeURL(in); got != want {
+			t.Errorf("NormalizeURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCanonicalKey_SamePage(t *testing.T) {
+	variants := []string{
+		"https://www.example.com/produit/42/?a=1&b=2",
+		"http://example.com/produit/42?b=2&a=1&utm_medium=email",
+		"https://EXAMPLE.com:443/produit/42?gclid=xyz&a=1&b=2#photos",
+	}
+	want := CanonicalKey(variants[0])
+	for _, v := range variants[1:] {
+		if got := CanonicalKey(v); got != want {
+			t.Errorf("CanonicalKey(%q) = %q, want %q", v, got, want)
+		}
+	}
+	if CanonicalKey("https://example.com/produit/43") == want {
+		t.Error("different pages should have different keys")
+	}
+}
+
+func TestScrapeOG_CanonicalLink(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head><title>Lego</title><link rel="Canonical" href="/produit/42"></head></html>`)
+	}))
+	defer srv.Close()
+
+	meta, err := scrapeOG(srv.URL + "/produit/42?utm_source=newsletter")
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if want := srv.URL + "/produit/42"; meta.Canonical != want {
+		t.Errorf("canonical = %q, want %q", meta.Canonical, want)
+	}
+}
+
+// Un lien vers la même page est signalé avec l'ID du lien existant
+func TestFindDuplicates(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	existing, err := svc.Create(ctx, ownerID, &Link{Title: "Lego", URL: "https://shop.example.com/lego?utm_source=fb"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if existing.URL != "https://shop.example.com/lego" {
+		t.Errorf("stored url = %q, tracking params should be stripped", existing.URL)
+	}
+
+	ids, err := svc.FindDuplicates(ctx, ownerID, &Link{URL: "http://WWW.shop.example.com/lego/"}, "")
+	if err != nil || len(ids) != 1 || ids[0] != existing.ID.Hex() {
+		t.Fatalf("expected duplicate of %s, got %v, %v", existing.ID.Hex(), ids, err)
+	}
+
+	if ids, err := svc.FindDuplicates(ctx, ownerID, &Link{URL: "https://shop.example.com/lego"}, existing.ID.Hex()); err != nil || len(ids) != 0 {
+		t.Errorf("the link itself should not count as a duplicate: %v, %v", ids, err)
+	}
+	if ids, err := svc.FindDuplicates(ctx, primitive.NewObjectID().Hex(), &Link{URL: "https://shop.example.com/lego"}, ""); err != nil || len(ids) != 0 {
+		t.Errorf("another user's link should not count as a duplicate: %v, %v", ids, err)
+	}
+}
+
+// Un doublon est un avertissement : le lien est créé, et il reste modifiable
+// sans que l'avertissement revienne tant que sa page ne change pas
+func TestHandler_DuplicateIsAWarning(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ownerID := primitive.NewObjectID().Hex()
+	ctx := interceptor.ContextWithUserID(context.Background(), ownerID)
+	h := NewHandler(NewService(db.Collection("links"), db.Collection("folders")))
+
+	first, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Title: "Lego", Url: "https://shop.example.com/lego"})
+	if err != nil || len(first.DuplicateLinkIds) != 0 {
+		t.Fatalf("first create = %v, %v", first, err)
+	}
+	second, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Title: "Lego (Papi)", Url: "https://shop.example.com/lego?utm_source=mail"})
+	if err != nil || len(second.DuplicateLinkIds) != 1 || second.DuplicateLinkIds[0] != first.Link.Id {
+		t.Fatalf("duplicate create = %v, %v; want a warning naming %s", second, err, first.Link.Id)
+	}
+
+	// Sans masque, comme les clients existants : la page ne change pas
+	updated, err := h.UpdateLink(ctx, &pb.UpdateLinkRequest{LinkId: second.Link.Id, Title: "Lego Duplo", Url: second.Link.Url})
+	if err != nil || len(updated.DuplicateLinkIds) != 0 || updated.Link.Title != "Lego Duplo" {
+		t.Errorf("update keeping the page = %v, %v", updated, err)
+	}
+	moved, err := h.UpdateLink(ctx, &pb.UpdateLinkRequest{LinkId: second.Link.Id, Title: "Vélo", Url: "https://shop.example.com/velo"})
+	if err != nil || len(moved.DuplicateLinkIds) != 0 {
+		t.Errorf("update to a new page = %v, %v", moved, err)
+	}
+	back, err := h.UpdateLink(ctx, &pb.UpdateLinkRequest{LinkId: second.Link.Id, Title: "Lego", Url: "https://shop.example.com/lego"})
+	if err != nil || len(back.DuplicateLinkIds) != 1 {
+		t.Errorf("update back to a saved page = %v, %v; want a warning", back, err)
+	}
+}
+
+// L'URL canonique est suivie à la création même quand l'image est fournie, et
+// à chaque changement d'URL : deux adresses d'une même page sont des doublons
+func TestHandler_FollowsCanonicalOnCreateAndUpdate(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head><title>Lego</title><link rel="canonical" href="/produit/42"></head></html>`)
+	}))
+	defer srv.Close()
+
+	ownerID := primitive.NewObjectID().Hex()
+	ctx := interceptor.ContextWithUserID(context.Background(), ownerID)
+	h := NewHandler(NewService(db.Collection("links"), db.Collection("folders")))
+	canonical := srv.URL + "/produit/42"
+
+	first, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Title: "Lego", Url: srv.URL + "/lego-city", ImageUrl: "https://img.example.com/lego.jpg"})
+	if err != nil || first.Link.CanonicalUrl != canonical || first.Link.ImageUrl != "https://img.example.com/lego.jpg" {
+		t.Fatalf("create with image = %v, %v; want canonical %s", first, err, canonical)
+	}
+
+	other, err := h.CreateLink(ctx, &pb.CreateLinkRequest{Title: "Vélo", Url: "https://shop.invalid/velo"})
+	if err != nil || len(other.DuplicateLinkIds) != 0 {
+		t.Fatalf("create other = %v, %v", other, err)
+	}
+	moved, err := h.UpdateLink(ctx, &pb.UpdateLinkRequest{
+		LinkId:     other.Link.Id,
+		Url:        srv.URL + "/promo/lego",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"url"}},
+	})
+	if err != nil || moved.Link.CanonicalUrl != canonical {
+		t.Fatalf("update url = %v, %v; want canonical %s", moved, err, canonical)
+	}
+	if len(moved.DuplicateLinkIds) != 1 || moved.DuplicateLinkIds[0] != first.Link.Id {
+		t.Errorf("update duplicates = %v, want %s", moved.DuplicateLinkIds, first.Link.Id)
+	}
+
+	// Une modification qui ne touche pas l'URL garde l'URL canonique
+	kept, err := h.UpdateLink(ctx, &pb.UpdateLinkRequest{
+		LinkId:     other.Link.Id,
+		Title:      "Lego en promo",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil || kept.Link.CanonicalUrl != canonical || len(kept.DuplicateLinkIds) != 0 {
+		t.Errorf("update title = %v, %v", kept, err)
+	}
+}
@@ -2,9 +2,17 @@ package link
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
 
 	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/agerange"
+	"github.com/tribbae/backend/internal/etag"
+	"github.com/tribbae/backend/internal/geo"
 	"github.com/tribbae/backend/internal/interceptor"
+	"github.com/tribbae/backend/internal/price"
+	"github.com/tribbae/backend/internal/recipe"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,37 +27,166 @@ func NewHandler(svc *Service) *Handler {
 	return &Handler{svc: svc}
 }
 
+// serviceError convertit une erreur du service en status gRPC.
+// Les erreurs métier connues ont leur propre code, le reste est Internal.
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrFolderFrozen), errors.Is(err, ErrServingsUnknown),
+		errors.Is(err, ErrNotWatchable):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s: %v", action, err)
+	case errors.Is(err, etag.ErrMismatch):
+		return status.Errorf(codes.Aborted, "failed to %s: %v", action, err)
+	case errors.Is(err, etag.ErrInvalid), errors.Is(err, ErrInvalidUpdateMask),
+		errors.Is(err, ErrBatchTooLarge), errors.Is(err, ErrInvalidBatchEdit),
+		errors.Is(err, ErrInvalidServings), errors.Is(err, ErrInvalidCoordinates),
+		errors.Is(err, ErrInvalidThreshold):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrLinkNotFound), errors.Is(err, ErrPlaceNotFound),
+		errors.Is(err, ErrChildNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotAuthorized), errors.Is(err, ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotApplied):
+		return status.Errorf(codes.Aborted, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
 func (h *Handler) toProto(ctx context.Context, l *Link, userID string) *pb.Link {
 	likeCount, _ := h.svc.GetLikeCount(ctx, l.ID.Hex())
 	likedByMe, _ := h.svc.IsLikedByUser(ctx, l.ID.Hex(), userID)
 
 	ownerDisplayName, ownerIsAdmin := h.svc.GetOwnerInfo(ctx, l.OwnerID)
 	return &pb.Link{
-		Id:               l.ID.Hex(),
-		OwnerId:          l.OwnerID,
-		FolderId:         l.FolderID,
-		Title:            l.Title,
-		Url:              l.URL,
-		Description:      l.Description,
-		Category:         pb.LinkCategory(pb.LinkCategory_value[l.Category]),
-		Tags:             l.Tags,
-		AgeRange:         l.AgeRange,
-		Location:         l.Location,
-		Price:            l.Price,
-		ImageUrl:         l.ImageURL,
-		EventDate:        l.EventDate,
-		ReminderEnabled:  l.ReminderEnabled,
-		Rating:           l.Rating,
-		Ingredients:      l.Ingredients,
-		CreatedAt:        timestamppb.New(l.CreatedAt),
-		UpdatedAt:        timestamppb.New(l.UpdatedAt),
-		LikeCount:        likeCount,
-		LikedByMe:        likedByMe,
-		Favorite:         l.Favorite,
-		OwnerDisplayName: ownerDisplayName,
-		OwnerIsAdmin:     ownerIsAdmin,
-		Visibility:       l.Visibility,
+		Id:                l.ID.Hex(),
+		OwnerId:           l.OwnerID,
+		FolderId:          l.FolderID,
+		Title:             l.Title,
+		Url:               l.URL,
+		Description:       l.Description,
+		Category:          pb.LinkCategory(pb.LinkCategory_value[l.Category]),
+		Tags:              l.Tags,
+		AgeRange:          l.AgeRange,
+		Location:          l.Location,
+		Price:             l.Price,
+		ImageUrl:          l.ImageURL,
+		EventDate:         l.EventDate,
+		ReminderEnabled:   l.ReminderEnabled,
+		Rating:            l.Rating,
+		Ingredients:       l.Ingredients,
+		CreatedAt:         timestamppb.New(l.CreatedAt),
+		UpdatedAt:         timestamppb.New(l.UpdatedAt),
+		LikeCount:         likeCount,
+		LikedByMe:         likedByMe,
+		Favorite:          l.Favorite,
+		OwnerDisplayName:  ownerDisplayName,
+		OwnerIsAdmin:      ownerIsAdmin,
+		Visibility:        l.Visibility,
+		Etag:              etag.Format(l.Version),
+		CanonicalUrl:      l.CanonicalURL,
+		Health:            healthToProto(l.LinkStatus),
+		Recipe:            recipeToProto(l.Recipe),
+		ParsedIngredients: ingredientsToProto(l.ParsedIngredients),
+		Geo:               geoToProto(l.Geo),
+		Ages:              agesToProto(l.Ages),
+		PriceAmount:       moneyToProto(l.PriceAmount),
+		Purchased:         l.Purchased,
+		PriceWatch:        priceWatchToProto(l.PriceWatch),
+	}
+}
+
+func priceWatchToProto(pw *PriceWatch) *pb.PriceWatch {
+	if pw == nil {
+		return nil
+	}
+	out := &pb.PriceWatch{
+		UserId:         pw.UserID,
+		ThresholdCents: pw.Threshold,
+		Current:        moneyToProto(pw.Current),
+		CreatedAt:      timestamppb.New(pw.CreatedAt),
+	}
+	if pw.CheckedAt != nil {
+		out.CheckedAt = timestamppb.New(*pw.CheckedAt)
+	}
+	return out
+}
+
+func moneyToProto(a *price.Amount) *pb.Money {
+	if a == nil {
+		return nil
+	}
+	return &pb.Money{AmountCents: a.Cents, Currency: a.Currency}
+}
+
+func agesToProto(r *agerange.Range) *pb.AgeBounds {
+	if r == nil {
+		return nil
+	}
+	return &pb.AgeBounds{MinMonths: r.MinMonths, MaxMonths: r.MaxMonths}
+}
+
+func geoToProto(p *geo.Point) *pb.GeoPoint {
+	if p == nil {
+		return nil
+	}
+	return &pb.GeoPoint{Latitude: p.Lat(), Longitude: p.Lng()}
+}
+
+func healthToProto(st *LinkStatus) *pb.LinkHealth {
+	if st == nil {
+		return nil
+	}
+	return &pb.LinkHealth{
+		HttpStatus: st.HTTPStatus,
+		FinalUrl:   st.FinalURL,
+		CheckedAt:  timestamppb.New(st.CheckedAt),
+		Broken:     st.Broken,
+		Reason:     st.Reason,
+	}
+}
+
+func recipeToProto(r *recipe.Recipe) *pb.Recipe {
+	if r == nil {
+		return nil
+	}
+	pr := &pb.Recipe{
+		Instructions: r.Instructions,
+		Yield:        r.Yield,
+		Servings:     r.Servings,
+		PrepMinutes:  r.PrepMinutes,
+		CookMinutes:  r.CookMinutes,
+		TotalMinutes: r.TotalMinutes,
+	}
+	if n := r.Nutrition; n != nil {
+		pr.Nutrition = &pb.Nutrition{
+			ServingSize:  n.ServingSize,
+			Calories:     n.Calories,
+			Fat:          n.Fat,
+			SaturatedFat: n.SaturatedFat,
+			Carbohydrate: n.Carbohydrate,
+			Sugar:        n.Sugar,
+			Fiber:        n.Fiber,
+			Protein:      n.Protein,
+			Sodium:       n.Sodium,
+		}
 	}
+	return pr
+}
+
+func ingredientsToProto(ingredients []recipe.Ingredient) []*pb.Ingredient {
+	out := make([]*pb.Ingredient, len(ingredients))
+	for i, ing := range ingredients {
+		out[i] = &pb.Ingredient{
+			Raw:         ing.Raw,
+			Quantity:    ing.Quantity,
+			QuantityMax: ing.QuantityMax,
+			Unit:        ing.Unit,
+			Item:        ing.Item,
+			Text:        ing.String(),
+		}
+	}
+	return out
 }
 
 func (h *Handler) CreateLink(ctx context.Context, req *pb.CreateLinkRequest) (*pb.CreateLinkResponse, error) {
@@ -73,26 +210,42 @@ func (h *Handler) CreateLink(ctx context.Context, req *pb.CreateLinkRequest) (*p
 		Rating:          req.Rating,
 		Ingredients:     req.Ingredients,
 		Visibility:      req.Visibility,
+		Purchased:       req.Purchased,
 	}
-	// Scraper OG si pas d'image fournie
-	if l.ImageURL == "" && l.URL != "" {
+	// La page est toujours lue pour son URL canonique (doublons). Elle complète
+	// aussi le lien s'il n'a pas d'image, et toujours une recette afin
+	// d'importer sa fiche.
+	if l.URL != "" {
 		if meta, err := scrapeOG(l.URL); err == nil {
-			if l.Title == "" && meta.Title != "" {
-				l.Title = meta.Title
-			}
-			if l.Description == "" && meta.Description != "" {
-				l.Description = meta.Description
-			}
-			if meta.Image != "" {
-				l.ImageURL = meta.Image
+			if l.ImageURL == "" || req.Category == pb.LinkCategory_LINK_CATEGORY_RECETTE {
+				if l.Title == "" && meta.Title != "" {
+					l.Title = meta.Title
+				}
+				if l.Description == "" && meta.Description != "" {
+					l.Description = meta.Description
+				}
+				if l.ImageURL == "" && meta.Image != "" {
+					l.ImageURL = meta.Image
+				}
+				if len(l.Ingredients) == 0 {
+					l.Ingredients = meta.Ingredients
+				}
+				l.Recipe = meta.Recipe
 			}
+			l.CanonicalURL = meta.Canonical
+		}
+	}
+	var duplicates []string
+	if !req.AllowDuplicate {
+		if duplicates, err = h.svc.FindDuplicates(ctx, ownerID, l, ""); err != nil {
+			return nil, serviceError(err, "create link")
 		}
 	}
 	created, err := h.svc.Create(ctx, ownerID, l)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create link: %v", err)
+		return nil, serviceError(err, "create link")
 	}
-	return &pb.CreateLinkResponse{Link: h.toProto(ctx, created, ownerID)}, nil
+	return &pb.CreateLinkResponse{Link: h.toProto(ctx, created, ownerID), DuplicateLinkIds: duplicates}, nil
 }
 
 func (h *Handler) GetLink(ctx context.Context, req *pb.GetLinkRequest) (*pb.GetLinkResponse, error) {
@@ -107,12 +260,58 @@ func (h *Handler) GetLink(ctx context.Context, req *pb.GetLinkRequest) (*pb.GetL
 	return &pb.GetLinkResponse{Link: h.toProto(ctx, l, ownerID)}, nil
 }
 
+// sortFields associe les champs de tri de l'API à ceux de List
+var sortFields = map[pb.LinkSortField]string{
+	pb.LinkSortField_LINK_SORT_FIELD_CREATED_AT: SortByCreatedAt,
+	pb.LinkSortField_LINK_SORT_FIELD_UPDATED_AT: SortByUpdatedAt,
+	pb.LinkSortField_LINK_SORT_FIELD_RATING:     SortByRating,
+	pb.LinkSortField_LINK_SORT_FIELD_EVENT_DATE: SortByEventAt,
+	pb.LinkSortField_LINK_SORT_FIELD_TITLE:      SortByTitle,
+}
+
 func (h *Handler) ListLinks(ctx context.Context, req *pb.ListLinksRequest) (*pb.ListLinksResponse, error) {
 	ownerID, err := interceptor.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
-	links, err := h.svc.List(ctx, ownerID, req.FolderId)
+	opts := ListOptions{
+		FolderID:   req.FolderId,
+		Tags:       req.Tags,
+		AllTags:    req.MatchAllTags,
+		Favorite:   req.FavoritesOnly,
+		MinRating:  req.MinRating,
+		MaxRating:  req.MaxRating,
+		Visibility: req.Visibility,
+		OwnerID:    req.OwnerId,
+		Broken:     req.BrokenOnly,
+		ForMyKids:  req.SuitableForMyKids,
+		MinPrice:   req.MinPriceCents,
+		MaxPrice:   req.MaxPriceCents,
+		Currency:   strings.ToUpper(req.Currency),
+		SortBy:     sortFields[req.SortBy],
+		Descending: req.Descending,
+		PageSize:   req.PageSize,
+		PageToken:  req.PageToken,
+	}
+	if req.Category != pb.LinkCategory_LINK_CATEGORY_UNSPECIFIED {
+		opts.Category = req.Category.String()
+	}
+	if req.SortBy == pb.LinkSortField_LINK_SORT_FIELD_UNSPECIFIED {
+		opts.Descending = true
+	}
+	if req.EventAfter != nil {
+		t := req.EventAfter.AsTime()
+		opts.EventAfter = &t
+	}
+	if req.EventBefore != nil {
+		t := req.EventBefore.AsTime()
+		opts.EventBefore = &t
+	}
+
+	links, nextToken, err := h.svc.List(ctx, ownerID, opts)
+	if errors.Is(err, ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list links: %v", err)
 	}
@@ -120,7 +319,7 @@ func (h *Handler) ListLinks(ctx context.Context, req *pb.ListLinksRequest) (*pb.
 	for _, l := range links {
 		pbLinks = append(pbLinks, h.toProto(ctx, l, ownerID))
 	}
-	return &pb.ListLinksResponse{Links: pbLinks}, nil
+	return &pb.ListLinksResponse{Links: pbLinks, NextPageToken: nextToken}, nil
 }
 
 func (h *Handler) UpdateLink(ctx context.Context, req *pb.UpdateLinkRequest) (*pb.UpdateLinkResponse, error) {
@@ -144,12 +343,36 @@ func (h *Handler) UpdateLink(ctx context.Context, req *pb.UpdateLinkRequest) (*p
 		Rating:          req.Rating,
 		Ingredients:     req.Ingredients,
 		Visibility:      req.Visibility,
+		Favorite:        req.Favorite,
+		Purchased:       req.Purchased,
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	// Une nouvelle URL est suivie jusqu'à son URL canonique, comme à la
+	// création ; une URL inchangée garde celle déjà trouvée
+	var before *Link
+	if updatesURL(paths) {
+		if before, err = h.svc.Get(ctx, req.LinkId, ownerID); err != nil {
+			return nil, status.Errorf(codes.NotFound, "link not found: %v", err)
+		}
+		if l.URL != "" && NormalizeURL(l.URL) != before.URL {
+			if meta, err := scrapeOG(l.URL); err == nil {
+				l.CanonicalURL = meta.Canonical
+			}
+		}
 	}
-	updated, err := h.svc.Update(ctx, req.LinkId, ownerID, l)
+	updated, err := h.svc.Update(ctx, req.LinkId, ownerID, l, paths, req.Etag)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update link: %v", err)
+		return nil, serviceError(err, "update link")
+	}
+	// Seul un changement de page est vérifié : un doublon accepté à la
+	// création ne concerne pas les modifications suivantes
+	var duplicates []string
+	if !req.AllowDuplicate && before != nil && updated.canonicalKey() != before.canonicalKey() {
+		if duplicates, err = h.svc.FindDuplicates(ctx, ownerID, updated, req.LinkId); err != nil {
+			return nil, serviceError(err, "update link")
+		}
 	}
-	return &pb.UpdateLinkResponse{Link: h.toProto(ctx, updated, ownerID)}, nil
+	return &pb.UpdateLinkResponse{Link: h.toProto(ctx, updated, ownerID), DuplicateLinkIds: duplicates}, nil
 }
 
 func (h *Handler) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*pb.DeleteLinkResponse, error) {
@@ -158,11 +381,214 @@ func (h *Handler) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*p
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 	if err := h.svc.Delete(ctx, req.LinkId, ownerID); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete link: %v", err)
+		return nil, serviceError(err, "delete link")
 	}
 	return &pb.DeleteLinkResponse{}, nil
 }
 
+func (h *Handler) BatchUpdateLinks(ctx context.Context, req *pb.BatchUpdateLinksRequest) (*pb.BatchUpdateLinksResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	change := BatchChange{AddTags: req.AddTags, RemoveTags: req.RemoveTags, Visibility: req.Visibility}
+	results, err := h.svc.BatchUpdate(ctx, userID, req.LinkIds, change, req.Atomic)
+	if err != nil {
+		return nil, serviceError(err, "update links")
+	}
+	return &pb.BatchUpdateLinksResponse{Results: batchResultsToProto(results, "update link")}, nil
+}
+
+func (h *Handler) BatchMoveLinks(ctx context.Context, req *pb.BatchMoveLinksRequest) (*pb.BatchMoveLinksResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	results, err := h.svc.BatchMove(ctx, userID, req.LinkIds, req.TargetFolderId, req.Atomic)
+	if err != nil {
+		return nil, serviceError(err, "move links")
+	}
+	return &pb.BatchMoveLinksResponse{Results: batchResultsToProto(results, "move link")}, nil
+}
+
+func (h *Handler) BatchDeleteLinks(ctx context.Context, req *pb.BatchDeleteLinksRequest) (*pb.BatchDeleteLinksResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	results, err := h.svc.BatchDelete(ctx, userID, req.LinkIds, req.Atomic)
+	if err != nil {
+		return nil, serviceError(err, "delete links")
+	}
+	return &pb.BatchDeleteLinksResponse{Results: batchResultsToProto(results, "delete link")}, nil
+}
+
+func (h *Handler) GetFolderHealth(ctx context.Context, req *pb.GetFolderHealthRequest) (*pb.GetFolderHealthResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	health, err := h.svc.FolderHealth(ctx, req.FolderId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get folder health")
+	}
+	resp := &pb.GetFolderHealthResponse{
+		Total:      health.Total,
+		Checked:    health.Checked,
+		Unchecked:  health.Unchecked,
+		Redirected: health.Redirected,
+	}
+	for _, l := range health.BrokenLinks {
+		resp.BrokenLinks = append(resp.BrokenLinks, h.toProto(ctx, l, userID))
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetFolderBudget(ctx context.Context, req *pb.GetFolderBudgetRequest) (*pb.GetFolderBudgetResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	budget, err := h.svc.FolderBudget(ctx, req.FolderId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get folder budget")
+	}
+	resp := &pb.GetFolderBudgetResponse{Totals: make([]*pb.BudgetTotal, 0, len(budget.Totals)), Unpriced: budget.Unpriced}
+	for _, t := range budget.Totals {
+		resp.Totals = append(resp.Totals, &pb.BudgetTotal{
+			Currency:       t.Currency,
+			TotalCents:     t.Total,
+			SpentCents:     t.Spent,
+			PlannedCents:   t.Planned,
+			Count:          t.Count,
+			PurchasedCount: t.Purchased,
+		})
+	}
+	return resp, nil
+}
+
+func (h *Handler) WatchPrice(ctx context.Context, req *pb.WatchPriceRequest) (*pb.WatchPriceResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	l, err := h.svc.WatchPrice(ctx, req.LinkId, userID, req.ThresholdCents)
+	if err != nil {
+		return nil, serviceError(err, "watch price")
+	}
+	return &pb.WatchPriceResponse{Link: h.toProto(ctx, l, userID)}, nil
+}
+
+func (h *Handler) UnwatchPrice(ctx context.Context, req *pb.UnwatchPriceRequest) (*pb.UnwatchPriceResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.UnwatchPrice(ctx, req.LinkId, userID); err != nil {
+		return nil, serviceError(err, "unwatch price")
+	}
+	return &pb.UnwatchPriceResponse{}, nil
+}
+
+func (h *Handler) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	points, err := h.svc.PriceHistory(ctx, req.LinkId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get price history")
+	}
+	resp := &pb.GetPriceHistoryResponse{Points: make([]*pb.PricePoint, 0, len(points))}
+	for _, p := range points {
+		resp.Points = append(resp.Points, &pb.PricePoint{Price: moneyToProto(&p.Amount), ObservedAt: timestamppb.New(p.ObservedAt)})
+	}
+	return resp, nil
+}
+
+// updatesURL indique si un masque de mise à jour modifie l'URL du lien
+func updatesURL(paths []string) bool {
+	return len(paths) == 0 || slices.Contains(paths, "url") || slices.Contains(paths, "*")
+}
+
+// batchResultsToProto convertit les résultats par lien avec le même code
+// d'erreur que l'opération unitaire correspondante
+func batchResultsToProto(results []BatchResult, action string) []*pb.BatchLinkResult {
+	out := make([]*pb.BatchLinkResult, 0, len(results))
+	for _, r := range results {
+		item := &pb.BatchLinkResult{LinkId: r.LinkID, Success: r.Err == nil}
+		if r.Err != nil {
+			st := status.Convert(serviceError(r.Err, action))
+			item.Code = int32(st.Code())
+			item.Message = st.Message()
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func (h *Handler) ScaleRecipe(ctx context.Context, req *pb.ScaleRecipeRequest) (*pb.ScaleRecipeResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	ingredients, base, err := h.svc.ScaleRecipe(ctx, req.LinkId, userID, req.Servings, req.BaseServings, req.Metric)
+	if err != nil {
+		return nil, serviceError(err, "scale recipe")
+	}
+	return &pb.ScaleRecipeResponse{
+		Ingredients:  ingredientsToProto(ingredients),
+		Servings:     req.Servings,
+		BaseServings: base,
+	}, nil
+}
+
+func (h *Handler) SearchNearby(ctx context.Context, req *pb.SearchNearbyRequest) (*pb.SearchNearbyResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	q := NearbyQuery{
+		Lat:      req.Latitude,
+		Lng:      req.Longitude,
+		Place:    req.Place,
+		RadiusKm: req.RadiusKm,
+		Limit:    req.Limit,
+	}
+	if req.Category != pb.LinkCategory_LINK_CATEGORY_UNSPECIFIED {
+		q.Category = req.Category.String()
+	}
+	results, center, err := h.svc.SearchNearby(ctx, userID, q)
+	if err != nil {
+		return nil, serviceError(err, "search nearby links")
+	}
+	resp := &pb.SearchNearbyResponse{Results: make([]*pb.NearbyLink, 0, len(results)), Center: geoToProto(center)}
+	for _, r := range results {
+		resp.Results = append(resp.Results, &pb.NearbyLink{Link: h.toProto(ctx, r.Link, userID), DistanceKm: r.DistanceKm})
+	}
+	return resp, nil
+}
+
+func (h *Handler) RecommendForChild(ctx context.Context, req *pb.RecommendForChildRequest) (*pb.RecommendForChildResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+	var category string
+	if req.Category != pb.LinkCategory_LINK_CATEGORY_UNSPECIFIED {
+		category = req.Category.String()
+	}
+	links, months, err := h.svc.RecommendForChild(ctx, userID, req.ChildId, category, req.Limit)
+	if err != nil {
+		return nil, serviceError(err, "recommend links")
+	}
+	resp := &pb.RecommendForChildResponse{Links: make([]*pb.Link, 0, len(links)), AgeMonths: months}
+	for _, l := range links {
+		resp.Links = append(resp.Links, h.toProto(ctx, l, userID))
+	}
+	return resp, nil
+}
+
 func (h *Handler) LikeLink(ctx context.Context, req *pb.LikeLinkRequest) (*pb.LikeLinkResponse, error) {
 	ownerID, err := interceptor.UserIDFromContext(ctx)
 	if err != nil {
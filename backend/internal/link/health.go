@@ -0,0 +1,71 @@
+package link
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FolderHealth résume l'état des URLs des liens d'un dossier
+type FolderHealth struct {
+	Total       int32
+	Checked     int32   // liens avec URL déjà vérifiés
+	Unchecked   int32   // liens avec URL pas encore vérifiés
+	Redirected  int32   // pages qui ont changé d'adresse mais répondent
+	BrokenLinks []*Link // liens dont l'URL ne répond plus
+}
+
+// FolderHealth retourne le bilan des vérifications d'URL d'un dossier visible
+// par l'utilisateur
+func (s *Service) FolderHealth(ctx context.Context, folderID, userID string) (*FolderHealth, error) {
+	if !s.canViewFolder(ctx, folderID, userID) {
+		return nil, ErrNotAuthorized
+	}
+	cursor, err := s.col.Find(ctx, bson.M{"folder_id": folderID, "deleted_at": nil})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var links []*Link
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, err
+	}
+
+	h := &FolderHealth{Total: int32(len(links)), BrokenLinks: []*Link{}}
+	for _, l := range links {
+		switch {
+		case l.URL == "":
+		case l.LinkStatus == nil:
+			h.Unchecked++
+		case l.LinkStatus.Broken:
+			h.Checked++
+			h.BrokenLinks = append(h.BrokenLinks, l)
+		default:
+			h.Checked++
+			if l.LinkStatus.FinalURL != "" && CanonicalKey(l.LinkStatus.FinalURL) != CanonicalKey(l.URL) {
+				h.Redirected++
+			}
+		}
+	}
+	return h, nil
+}
+
+// canViewFolder vérifie si l'utilisateur peut consulter un dossier
+// (propriétaire, collaborateur ou dossier public)
+func (s *Service) canViewFolder(ctx context.Context, folderID, userID string) bool {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return false
+	}
+	count, _ := s.folderCol.CountDocuments(ctx, bson.M{
+		"_id":        fid,
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"owner_id": userID},
+			bson.M{"visibility": "public"},
+			bson.M{"collaborators.user_id": userID},
+		},
+	})
+	return count > 0
+}
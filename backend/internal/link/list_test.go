@@ -0,0 +1,194 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventTime_SecondsAndMillis(t *testing.T) {
+	want := time.Date(2026, 12, 24, 18, 0, 0, 0, time.UTC)
+	if got := eventTime(want.Unix()); got == nil || !got.Equal(want) {
+		t.Errorf("seconds: got %v, want %v", got, want)
+	}
+	if got := eventTime(want.UnixMilli()); got == nil || !got.Equal(want) {
+		t.Errorf("millis: got %v, want %v", got, want)
+	}
+	if got := eventTime(0); got != nil {
+		t.Errorf("zero: got %v, want nil", got)
+	}
+}
+
+// Les filtres se combinent et la pagination parcourt tous les liens une seule fois,
+// y compris ceux sans date lors d'un tri par date d'événement
+func TestList_FiltersAndPagination(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	base := time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)
+	fixtures := []*Link{
+		{Title: "Piscine", Category: "LINK_CATEGORY_ACTIVITE", Tags: []string{"sport", "ete"}, Rating: 4, EventDate: base.Unix()},
+		{Title: "Escalade", Category: "LINK_CATEGORY_ACTIVITE", Tags: []string{"sport"}, Rating: 5, EventDate: base.AddDate(0, 1, 0).UnixMilli()},
+		{Title: "Musée", Category: "LINK_CATEGORY_ACTIVITE", Tags: []string{"culture"}, Rating: 3},
+		{Title: "Crêpes", Category: "LINK_CATEGORY_RECETTE", Tags: []string{"sucre"}, Rating: 5, Favorite: true},
+		{Title: "Cinéma", Category: "LINK_CATEGORY_ACTIVITE", Tags: []string{"culture", "ete"}, Rating: 2},
+	}
+	for _, l := range fixtures {
+		if _, err := svc.Create(ctx, ownerID, l); err != nil {
+			t.Fatalf("create %s: %v", l.Title, err)
+		}
+	}
+
+	titles := func(links []*Link) []string {
+		var out []string
+		for _, l := range links {
+			out = append(out, l.Title)
+		}
+		return out
+	}
+
+	links, _, err := svc.List(ctx, ownerID, ListOptions{
+		Category:  "LINK_CATEGORY_ACTIVITE",
+		Tags:      []string{"sport", "ete"},
+		MinRating: 4,
+		SortBy:    SortByTitle,
+	})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := titles(links); len(got) != 2 || got[0] != "Escalade" || got[1] != "Piscine" {
+		t.Errorf("any-tag filter: got %v", got)
+	}
+
+	links, _, err = svc.List(ctx, ownerID, ListOptions{Tags: []string{"sport", "ete"}, AllTags: true})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := titles(links); len(got) != 1 || got[0] != "Piscine" {
+		t.Errorf("all-tags filter: got %v", got)
+	}
+
+	after := base.AddDate(0, 0, 7)
+	links, _, err = svc.List(ctx, ownerID, ListOptions{EventAfter: &after})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := titles(links); len(got) != 1 || got[0] != "Escalade" {
+		t.Errorf("event range (millis date): got %v", got)
+	}
+
+	var seen []string
+	token := ""
+	for page := 0; page < 10; page++ {
+		links, next, err := svc.List(ctx, ownerID, ListOptions{SortBy: SortByEventAt, Descending: true, PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("page %d: %v", page, err)
+		}
+		seen = append(seen, titles(links)...)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if len(seen) != len(fixtures) {
+		t.Fatalf("pagination returned %v, want %d links", seen, len(fixtures))
+	}
+	if seen[0] != "Escalade" || seen[1] != "Piscine" {
+		t.Errorf("dated links should come first in descending order, got %v", seen)
+	}
+
+	if _, _, err := svc.List(ctx, ownerID, ListOptions{PageToken: "garbage"}); err != ErrInvalidPageToken {
+		t.Errorf("invalid token error = %v, want ErrInvalidPageToken", err)
+	}
+}
+
+// Sans page_size, les anciens clients reçoivent tous leurs liens, au-delà
+// de la taille d'une page
+func TestList_UnboundedWithoutPageSize(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+	const total = maxListPageSize + 20
+	docs := make([]any, 0, total)
+	for range total {
+		docs = append(docs, &Link{ID: primitive.NewObjectID(), OwnerID: ownerID, Title: "Lien", CreatedAt: time.Now()})
+	}
+	if _, err := db.Collection("links").InsertMany(ctx, docs); err != nil {
+		t.Fatal(err)
+	}
+
+	links, next, err := svc.List(ctx, ownerID, ListOptions{})
+	if err != nil || len(links) != total || next != "" {
+		t.Fatalf("list = %d links, next %q, %v; want all %d without token", len(links), next, err, total)
+	}
+	links, next, err = svc.List(ctx, ownerID, ListOptions{PageSize: 1000})
+	if err != nil || len(links) != maxListPageSize || next == "" {
+		t.Errorf("oversized page = %d links, next %q, %v; want %d and a token", len(links), next, err, maxListPageSize)
+	}
+}
+
+// Un token n'est valable que pour le tri et les filtres de la page qui l'a
+// produit : réutilisé avec un autre tri ou un autre filtre, il est refusé
+func TestList_PageTokenBoundToQuery(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+	for _, title := range []string{"Abricot", "Banane", "Cerise"} {
+		if _, err := svc.Create(ctx, ownerID, &Link{Title: title, Category: "LINK_CATEGORY_RECETTE"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := ListOptions{SortBy: SortByTitle, Category: "LINK_CATEGORY_RECETTE", PageSize: 1}
+	_, next, err := svc.List(ctx, ownerID, opts)
+	if err != nil || next == "" {
+		t.Fatalf("first page: next %q, %v", next, err)
+	}
+	same := opts
+	same.PageToken = next
+	if links, _, err := svc.List(ctx, ownerID, same); err != nil || len(links) != 1 || links[0].Title != "Banane" {
+		t.Errorf("second page = %v, %v; want Banane", links, err)
+	}
+
+	after := time.Now()
+	for name, change := range map[string]func(o *ListOptions){
+		"sort":        func(o *ListOptions) { o.SortBy = SortByCreatedAt },
+		"direction":   func(o *ListOptions) { o.Descending = true },
+		"category":    func(o *ListOptions) { o.Category = "LINK_CATEGORY_ACTIVITE" },
+		"folder":      func(o *ListOptions) { o.FolderID = primitive.NewObjectID().Hex() },
+		"event after": func(o *ListOptions) { o.EventAfter = &after },
+	} {
+		o := same
+		change(&o)
+		if _, _, err := svc.List(ctx, ownerID, o); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("token reused with another %s: err = %v, want ErrInvalidPageToken", name, err)
+		}
+	}
+	// Côté API, le token refusé est une erreur de la requête
+	h := NewHandler(svc)
+	_, err = h.ListLinks(interceptor.ContextWithUserID(ctx, ownerID), &pb.ListLinksRequest{
+		SortBy:    pb.LinkSortField_LINK_SORT_FIELD_CREATED_AT,
+		PageSize:  1,
+		PageToken: next,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("handler err = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,184 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/geo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultNearbyRadiusKm = 20
+	maxNearbyRadiusKm     = 200
+	defaultNearbyLimit    = 50
+	maxNearbyLimit        = 200
+	// geocodeTimeout borne l'attente du géocodeur lors d'une écriture
+	geocodeTimeout = 3 * time.Second
+)
+
+var (
+	// ErrInvalidCoordinates est retourné pour une position absente ou hors limites
+	ErrInvalidCoordinates = errors.New("invalid coordinates")
+	// ErrPlaceNotFound est retourné quand le lieu de la recherche n'est pas reconnu
+	ErrPlaceNotFound = errors.New("place not found")
+)
+
+// NearbyQuery décrit une recherche autour d'un point, donné par ses
+// coordonnées ou par un lieu à géocoder (Place)
+type NearbyQuery struct {
+	Lat, Lng float64
+	Place    string
+	RadiusKm float64 // defaultNearbyRadiusKm si nul
+	Category string
+	Limit    int32
+}
+
+// NearbyLink est un lien trouvé et sa distance au centre de la recherche
+type NearbyLink struct {
+	Link       *Link
+	DistanceKm float64
+}
+
+// locate géocode l.Location. Un lieu non reconnu laisse le lien sans
+// coordonnées ; une panne du géocodeur aussi, mais le lien sera repris par
+// BackfillGeo.
+func (s *Service) locate(ctx context.Context, l *Link) {
+	l.Geo = nil
+	l.GeocodedLocation = ""
+	if strings.TrimSpace(l.Location) == "" || s.geocoder == nil {
+		l.GeocodedLocation = l.Location
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, geocodeTimeout)
+	defer cancel()
+	p, err := s.geocoder.Geocode(ctx, l.Location)
+	switch {
+	case err == nil:
+		l.Geo = geo.NewPoint(p.Lat, p.Lng)
+		l.GeocodedLocation = l.Location
+	case errors.Is(err, geo.ErrNotFound):
+		l.GeocodedLocation = l.Location
+	default:
+		log.Printf("WARN: geocode %q: %v", l.Location, err)
+	}
+}
+
+// SearchNearby retourne, du plus proche au plus lointain, les liens situés
+// dans le rayon de la recherche parmi ceux de l'utilisateur, de ses dossiers
+// partagés et de la communauté. Le centre retenu est retourné avec.
+func (s *Service) SearchNearby(ctx context.Context, userID string, q NearbyQuery) ([]NearbyLink, *geo.Point, error) {
+	center, err := s.nearbyCenter(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	radius := q.RadiusKm
+	if radius <= 0 {
+		radius = defaultNearbyRadiusKm
+	}
+	radius = min(radius, maxNearbyRadiusKm)
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultNearbyLimit
+	}
+	limit = min(limit, maxNearbyLimit)
+
+	scope := bson.A{bson.M{"visibility": "public"}}
+	if userID != "" {
+		folderIDs, err := s.AccessibleFolderIDs(ctx, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		scope = append(scope, bson.M{"owner_id": userID})
+		if len(folderIDs) > 0 {
+			scope = append(scope, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+		}
+	}
+	query := bson.M{"deleted_at": nil, "$or": scope}
+	if q.Category != "" {
+		query["category"] = q.Category
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.D{
+			{Key: "near", Value: center},
+			{Key: "key", Value: "geo"},
+			{Key: "distanceField", Value: "distance"},
+			{Key: "maxDistance", Value: radius * 1000},
+			{Key: "spherical", Value: true},
+			{Key: "query", Value: query},
+		}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cursor, err := s.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	var docs []struct {
+		Link     `bson:",inline"`
+		Distance float64 `bson:"distance"` // en mètres
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, nil, err
+	}
+	out := make([]NearbyLink, 0, len(docs))
+	for i := range docs {
+		out = append(out, NearbyLink{Link: &docs[i].Link, DistanceKm: docs[i].Distance / 1000})
+	}
+	return out, center, nil
+}
+
+func (s *Service) nearbyCenter(ctx context.Context, q NearbyQuery) (*geo.Point, error) {
+	if strings.TrimSpace(q.Place) != "" {
+		if s.geocoder == nil {
+			return nil, ErrPlaceNotFound
+		}
+		p, err := s.geocoder.Geocode(ctx, q.Place)
+		if errors.Is(err, geo.ErrNotFound) {
+			return nil, ErrPlaceNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		return geo.NewPoint(p.Lat, p.Lng), nil
+	}
+	if (q.Lat == 0 && q.Lng == 0) || q.Lat < -90 || q.Lat > 90 || q.Lng < -180 || q.Lng > 180 {
+		return nil, ErrInvalidCoordinates
+	}
+	return geo.NewPoint(q.Lat, q.Lng), nil
+}
+
+// BackfillGeo géocode les lieux des liens créés avant l'ajout des
+// coordonnées, ou dont le géocodage avait échoué
+func (s *Service) BackfillGeo(ctx context.Context) error {
+	filter := bson.M{
+		"location": bson.M{"$nin": bson.A{"", nil}},
+		"$expr":    bson.M{"$ne": bson.A{"$location", bson.M{"$ifNull": bson.A{"$geocoded_location", ""}}}},
+	}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "location": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l Link
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		s.locate(ctx, &l)
+		if l.GeocodedLocation == "" {
+			// Géocodeur indisponible : inutile d'insister jusqu'au prochain démarrage
+			return nil
+		}
+		set := bson.M{"geo": l.Geo, "geocoded_location": l.GeocodedLocation}
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": set}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,78 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Les lieux sont géocodés à l'écriture et la recherche couvre les liens de
+// l'utilisateur et ceux de la communauté, dans le rayon demandé
+func TestSearchNearby(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := db.Collection("links").Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "geo", Value: "2dsphere"}}}); err != nil {
+		t.Fatalf("geo index: %v", err)
+	}
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	userID := primitive.NewObjectID().Hex()
+	strangerID := primitive.NewObjectID().Hex()
+	const activity = "LINK_CATEGORY_ACTIVITE"
+
+	create := func(ownerID, title, location, category, visibility string) *Link {
+		l, err := svc.Create(ctx, ownerID, &Link{Title: title, Location: location, Category: category, Visibility: visibility})
+		if err != nil {
+			t.Fatalf("create %s: %v", title, err)
+		}
+		return l
+	}
+	park := create(userID, "Parc", "Parc de la Tête d'Or, Lyon", activity, "")
+	create(strangerID, "Piscine", "Villeurbanne", activity, "public")
+	create(strangerID, "Privé", "Lyon", activity, "private")
+	create(userID, "Musée", "Paris", activity, "")
+	create(userID, "Quenelles", "Lyon", "LINK_CATEGORY_RECETTE", "")
+	if park.Geo == nil || park.GeocodedLocation != park.Location {
+		t.Fatalf("park not geocoded: %+v", park)
+	}
+
+	results, center, err := svc.SearchNearby(ctx, userID, NearbyQuery{Place: "Lyon", RadiusKm: 20, Category: activity})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if center == nil || center.Lat() < 45 || center.Lat() > 46 {
+		t.Errorf("center = %+v", center)
+	}
+	var titles []string
+	for _, r := range results {
+		titles = append(titles, r.Link.Title)
+	}
+	if len(titles) != 2 || titles[0] != "Parc" || titles[1] != "Piscine" {
+		t.Fatalf("results = %v, want [Parc Piscine]", titles)
+	}
+	if d := results[1].DistanceKm; d < 2 || d > 8 {
+		t.Errorf("Villeurbanne distance = %.1f km", d)
+	}
+
+	// Le lieu modifié est géocodé à nouveau
+	park.Location = "Grenoble"
+	if _, err := svc.Update(ctx, park.ID.Hex(), userID, park, []string{"location"}, ""); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	results, _, err = svc.SearchNearby(ctx, userID, NearbyQuery{Lat: 45.1885, Lng: 5.7245, RadiusKm: 5})
+	if err != nil || len(results) != 1 || results[0].Link.ID != park.ID {
+		t.Errorf("near Grenoble = %+v, %v", results, err)
+	}
+
+	if _, _, err := svc.SearchNearby(ctx, userID, NearbyQuery{}); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("no center err = %v, want ErrInvalidCoordinates", err)
+	}
+	if _, _, err := svc.SearchNearby(ctx, userID, NearbyQuery{Place: "au fond du jardin"}); !errors.Is(err, ErrPlaceNotFound) {
+		t.Errorf("unknown place err = %v, want ErrPlaceNotFound", err)
+	}
+}
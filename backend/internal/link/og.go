@@ -1,11 +1,13 @@
 package link
 
 import (
-	"fmt"
+	"encoding/json"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/tribbae/backend/internal/recipe"
+	"github.com/tribbae/backend/internal/schemaorg"
 	"golang.org/x/net/html"
 )
 
@@ -13,6 +15,10 @@ type OGMeta struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Image       string `json:"image"`
+	Canonical   string `json:"canonical"` // <link rel="canonical">, en URL absolue
+	// Ingredients et Recipe viennent du schema.org Recipe de la page (JSON-LD ou microdata)
+	Ingredients []string       `json:"ingredients,omitempty"`
+	Recipe      *recipe.Recipe `json:"recipe,omitempty"`
 }
 
 var httpClient = &http.Client{Timeout: 8 * time.Second}
@@ -59,6 +65,12 @@ func scrapeOG(rawURL string) (*OGMeta, error) {
 				meta.Description = content
 			}
 		}
+		if n.Type == html.ElementNode && n.Data == "link" && meta.Canonical == "" &&
+			strings.EqualFold(attrVal(n, "rel"), "canonical") && attrVal(n, "href") != "" {
+			if href, err := resp.Request.URL.Parse(attrVal(n, "href")); err == nil && href.Host != "" {
+				meta.Canonical = href.String()
+			}
+		}
 		// fallback <title>
 		if n.Type == html.ElementNode && n.Data == "title" && meta.Title == "" {
 			if n.FirstChild != nil {
@@ -70,6 +82,7 @@ func scrapeOG(rawURL string) (*OGMeta, error) {
 		}
 	}
 	walk(doc)
+	meta.Ingredients, meta.Recipe = recipe.FromSchema(schemaorg.Extract(doc))
 	return meta, nil
 }
 
@@ -96,7 +109,6 @@ func PreviewHandler() func(http.ResponseWriter, *http.Request) {
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
-		fmt.Fprintf(w, `{"title":%q,"description":%q,"image":%q}`,
-			meta.Title, meta.Description, meta.Image)
+		json.NewEncoder(w).Encode(meta)
 	}
 }
@@ -0,0 +1,32 @@
+package link
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// La fiche recette publiée en JSON-LD est importée avec l'aperçu
+func TestScrapeOG_Recipe(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+<meta property="og:title" content="Crêpes faciles">
+<script type="application/ld+json">{"@context":"https://schema.org","@type":"Recipe",
+ "recipeIngredient":["250 g de farine","4 œufs"],"recipeYield":"4 personnes",
+ "prepTime":"PT10M","recipeInstructions":[{"@type":"HowToStep","text":"Mélanger."}]}</script>
+</head></html>`)
+	}))
+	defer srv.Close()
+
+	meta, err := scrapeOG(srv.URL)
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if meta.Title != "Crêpes faciles" || len(meta.Ingredients) != 2 || meta.Ingredients[1] != "4 œufs" {
+		t.Errorf("unexpected meta: %+v", meta)
+	}
+	if meta.Recipe == nil || meta.Recipe.Servings != 4 || meta.Recipe.PrepMinutes != 10 || len(meta.Recipe.Instructions) != 1 {
+		t.Errorf("unexpected recipe: %+v", meta.Recipe)
+	}
+}
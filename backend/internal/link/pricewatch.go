@@ -0,0 +1,138 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/price"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxPriceHistory limite les relevés retournés par PriceHistory
+const maxPriceHistory = 1000
+
+var (
+	// ErrNotWatchable est retourné pour un lien sans page web dont suivre le prix
+	ErrNotWatchable = errors.New("link has no web page to watch")
+	// ErrInvalidThreshold est retourné pour un seuil d'alerte négatif
+	ErrInvalidThreshold = errors.New("invalid price threshold")
+)
+
+// PriceWatch est le suivi du prix de la page d'un lien, relevé
+// périodiquement par le job pricewatch
+type PriceWatch struct {
+	UserID string `bson:"user_id" json:"user_id"` // destinataire des alertes
+	// Threshold déclenche une alerte quand le prix passe à ce montant ou
+	// moins, en centimes ; à 0, toute baisse est signalée
+	Threshold int64         `bson:"threshold"             json:"threshold"`
+	Current   *price.Amount `bson:"current,omitempty"     json:"current,omitempty"` // dernier prix relevé
+	CheckedAt *time.Time    `bson:"checked_at,omitempty"  json:"checked_at,omitempty"`
+	// AlertedCents est le prix de la dernière alerte sous le seuil, pour ne
+	// pas la répéter tant que le prix ne baisse pas davantage
+	AlertedCents int64     `bson:"alerted_cents,omitempty" json:"-"`
+	CreatedAt    time.Time `bson:"created_at"              json:"created_at"`
+}
+
+// PricePoint est un prix relevé sur la page d'un lien. Un relevé n'est
+// enregistré que si le prix a changé depuis le précédent.
+type PricePoint struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	LinkID     string             `bson:"link_id"`
+	Amount     price.Amount       `bson:"amount"`
+	ObservedAt time.Time          `bson:"observed_at"`
+}
+
+// WatchPrice active, ou modifie, le suivi du prix d'un lien par un
+// utilisateur qui peut le modifier. Les relevés déjà faits sont conservés.
+func (s *Service) WatchPrice(ctx context.Context, linkID, userID string, threshold int64) (*Link, error) {
+	if threshold < 0 {
+		return nil, ErrInvalidThreshold
+	}
+	l, err := s.editableLink(ctx, linkID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(l.URL, "http://") && !strings.HasPrefix(l.URL, "https://") {
+		return nil, ErrNotWatchable
+	}
+	set := bson.M{
+		"price_watch.user_id":   userID,
+		"price_watch.threshold": threshold,
+	}
+	if l.PriceWatch == nil {
+		set["price_watch.created_at"] = time.Now()
+	}
+	var updated Link
+	err = s.col.FindOneAndUpdate(ctx,
+		bson.M{"_id": l.ID, "deleted_at": nil},
+		bson.M{"$set": set, "$unset": bson.M{"price_watch.alerted_cents": ""}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrLinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// UnwatchPrice arrête le suivi du prix d'un lien. L'historique est conservé.
+func (s *Service) UnwatchPrice(ctx context.Context, linkID, userID string) error {
+	l, err := s.editableLink(ctx, linkID, userID)
+	if err != nil {
+		return err
+	}
+	_, err = s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$unset": bson.M{"price_watch": ""}})
+	return err
+}
+
+// PriceHistory retourne les prix relevés sur la page d'un lien visible par
+// l'utilisateur, du plus ancien au plus récent
+func (s *Service) PriceHistory(ctx context.Context, linkID, userID string) ([]PricePoint, error) {
+	if _, err := s.Get(ctx, linkID, userID); err != nil {
+		return nil, ErrLinkNotFound
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "observed_at", Value: -1}}).SetLimit(maxPriceHistory)
+	cursor, err := s.historyCol.Find(ctx, bson.M{"link_id": linkID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	points := []PricePoint{}
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, err
+	}
+	// Les plus récents ont été lus d'abord pour garder la fin de l'historique
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	return points, nil
+}
+
+// editableLink charge un lien que l'utilisateur peut modifier : le sien ou
+// celui d'un dossier dont il est éditeur
+func (s *Service) editableLink(ctx context.Context, linkID, userID string) (*Link, error) {
+	id, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return nil, ErrLinkNotFound
+	}
+	var l Link
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&l); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	if l.OwnerID != userID && !s.canEditFolder(ctx, l.FolderID, userID) {
+		if s.canAccessLink(ctx, &l, userID) {
+			return nil, ErrNotAuthorized
+		}
+		return nil, ErrLinkNotFound
+	}
+	return &l, nil
+}
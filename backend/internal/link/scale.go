@@ -0,0 +1,80 @@
+package link
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tribbae/backend/internal/recipe"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrServingsUnknown est retourné quand on adapte une recette dont le nombre
+// de parts n'est pas connu et n'a pas été fourni
+var ErrServingsUnknown = errors.New("recipe servings unknown")
+
+// ErrInvalidServings est retourné pour un nombre de parts négatif ou nul
+var ErrInvalidServings = errors.New("servings must be positive")
+
+// ingredientFields retourne les ingrédients et leur forme analysée, à écrire ensemble
+func (l *Link) ingredientFields() bson.M {
+	return bson.M{
+		"ingredients":        nonNil(l.Ingredients),
+		"parsed_ingredients": recipe.ParseIngredients(l.Ingredients),
+	}
+}
+
+// ScaleRecipe retourne les ingrédients du lien pour servings parts. Le nombre
+// de parts d'origine est celui de la fiche recette, ou baseServings s'il est
+// renseigné. Avec metric, les unités impériales sont converties.
+func (s *Service) ScaleRecipe(ctx context.Context, linkID, userID string, servings, baseServings int32, metric bool) ([]recipe.Ingredient, int32, error) {
+	if servings <= 0 || baseServings < 0 {
+		return nil, 0, ErrInvalidServings
+	}
+	l, err := s.Get(ctx, linkID, userID)
+	if err != nil {
+		return nil, 0, ErrLinkNotFound
+	}
+	if baseServings == 0 && l.Recipe != nil {
+		baseServings = l.Recipe.Servings
+	}
+	if baseServings == 0 {
+		return nil, 0, ErrServingsUnknown
+	}
+
+	parsed := l.ParsedIngredients
+	if len(parsed) == 0 {
+		parsed = recipe.ParseIngredients(l.Ingredients)
+	}
+	factor := float64(servings) / float64(baseServings)
+	out := make([]recipe.Ingredient, len(parsed))
+	for i, ing := range parsed {
+		if metric {
+			ing = ing.Metric()
+		}
+		out[i] = ing.Scale(factor)
+	}
+	return out, baseServings, nil
+}
+
+// BackfillParsedIngredients analyse les ingrédients des liens créés avant
+// l'ajout de parsed_ingredients
+func (s *Service) BackfillParsedIngredients(ctx context.Context) error {
+	filter := bson.M{"ingredients.0": bson.M{"$exists": true}, "parsed_ingredients": bson.M{"$exists": false}}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "ingredients": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l Link
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		set := bson.M{"parsed_ingredients": recipe.ParseIngredients(l.Ingredients)}
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": set}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
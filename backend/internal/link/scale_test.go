@@ -0,0 +1,58 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tribbae/backend/internal/recipe"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Les ingrédients sont adaptés au nombre de parts demandé
+func TestScaleRecipe(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	created, err := svc.Create(ctx, ownerID, &Link{
+		Title:       "Crêpes",
+		Ingredients: []string{"250 g de farine", "1/2 c. à soupe d'huile", "1 cup milk", "sel"},
+		Recipe:      &recipe.Recipe{Servings: 4},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if len(created.ParsedIngredients) != 4 || created.ParsedIngredients[0].Unit != "g" {
+		t.Fatalf("parsed ingredients = %+v", created.ParsedIngredients)
+	}
+
+	scaled, base, err := svc.ScaleRecipe(ctx, created.ID.Hex(), ownerID, 8, 0, true)
+	if err != nil {
+		t.Fatalf("scale: %v", err)
+	}
+	if base != 4 {
+		t.Errorf("base servings = %d, want 4", base)
+	}
+	want := []string{"500 g de farine", "1 c. à soupe d'huile", "480 ml de milk", "sel"}
+	for i, ing := range scaled {
+		if ing.String() != want[i] {
+			t.Errorf("ingredient %d = %q, want %q", i, ing.String(), want[i])
+		}
+	}
+
+	// Sans fiche recette, le nombre de parts d'origine doit être fourni
+	plain, err := svc.Create(ctx, ownerID, &Link{Title: "Soupe", Ingredients: []string{"1 l d'eau"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, _, err := svc.ScaleRecipe(ctx, plain.ID.Hex(), ownerID, 6, 0, false); !errors.Is(err, ErrServingsUnknown) {
+		t.Errorf("err = %v, want ErrServingsUnknown", err)
+	}
+	if scaled, _, err := svc.ScaleRecipe(ctx, plain.ID.Hex(), ownerID, 6, 2, false); err != nil || scaled[0].String() != "3 l d'eau" {
+		t.Errorf("scaled = %v, %v", scaled, err)
+	}
+}
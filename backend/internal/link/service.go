@@ -3,14 +3,26 @@ package link
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
+	"github.com/tribbae/backend/internal/agerange"
+	"github.com/tribbae/backend/internal/etag"
+	"github.com/tribbae/backend/internal/geo"
+	"github.com/tribbae/backend/internal/pagetoken"
+	"github.com/tribbae/backend/internal/price"
+	"github.com/tribbae/backend/internal/recipe"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// ErrFolderFrozen est retourné quand on tente de modifier les liens d'un dossier gelé
+var ErrFolderFrozen = errors.New("folder is frozen")
+
 type Link struct {
 	ID              primitive.ObjectID `bson:"_id,omitempty"    json:"id"`
 	OwnerID         string             `bson:"owner_id"         json:"owner_id"`
@@ -32,6 +44,46 @@ type Link struct {
 	Favorite        bool               `bson:"favorite"         json:"favorite"`
 	CreatedAt       time.Time          `bson:"created_at"       json:"created_at"`
 	UpdatedAt       time.Time          `bson:"updated_at"       json:"updated_at"`
+	// Version est incrémentée à chaque modification (etag de l'API)
+	Version int64 `bson:"version" json:"version"`
+	// DeletedAt est renseigné quand le lien est dans la corbeille.
+	// DeletedWithFolder indique qu'il y est parti avec son dossier.
+	DeletedAt         *time.Time `bson:"deleted_at,omitempty"          json:"deleted_at,omitempty"`
+	DeletedWithFolder bool       `bson:"deleted_with_folder,omitempty" json:"deleted_with_folder,omitempty"`
+	// EventAt est event_date normalisé en date (le web l'envoie en secondes,
+	// Android en millisecondes) ; il sert aux filtres et au tri.
+	EventAt *time.Time `bson:"event_at,omitempty" json:"event_at,omitempty"`
+	// CanonicalURL est l'URL annoncée par la page (<link rel=canonical>) et
+	// CanonicalKey la clé normalisée qui sert à détecter les doublons.
+	CanonicalURL string `bson:"canonical_url,omitempty" json:"canonical_url,omitempty"`
+	CanonicalKey string `bson:"canonical_key,omitempty" json:"-"`
+	// LinkStatus est le résultat de la dernière vérification de l'URL (linkcheck)
+	LinkStatus *LinkStatus `bson:"link_status,omitempty" json:"link_status,omitempty"`
+	// Recipe est la fiche importée du schema.org Recipe de la page
+	Recipe *recipe.Recipe `bson:"recipe,omitempty" json:"recipe,omitempty"`
+	// ParsedIngredients est Ingredients analysé (quantité, unité, ingrédient)
+	ParsedIngredients []recipe.Ingredient `bson:"parsed_ingredients,omitempty" json:"parsed_ingredients,omitempty"`
+	// Geo sont les coordonnées de Location, absentes si le lieu n'a pas été
+	// reconnu ; GeocodedLocation est le texte qui a été géocodé.
+	Geo              *geo.Point `bson:"geo,omitempty"               json:"geo,omitempty"`
+	GeocodedLocation string     `bson:"geocoded_location,omitempty" json:"-"`
+	// Ages est AgeRange analysé, null si la tranche n'est pas reconnue
+	Ages *agerange.Range `bson:"ages" json:"ages,omitempty"`
+	// PriceAmount est Price analysé, null si le prix n'est pas reconnu.
+	// Purchased indique que le cadeau ou l'article a été acheté.
+	PriceAmount *price.Amount `bson:"price_amount" json:"price_amount,omitempty"`
+	Purchased   bool          `bson:"purchased"    json:"purchased"`
+	// PriceWatch est le suivi du prix de la page, absent s'il n'est pas activé
+	PriceWatch *PriceWatch `bson:"price_watch,omitempty" json:"price_watch,omitempty"`
+}
+
+// LinkStatus décrit l'état de l'URL d'un lien lors de sa dernière vérification
+type LinkStatus struct {
+	HTTPStatus int32     `bson:"http_status"         json:"http_status"`
+	FinalURL   string    `bson:"final_url,omitempty" json:"final_url,omitempty"` // après redirections
+	CheckedAt  time.Time `bson:"checked_at"          json:"checked_at"`
+	Broken     bool      `bson:"broken"              json:"broken"`
+	Reason     string    `bson:"reason,omitempty"    json:"reason,omitempty"` // voir linkcheck.Reason*
 }
 
 type LinkLike struct {
@@ -46,6 +98,9 @@ type Service struct {
 	folderCol   *mongo.Collection
 	linkLikeCol *mongo.Collection
 	userCol     *mongo.Collection
+	childCol    *mongo.Collection
+	historyCol  *mongo.Collection // prix relevés par le job pricewatch
+	geocoder    geo.Geocoder
 }
 
 func NewService(col *mongo.Collection, folderCol *mongo.Collection) *Service {
@@ -54,12 +109,22 @@ func NewService(col *mongo.Collection, folderCol *mongo.Collection) *Service {
 		folderCol:   folderCol,
 		linkLikeCol: col.Database().Collection("link_likes"),
 		userCol:     col.Database().Collection("users"),
+		childCol:    col.Database().Collection("children"),
+		historyCol:  col.Database().Collection("price_history"),
+		geocoder:    geo.DefaultGazetteer(),
 	}
 }
 
-// accessibleFolderIDs retourne les IDs de dossiers auxquels l'utilisateur a accès
-func (s *Service) accessibleFolderIDs(ctx context.Context, userID string) ([]string, error) {
+// SetGeocoder remplace le géocodeur des lieux, par défaut le répertoire des
+// communes intégré
+func (s *Service) SetGeocoder(g geo.Geocoder) {
+	s.geocoder = g
+}
+
+// AccessibleFolderIDs retourne les IDs de dossiers auxquels l'utilisateur a accès
+func (s *Service) AccessibleFolderIDs(ctx context.Context, userID string) ([]string, error) {
 	filter := bson.M{
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"owner_id": userID},
 			bson.M{"collaborators.user_id": userID},
@@ -97,7 +162,8 @@ func (s *Service) canAccessLink(ctx context.Context, l *Link, userID string) boo
 	}
 	// Vérifier si le dossier est public ou si l'utilisateur est collaborateur
 	count, _ := s.folderCol.CountDocuments(ctx, bson.M{
-		"_id": fid,
+		"_id":        fid,
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"visibility": "public"},
 			bson.M{"collaborators.user_id": userID},
@@ -116,7 +182,8 @@ func (s *Service) canEditFolder(ctx context.Context, folderID, userID string) bo
 		return false
 	}
 	count, _ := s.folderCol.CountDocuments(ctx, bson.M{
-		"_id": fid,
+		"_id":        fid,
+		"deleted_at": nil,
 		"$or": bson.A{
 			bson.M{"owner_id": userID},
 			bson.M{"collaborators": bson.M{"$elemMatch": bson.M{"user_id": userID, "role": "editor"}}},
@@ -125,7 +192,20 @@ func (s *Service) canEditFolder(ctx context.Context, folderID, userID string) bo
 	return count > 0
 }
 
+// folderFrozen vérifie si un dossier est gelé (lecture seule)
+func (s *Service) folderFrozen(ctx context.Context, folderID string) bool {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return false
+	}
+	count, _ := s.folderCol.CountDocuments(ctx, bson.M{"_id": fid, "frozen": true})
+	return count > 0
+}
+
 func (s *Service) Create(ctx context.Context, ownerID string, l *Link) (*Link, error) {
+	if l.FolderID != "" && s.folderFrozen(ctx, l.FolderID) {
+		return nil, ErrFolderFrozen
+	}
 	l.ID = primitive.NewObjectID()
 	l.OwnerID = ownerID
 	l.CreatedAt = time.Now()
@@ -136,6 +216,13 @@ func (s *Service) Create(ctx context.Context, ownerID string, l *Link) (*Link, e
 	if l.Ingredients == nil {
 		l.Ingredients = []string{}
 	}
+	l.ParsedIngredients = recipe.ParseIngredients(l.Ingredients)
+	l.EventAt = eventTime(l.EventDate)
+	l.Ages = parseAges(l.AgeRange)
+	l.PriceAmount = parsePrice(l.Price)
+	l.URL = NormalizeURL(l.URL)
+	l.CanonicalKey = l.canonicalKey()
+	s.locate(ctx, l)
 	// Default to private if visibility is not set
 	if l.Visibility == "" {
 		l.Visibility = "private"
@@ -143,6 +230,7 @@ func (s *Service) Create(ctx context.Context, ownerID string, l *Link) (*Link, e
 	if _, err := s.col.InsertOne(ctx, l); err != nil {
 		return nil, err
 	}
+	s.markFolderSearchText(ctx, l.FolderID)
 	return l, nil
 }
 
@@ -152,7 +240,7 @@ func (s *Service) Get(ctx context.Context, linkID, userID string) (*Link, error)
 		return nil, errors.New("invalid link id")
 	}
 	var l Link
-	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&l); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&l); err != nil {
 		return nil, err
 	}
 	if !s.canAccessLink(ctx, &l, userID) {
@@ -161,68 +249,328 @@ func (s *Service) Get(ctx context.Context, linkID, userID string) (*Link, error)
 	return &l, nil
 }
 
-func (s *Service) List(ctx context.Context, ownerID, folderID string) ([]*Link, error) {
-	if folderID != "" {
-		// Si un dossier est spécifié, retourner ses liens (si accès)
-		return s.listByFolder(ctx, folderID)
+// ListOptions regroupe les filtres, le tri et la pagination de List
+type ListOptions struct {
+	FolderID    string
+	Category    string
+	Tags        []string
+	AllTags     bool // tous les tags plutôt qu'au moins un
+	Favorite    bool
+	MinRating   int32
+	MaxRating   int32
+	EventAfter  *time.Time // inclus
+	EventBefore *time.Time // exclu
+	Visibility  string
+	OwnerID     string
+	Broken      bool   // seulement les liens dont l'URL ne répond plus
+	ForMyKids   bool   // adaptés à l'âge actuel d'un des enfants, ou sans âge conseillé
+	MinPrice    int64  // en centimes, 0 : pas de borne
+	MaxPrice    int64  // en centimes, 0 : pas de borne
+	Currency    string // seulement les prix dans cette devise ISO 4217
+	SortBy      string // un des SortBy*, SortByCreatedAt si vide
+	Descending  bool
+	PageSize    int32
+	PageToken   string
+}
+
+// Champs de tri acceptés par List
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByRating    = "rating"
+	SortByEventAt   = "event_at"
+	SortByTitle     = "title"
+)
+
+// maxListPageSize borne les pages demandées. Sans page_size, la liste est
+// retournée entière, comme avant la pagination : les clients qui ne
+// connaissent pas page_token ne perdent pas de liens.
+const maxListPageSize = 500
+
+// ErrInvalidPageToken est retourné quand le token de pagination est illisible
+var ErrInvalidPageToken = pagetoken.ErrInvalid
+
+// List retourne une page de liens : ceux d'un dossier si opts.FolderID est
+// renseigné, sinon les liens de l'utilisateur et ceux de ses dossiers partagés.
+// Sans opts.PageSize, tous les liens sont retournés en une fois.
+func (s *Service) List(ctx context.Context, userID string, opts ListOptions) ([]*Link, string, error) {
+	pageSize := max(opts.PageSize, 0)
+	if pageSize > maxListPageSize {
+		pageSize = maxListPageSize
 	}
-	// Sinon, retourner les liens propres + ceux des dossiers partagés
-	folderIDs, err := s.accessibleFolderIDs(ctx, ownerID)
-	if err != nil {
-		return nil, err
+	sortBy := opts.SortBy
+	if sortBy == "" {
+		sortBy = SortByCreatedAt
 	}
 
-	// Construire le filtre : toujours inclure les liens propres
-	// N'ajouter le filtre folder_id que si l'utilisateur a des dossiers accessibles
-	conditions := bson.A{
-		bson.M{"owner_id": ownerID},
+	and := bson.A{bson.M{"deleted_at": nil}}
+	if opts.FolderID != "" {
+		and = append(and, bson.M{"folder_id": opts.FolderID})
+	} else {
+		folderIDs, err := s.AccessibleFolderIDs(ctx, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		// Toujours inclure les liens propres ; n'ajouter le filtre folder_id
+		// que si l'utilisateur a des dossiers accessibles
+		conditions := bson.A{bson.M{"owner_id": userID}}
+		if len(folderIDs) > 0 {
+			conditions = append(conditions, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+		}
+		and = append(and, bson.M{"$or": conditions})
 	}
-	if len(folderIDs) > 0 {
-		conditions = append(conditions, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+	and = append(and, opts.filters()...)
+	if opts.ForMyKids {
+		// Sans enfant enregistré, le filtre n'écarte rien
+		ages, err := s.childrenAges(ctx, userID, time.Now())
+		if err != nil {
+			return nil, "", err
+		}
+		if len(ages) > 0 {
+			and = append(and, agesFilter(ages))
+		}
 	}
-	filter := bson.M{"$or": conditions}
 
-	cursor, err := s.col.Find(ctx, filter)
+	// Le token n'est valable que pour le même tri et les mêmes filtres
+	scope := opts.scope(sortBy)
+	if opts.PageToken != "" {
+		cursor, err := pagetoken.DecodeScoped(scope, opts.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		and = append(and, pagetoken.Seek(sortBy, opts.Descending, cursor))
+	}
+
+	dir := 1
+	if opts.Descending {
+		dir = -1
+	}
+	findOpts := options.Find().SetSort(bson.D{{Key: sortBy, Value: dir}, {Key: "_id", Value: dir}})
+	if pageSize > 0 {
+		findOpts.SetLimit(int64(pageSize) + 1)
+	}
+	cursor, err := s.col.Find(ctx, bson.M{"$and": and}, findOpts)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer cursor.Close(ctx)
 	var links []*Link
 	if err := cursor.All(ctx, &links); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	// Toujours retourner un slice vide plutôt que nil
 	if links == nil {
 		links = []*Link{}
 	}
-	return links, nil
+
+	var nextToken string
+	if pageSize > 0 && len(links) > int(pageSize) {
+		links = links[:pageSize]
+		last := links[len(links)-1]
+		if nextToken, err = pagetoken.EncodeScoped(scope, last.sortValue(sortBy), last.ID); err != nil {
+			return nil, "", err
+		}
+	}
+	return links, nextToken, nil
 }
 
-func (s *Service) listByFolder(ctx context.Context, folderID string) ([]*Link, error) {
-	cursor, err := s.col.Find(ctx, bson.M{"folder_id": folderID})
-	if err != nil {
-		return nil, err
+// scope résume le tri et les filtres d'une liste pour pagetoken.Scope
+func (o ListOptions) scope(sortBy string) string {
+	timeParam := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339Nano)
 	}
-	defer cursor.Close(ctx)
-	var links []*Link
-	if err := cursor.All(ctx, &links); err != nil {
-		return nil, err
+	return pagetoken.Scope(
+		sortBy, fmt.Sprint(o.Descending),
+		o.FolderID, o.Category, strings.Join(o.Tags, ","), fmt.Sprint(o.AllTags), fmt.Sprint(o.Favorite),
+		fmt.Sprint(o.MinRating), fmt.Sprint(o.MaxRating), timeParam(o.EventAfter), timeParam(o.EventBefore),
+		o.Visibility, o.OwnerID, fmt.Sprint(o.Broken), fmt.Sprint(o.ForMyKids),
+		fmt.Sprint(o.MinPrice), fmt.Sprint(o.MaxPrice), o.Currency,
+	)
+}
+
+// filters traduit les critères de ListOptions en conditions MongoDB
+func (o ListOptions) filters() bson.A {
+	var f bson.A
+	if o.Category != "" {
+		f = append(f, bson.M{"category": o.Category})
 	}
-	if links == nil {
-		links = []*Link{}
+	if len(o.Tags) > 0 {
+		op := "$in"
+		if o.AllTags {
+			op = "$all"
+		}
+		f = append(f, bson.M{"tags": bson.M{op: o.Tags}})
 	}
-	return links, nil
+	if o.Favorite {
+		f = append(f, bson.M{"favorite": true})
+	}
+	if o.MinRating > 0 || o.MaxRating > 0 {
+		r := bson.M{}
+		if o.MinRating > 0 {
+			r["$gte"] = o.MinRating
+		}
+		if o.MaxRating > 0 {
+			r["$lte"] = o.MaxRating
+		}
+		f = append(f, bson.M{"rating": r})
+	}
+	if o.EventAfter != nil || o.EventBefore != nil {
+		r := bson.M{}
+		if o.EventAfter != nil {
+			r["$gte"] = *o.EventAfter
+		}
+		if o.EventBefore != nil {
+			r["$lt"] = *o.EventBefore
+		}
+		f = append(f, bson.M{"event_at": r})
+	}
+	if o.Visibility != "" {
+		f = append(f, bson.M{"visibility": o.Visibility})
+	}
+	if o.OwnerID != "" {
+		f = append(f, bson.M{"owner_id": o.OwnerID})
+	}
+	if o.Broken {
+		f = append(f, bson.M{"link_status.broken": true})
+	}
+	if o.MinPrice > 0 || o.MaxPrice > 0 {
+		r := bson.M{}
+		if o.MinPrice > 0 {
+			r["$gte"] = o.MinPrice
+		}
+		if o.MaxPrice > 0 {
+			r["$lte"] = o.MaxPrice
+		}
+		f = append(f, bson.M{"price_amount.cents": r})
+	}
+	if o.Currency != "" {
+		f = append(f, bson.M{"price_amount.currency": o.Currency})
+	}
+	return f
+}
+
+// sortValue retourne la valeur du champ de tri, pour le token de pagination
+func (l *Link) sortValue(sortBy string) any {
+	switch sortBy {
+	case SortByUpdatedAt:
+		return l.UpdatedAt
+	case SortByRating:
+		return l.Rating
+	case SortByEventAt:
+		if l.EventAt == nil {
+			return nil
+		}
+		return *l.EventAt
+	case SortByTitle:
+		return l.Title
+	default:
+		return l.CreatedAt
+	}
+}
+
+// eventTime convertit event_date en date. Le web l'envoie en secondes et
+// Android en millisecondes ; 0 signifie que le lien n'a pas de date.
+func eventTime(v int64) *time.Time {
+	if v == 0 {
+		return nil
+	}
+	var t time.Time
+	if v > 1e11 || v < -1e11 {
+		t = time.UnixMilli(v)
+	} else {
+		t = time.Unix(v, 0)
+	}
+	t = t.UTC()
+	return &t
+}
+
+// AllDayEvent indique si la date de l'événement est une journée entière : une
+// date à minuit UTC vient d'un sélecteur de date (web et Android), sans heure.
+func (l *Link) AllDayEvent() bool {
+	return l.EventAt != nil && l.EventAt.UTC().Equal(l.EventAt.UTC().Truncate(24*time.Hour))
 }
 
-func (s *Service) Update(ctx context.Context, linkID, userID string, l *Link) (*Link, error) {
+// ErrInvalidUpdateMask est retourné quand un masque de mise à jour cite un
+// champ inconnu ou non modifiable
+var ErrInvalidUpdateMask = errors.New("invalid update mask")
+
+// updatableFields associe chaque champ modifiable de l'API (nom du champ proto)
+// aux valeurs à écrire en base
+var updatableFields = map[string]func(l *Link) bson.M{
+	"folder_id":        func(l *Link) bson.M { return bson.M{"folder_id": l.FolderID} },
+	"title":            func(l *Link) bson.M { return bson.M{"title": l.Title} },
+	"url":              func(l *Link) bson.M { return l.urlFields() },
+	"description":      func(l *Link) bson.M { return bson.M{"description": l.Description} },
+	"category":         func(l *Link) bson.M { return bson.M{"category": l.Category} },
+	"tags":             func(l *Link) bson.M { return bson.M{"tags": nonNil(l.Tags)} },
+	"age_range":        func(l *Link) bson.M { return l.ageRangeFields() },
+	"location":         func(l *Link) bson.M { return bson.M{"location": l.Location} },
+	"price":            func(l *Link) bson.M { return bson.M{"price": l.Price, "price_amount": parsePrice(l.Price)} },
+	"image_url":        func(l *Link) bson.M { return bson.M{"image_url": l.ImageURL} },
+	"event_date":       func(l *Link) bson.M { return bson.M{"event_date": l.EventDate, "event_at": eventTime(l.EventDate)} },
+	"reminder_enabled": func(l *Link) bson.M { return bson.M{"reminder_enabled": l.ReminderEnabled} },
+	"rating":           func(l *Link) bson.M { return bson.M{"rating": l.Rating} },
+	"ingredients":      func(l *Link) bson.M { return l.ingredientFields() },
+	"visibility":       func(l *Link) bson.M { return bson.M{"visibility": l.Visibility} },
+	"favorite":         func(l *Link) bson.M { return bson.M{"favorite": l.Favorite} },
+	"purchased":        func(l *Link) bson.M { return bson.M{"purchased": l.Purchased} },
+}
+
+// fullUpdate est la liste des champs écrits sans masque (remplacement complet,
+// le favori restant géré par ToggleFavorite et l'achat par son masque, que
+// les anciens clients n'envoient pas)
+var fullUpdate = []string{
+	"folder_id", "title", "url", "description", "category", "tags", "age_range",
+	"location", "price", "image_url", "event_date", "reminder_enabled", "rating",
+	"ingredients", "visibility",
+}
+
+func nonNil(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+// Update modifie les champs paths du lien avec les valeurs de l'argument l.
+// Sans paths (ou avec "*"), tous les champs sont remplacés. Si etagValue est
+// renseigné, la modification n'est faite que si le lien n'a pas changé depuis.
+func (s *Service) Update(ctx context.Context, linkID, userID string, l *Link, paths []string, etagValue string) (*Link, error) {
 	id, err := primitive.ObjectIDFromHex(linkID)
 	if err != nil {
 		return nil, errors.New("invalid link id")
 	}
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		paths = fullUpdate
+	}
+	set := bson.M{}
+	for _, p := range paths {
+		field, ok := updatableFields[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidUpdateMask, p)
+		}
+		for k, v := range field(l) {
+			set[k] = v
+		}
+	}
+
+	filter := bson.M{"_id": id, "deleted_at": nil}
+	if etagValue != "" {
+		versionFilter, err := etag.Filter(etagValue)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range versionFilter {
+			filter[k] = v
+		}
+	}
 
 	// Charger le lien existant
 	var existing Link
-	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&existing); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&existing); err != nil {
 		return nil, err
 	}
 
@@ -230,22 +578,45 @@ func (s *Service) Update(ctx context.Context, linkID, userID string, l *Link) (*
 	if existing.OwnerID != userID && !s.canEditFolder(ctx, existing.FolderID, userID) {
 		return nil, errors.New("not authorized")
 	}
+	// Une URL inchangée garde l'URL canonique trouvée lors de la création
+	if u, ok := set["url"]; ok && u == existing.URL && l.CanonicalURL == "" {
+		set["canonical_url"] = existing.CanonicalURL
+		set["canonical_key"] = existing.canonicalKey()
+		delete(set, "link_status")
+	}
+	if loc, ok := set["location"]; ok && loc != existing.GeocodedLocation {
+		s.locate(ctx, l)
+		set["geo"] = l.Geo
+		set["geocoded_location"] = l.GeocodedLocation
+	}
+	targetFolder := existing.FolderID
+	if v, ok := set["folder_id"]; ok {
+		targetFolder = v.(string)
+	}
+	if (existing.FolderID != "" && s.folderFrozen(ctx, existing.FolderID)) ||
+		(targetFolder != existing.FolderID && targetFolder != "" && s.folderFrozen(ctx, targetFolder)) {
+		return nil, ErrFolderFrozen
+	}
 
-	l.UpdatedAt = time.Now()
-	update := bson.M{"$set": bson.M{
-		"folder_id": l.FolderID, "title": l.Title, "url": l.URL,
-		"description": l.Description, "category": l.Category,
-		"tags": l.Tags, "age_range": l.AgeRange, "location": l.Location,
-		"price": l.Price, "image_url": l.ImageURL, "event_date": l.EventDate,
-		"reminder_enabled": l.ReminderEnabled, "rating": l.Rating,
-		"ingredients": l.Ingredients, "visibility": l.Visibility, "updated_at": l.UpdatedAt,
-	}}
-	if _, err := s.col.UpdateOne(ctx, bson.M{"_id": id}, update); err != nil {
+	set["updated_at"] = time.Now()
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	res, err := s.col.UpdateOne(ctx, filter, update)
+	if err != nil {
 		return nil, err
 	}
+	if res.MatchedCount == 0 {
+		return nil, etag.ErrMismatch
+	}
+	if searchTextChanged(set) {
+		s.markFolderSearchText(ctx, existing.FolderID)
+		if targetFolder != existing.FolderID {
+			s.markFolderSearchText(ctx, targetFolder)
+		}
+	}
 	return s.Get(ctx, linkID, userID)
 }
 
+// Delete place le lien dans la corbeille ; il reste restaurable jusqu'à la purge.
 func (s *Service) Delete(ctx context.Context, linkID, userID string) error {
 	id, err := primitive.ObjectIDFromHex(linkID)
 	if err != nil {
@@ -253,16 +624,296 @@ func (s *Service) Delete(ctx context.Context, linkID, userID string) error {
 	}
 
 	var existing Link
-	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&existing); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&existing); err != nil {
 		return err
 	}
 
 	if existing.OwnerID != userID && !s.canEditFolder(ctx, existing.FolderID, userID) {
 		return errors.New("not authorized")
 	}
+	if existing.FolderID != "" && s.folderFrozen(ctx, existing.FolderID) {
+		return ErrFolderFrozen
+	}
+
+	_, err = s.col.UpdateOne(ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{"deleted_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	s.markFolderSearchText(ctx, existing.FolderID)
+	return nil
+}
+
+// trashScope retourne le filtre des liens dont l'utilisateur gère la corbeille :
+// ses propres liens et ceux des dossiers qu'il possède.
+func (s *Service) trashScope(ctx context.Context, userID string) (bson.M, error) {
+	cursor, err := s.folderCol.Find(ctx, bson.M{"owner_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
 
-	_, err = s.col.DeleteOne(ctx, bson.M{"_id": id})
-	return err
+	var folderIDs []string
+	for cursor.Next(ctx) {
+		var f struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&f); err == nil {
+			folderIDs = append(folderIDs, f.ID.Hex())
+		}
+	}
+	conditions := bson.A{bson.M{"owner_id": userID}}
+	if len(folderIDs) > 0 {
+		conditions = append(conditions, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+	}
+	return bson.M{
+		"$or":                 conditions,
+		"deleted_at":          bson.M{"$ne": nil},
+		"deleted_with_folder": bson.M{"$ne": true},
+	}, nil
+}
+
+// ListTrash retourne les liens supprimés individuellement que l'utilisateur peut
+// restaurer. Les liens partis avec leur dossier sont gérés via le dossier.
+func (s *Service) ListTrash(ctx context.Context, userID string) ([]*Link, error) {
+	filter, err := s.trashScope(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{"deleted_at": -1})
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var links []*Link
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, err
+	}
+	if links == nil {
+		links = []*Link{}
+	}
+	return links, nil
+}
+
+// Restore sort un lien de la corbeille. Son dossier doit toujours exister hors corbeille.
+func (s *Service) Restore(ctx context.Context, linkID, userID string) (*Link, error) {
+	id, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return nil, errors.New("invalid link id")
+	}
+	var existing Link
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": bson.M{"$ne": nil}}).Decode(&existing); err != nil {
+		return nil, errors.New("link not found in trash")
+	}
+	if existing.DeletedWithFolder {
+		return nil, errors.New("link was deleted with its folder, restore the folder instead")
+	}
+	if existing.OwnerID != userID && !s.canEditFolder(ctx, existing.FolderID, userID) {
+		return nil, errors.New("not authorized")
+	}
+	if existing.FolderID != "" && !s.folderIsLive(ctx, existing.FolderID) {
+		return nil, errors.New("folder is in trash, restore it first")
+	}
+	if existing.FolderID != "" && s.folderFrozen(ctx, existing.FolderID) {
+		return nil, ErrFolderFrozen
+	}
+	_, err = s.col.UpdateOne(ctx,
+		bson.M{"_id": id},
+		bson.M{"$unset": bson.M{"deleted_at": ""}, "$set": bson.M{"updated_at": time.Now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.markFolderSearchText(ctx, existing.FolderID)
+	return s.Get(ctx, linkID, userID)
+}
+
+// EmptyTrash supprime définitivement les liens de la corbeille de l'utilisateur
+func (s *Service) EmptyTrash(ctx context.Context, userID string) (int64, error) {
+	filter, err := s.trashScope(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	res, err := s.col.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// folderIsLive vérifie qu'un dossier existe et n'est pas dans la corbeille
+func (s *Service) folderIsLive(ctx context.Context, folderID string) bool {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return false
+	}
+	count, _ := s.folderCol.CountDocuments(ctx, bson.M{"_id": fid, "deleted_at": nil})
+	return count > 0
+}
+
+// maxFolderSearchText borne la taille du texte dénormalisé sur un dossier
+const maxFolderSearchText = 32 * 1024
+
+// searchTextFields sont les champs d'un lien repris dans link_text
+var searchTextFields = []string{"title", "description", "tags", "folder_id"}
+
+// searchTextChanged indique si une modification touche le texte de
+// recherche du dossier (ou déplace le lien)
+func searchTextChanged(set bson.M) bool {
+	for _, f := range searchTextFields {
+		if _, ok := set[f]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// markFolderSearchText signale que le link_text d'un dossier est à
+// recalculer. Le calcul, qui relit tous les liens du dossier, est fait par
+// RefreshFolderSearchText : une rafale de modifications n'en coûte qu'un.
+// Un échec est journalisé sans faire échouer l'opération sur le lien.
+func (s *Service) markFolderSearchText(ctx context.Context, folderID string) {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return
+	}
+	if _, err := s.folderCol.UpdateOne(ctx, bson.M{"_id": fid}, bson.M{"$set": bson.M{"link_text_stale": true}}); err != nil {
+		log.Printf("ERROR: mark search text of folder %s: %v", folderID, err)
+	}
+}
+
+// RunFolderSearchText recalcule toutes les interval les textes de recherche
+// signalés par markFolderSearchText, jusqu'à l'annulation de ctx
+func (s *Service) RunFolderSearchText(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.RefreshFolderSearchText(ctx); err != nil {
+			log.Printf("ERROR: refresh folder search text: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshFolderSearchText recalcule le link_text des dossiers signalés et
+// retourne leur nombre. Chaque dossier est d'abord réclamé en retirant son
+// signal, pour qu'un seul serveur le traite ; une modification pendant le
+// calcul le signale de nouveau pour la passe suivante.
+func (s *Service) RefreshFolderSearchText(ctx context.Context) (int, error) {
+	cursor, err := s.folderCol.Find(ctx, bson.M{"link_text_stale": true}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, err
+	}
+	var folders []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &folders); err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, f := range folders {
+		res, err := s.folderCol.UpdateOne(ctx, bson.M{"_id": f.ID, "link_text_stale": true}, bson.M{"$unset": bson.M{"link_text_stale": ""}})
+		if err != nil {
+			return n, err
+		}
+		if res.ModifiedCount == 0 {
+			continue
+		}
+		s.refreshFolderSearchText(ctx, f.ID.Hex())
+		n++
+	}
+	return n, ctx.Err()
+}
+
+// refreshFolderSearchText recalcule le champ link_text d'un dossier (titres,
+// descriptions et tags de ses liens) utilisé par la recherche communautaire.
+// Un échec est journalisé et le dossier signalé de nouveau.
+func (s *Service) refreshFolderSearchText(ctx context.Context, folderID string) {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return
+	}
+	opts := options.Find().SetProjection(bson.M{"title": 1, "description": 1, "tags": 1})
+	cursor, err := s.col.Find(ctx, bson.M{"folder_id": folderID, "deleted_at": nil}, opts)
+	if err != nil {
+		log.Printf("ERROR: refresh search text of folder %s: %v", folderID, err)
+		s.markFolderSearchText(ctx, folderID)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	var b strings.Builder
+	for cursor.Next(ctx) && b.Len() < maxFolderSearchText {
+		var l struct {
+			Title       string   `bson:"title"`
+			Description string   `bson:"description"`
+			Tags        []string `bson:"tags"`
+		}
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		b.WriteString(l.Title)
+		b.WriteString(" ")
+		b.WriteString(l.Description)
+		b.WriteString(" ")
+		b.WriteString(strings.Join(l.Tags, " "))
+		b.WriteString("\n")
+	}
+	if _, err := s.folderCol.UpdateOne(ctx, bson.M{"_id": fid}, bson.M{"$set": bson.M{"link_text": b.String()}}); err != nil {
+		log.Printf("ERROR: refresh search text of folder %s: %v", folderID, err)
+		s.markFolderSearchText(ctx, folderID)
+	}
+}
+
+// BackfillFolderSearchText remplit link_text pour les dossiers publics qui n'en
+// ont pas encore (dossiers créés avant l'index de recherche).
+func (s *Service) BackfillFolderSearchText(ctx context.Context) error {
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cursor, err := s.folderCol.Find(ctx, bson.M{"visibility": "public", "link_text": bson.M{"$exists": false}}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var f struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&f); err == nil {
+			s.refreshFolderSearchText(ctx, f.ID.Hex())
+		}
+	}
+	return cursor.Err()
+}
+
+// BackfillEventAt remplit event_at pour les liens datés créés avant son ajout
+func (s *Service) BackfillEventAt(ctx context.Context) error {
+	filter := bson.M{"event_date": bson.M{"$ne": 0}, "event_at": bson.M{"$exists": false}}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "event_date": 1})
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var l struct {
+			ID        primitive.ObjectID `bson:"_id"`
+			EventDate int64              `bson:"event_date"`
+		}
+		if err := cursor.Decode(&l); err != nil {
+			continue
+		}
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID}, bson.M{"$set": bson.M{"event_at": eventTime(l.EventDate)}}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
 }
 
 // LikeLink ajoute un like à un lien
@@ -273,7 +924,7 @@ func (s *Service) LikeLink(ctx context.Context, linkID, userID string) (int32, e
 		return 0, errors.New("invalid link id")
 	}
 	var l Link
-	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&l); err != nil {
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&l); err != nil {
 		return 0, err
 	}
 
@@ -335,7 +986,7 @@ func (s *Service) ToggleFavorite(ctx context.Context, linkID, ownerID string) (b
 
 	// Récupérer le lien actuel
 	var link Link
-	err = s.col.FindOne(ctx, bson.M{"_id": id, "owner_id": ownerID}).Decode(&link)
+	err = s.col.FindOne(ctx, bson.M{"_id": id, "owner_id": ownerID, "deleted_at": nil}).Decode(&link)
 	if err != nil {
 		return false, err
 	}
@@ -345,7 +996,7 @@ func (s *Service) ToggleFavorite(ctx context.Context, linkID, ownerID string) (b
 	_, err = s.col.UpdateOne(
 		ctx,
 		bson.M{"_id": id, "owner_id": ownerID},
-		bson.M{"$set": bson.M{"favorite": newFavorite, "updated_at": time.Now()}},
+		bson.M{"$set": bson.M{"favorite": newFavorite, "updated_at": time.Now()}, "$inc": bson.M{"version": 1}},
 	)
 	if err != nil {
 		return false, err
@@ -360,7 +1011,7 @@ func (s *Service) ListCommunity(ctx context.Context, category string, limit int3
 		limit = 6
 	}
 
-	filter := bson.M{"visibility": "public"}
+	filter := bson.M{"visibility": "public", "deleted_at": nil}
 	if category != "" {
 		filter["category"] = category
 	}
@@ -389,7 +1040,7 @@ func (s *Service) ListNew(ctx context.Context, limit int32) ([]*Link, error) {
 	}
 
 	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit))
-	filter := bson.M{"visibility": "public"}
+	filter := bson.M{"visibility": "public", "deleted_at": nil}
 
 	cursor, err := s.col.Find(ctx, filter, opts)
 	if err != nil {
@@ -2,6 +2,7 @@ package link
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -344,7 +345,7 @@ func TestProperty_LinkUpdateTimestamp(t *testing.T) {
 				Visibility:  "public",
 			}
 			
-			resultLink, err := svc.Update(ctx, createdLink.ID.Hex(), userID, updatedLink)
+			resultLink, err := svc.Update(ctx, createdLink.ID.Hex(), userID, updatedLink, nil, "")
 			if err != nil {
 				t.Logf("Failed to update link: %v", err)
 				return false
@@ -387,3 +388,67 @@ func TestProperty_LinkUpdateTimestamp(t *testing.T) {
 	
 	properties.TestingRun(t)
 }
+
+// Les modifications des liens signalent le texte de recherche du dossier,
+// recalculé une seule fois par passe ; celles qui n'y touchent pas non
+func TestRefreshFolderSearchText(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	foldersCol := db.Collection("folders")
+	svc := NewService(db.Collection("links"), foldersCol)
+	ownerID := primitive.NewObjectID().Hex()
+	folderID := primitive.NewObjectID()
+	if _, err := foldersCol.InsertOne(ctx, bson.M{"_id": folderID, "owner_id": ownerID, "name": "Noël", "visibility": "public"}); err != nil {
+		t.Fatal(err)
+	}
+	linkText := func() (string, bool) {
+		var f struct {
+			LinkText string `bson:"link_text"`
+			Stale    bool   `bson:"link_text_stale"`
+		}
+		if err := foldersCol.FindOne(ctx, bson.M{"_id": folderID}).Decode(&f); err != nil {
+			t.Fatal(err)
+		}
+		return f.LinkText, f.Stale
+	}
+
+	lego, err := svc.Create(ctx, ownerID, &Link{FolderID: folderID.Hex(), Title: "Lego"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Create(ctx, ownerID, &Link{FolderID: folderID.Hex(), Title: "Puzzle"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, stale := linkText(); !stale {
+		t.Fatal("folder should be marked stale after link creation")
+	}
+	if n, err := svc.RefreshFolderSearchText(ctx); err != nil || n != 1 {
+		t.Fatalf("refresh = %d, %v; want the folder once", n, err)
+	}
+	if text, stale := linkText(); stale || !strings.Contains(text, "Lego") || !strings.Contains(text, "Puzzle") {
+		t.Errorf("link_text = %q, stale %v", text, stale)
+	}
+	if n, _ := svc.RefreshFolderSearchText(ctx); n != 0 {
+		t.Errorf("second refresh = %d, want 0", n)
+	}
+
+	lego.Favorite = true
+	if _, err := svc.Update(ctx, lego.ID.Hex(), ownerID, lego, []string{"favorite"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, stale := linkText(); stale {
+		t.Error("favorite toggle should not mark the search text")
+	}
+	lego.Title = "Lego Duplo"
+	if _, err := svc.Update(ctx, lego.ID.Hex(), ownerID, lego, []string{"title"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RefreshFolderSearchText(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := linkText(); !strings.Contains(text, "Duplo") {
+		t.Errorf("link_text after rename = %q", text)
+	}
+}
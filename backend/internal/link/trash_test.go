@@ -0,0 +1,82 @@
+package link
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Un lien supprimé disparaît des listes, apparaît dans la corbeille et peut être restauré
+func TestTrash_DeleteListRestore(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	linksCol := db.Collection("links")
+	foldersCol := db.Collection("folders")
+	svc := NewService(linksCol, foldersCol)
+
+	ownerID := primitive.NewObjectID().Hex()
+	folderID := primitive.NewObjectID()
+	if _, err := foldersCol.InsertOne(ctx, bson.M{
+		"_id":        folderID,
+		"owner_id":   ownerID,
+		"name":       "Noël",
+		"visibility": "private",
+		"created_at": time.Now(),
+		"updated_at": time.Now(),
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+
+	created, err := svc.Create(ctx, ownerID, &Link{FolderID: folderID.Hex(), Title: "Lego", Category: "LINK_CATEGORY_CADEAU"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := svc.Delete(ctx, created.ID.Hex(), ownerID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	if _, err := svc.Get(ctx, created.ID.Hex(), ownerID); err == nil {
+		t.Fatal("trashed link should not be returned by Get")
+	}
+	links, _, err := svc.List(ctx, ownerID, ListOptions{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(links) != 0 {
+		t.Fatalf("trashed link should not be listed, got %d links", len(links))
+	}
+
+	trashed, err := svc.ListTrash(ctx, ownerID)
+	if err != nil {
+		t.Fatalf("list trash: %v", err)
+	}
+	if len(trashed) != 1 || trashed[0].ID != created.ID {
+		t.Fatalf("expected the deleted link in trash, got %v", trashed)
+	}
+
+	// Un autre utilisateur ne peut pas restaurer le lien
+	if _, err := svc.Restore(ctx, created.ID.Hex(), primitive.NewObjectID().Hex()); err == nil {
+		t.Fatal("stranger should not be able to restore the link")
+	}
+
+	restored, err := svc.Restore(ctx, created.ID.Hex(), ownerID)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.DeletedAt != nil {
+		t.Fatal("restored link should not have deleted_at")
+	}
+
+	count, err := svc.EmptyTrash(ctx, ownerID)
+	if err != nil {
+		t.Fatalf("empty trash: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("restored link should not be purged, purged %d", count)
+	}
+}
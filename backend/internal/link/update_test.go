@@ -0,0 +1,55 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tribbae/backend/internal/etag"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Un masque ne modifie que les champs listés, et un etag périmé est refusé
+func TestUpdate_FieldMaskAndEtag(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	svc := NewService(db.Collection("links"), db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+
+	created, err := svc.Create(ctx, ownerID, &Link{
+		Title:       "Gâteau",
+		Tags:        []string{"dessert"},
+		Ingredients: []string{"farine", "œufs"},
+		ImageURL:    "https://example.com/gateau.jpg",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	firstEtag := etag.Format(created.Version)
+
+	updated, err := svc.Update(ctx, created.ID.Hex(), ownerID, &Link{Title: "Gâteau au yaourt"}, []string{"title"}, firstEtag)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Title != "Gâteau au yaourt" {
+		t.Errorf("title = %q", updated.Title)
+	}
+	if len(updated.Tags) != 1 || len(updated.Ingredients) != 2 || updated.ImageURL == "" {
+		t.Errorf("fields outside the mask were modified: %+v", updated)
+	}
+	if updated.Version != created.Version+1 {
+		t.Errorf("version = %d, want %d", updated.Version, created.Version+1)
+	}
+
+	// Un second éditeur qui a lu la version d'origine ne doit pas écraser la modification
+	_, err = svc.Update(ctx, created.ID.Hex(), ownerID, &Link{Title: "Autre titre"}, []string{"title"}, firstEtag)
+	if !errors.Is(err, etag.ErrMismatch) {
+		t.Fatalf("stale etag error = %v, want ErrMismatch", err)
+	}
+
+	if _, err := svc.Update(ctx, created.ID.Hex(), ownerID, &Link{}, []string{"owner_id"}, ""); !errors.Is(err, ErrInvalidUpdateMask) {
+		t.Errorf("unknown path error = %v, want ErrInvalidUpdateMask", err)
+	}
+}
@@ -0,0 +1,241 @@
+// Package linkcheck revérifie périodiquement les URLs des liens pour repérer
+// les pages disparues (404, site injoignable, redirection vers l'accueil).
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Raisons d'un lien cassé
+const (
+	ReasonHTTPError      = "http_error"
+	ReasonUnreachable    = "unreachable"
+	ReasonRedirectToHome = "redirected_to_home"
+)
+
+// Config règle la fréquence et la politesse du vérificateur
+type Config struct {
+	Interval     time.Duration // entre deux passes
+	RecheckAfter time.Duration // âge minimal d'une vérification avant de la refaire
+	HostDelay    time.Duration // délai minimal entre deux requêtes vers un même hôte
+	BatchSize    int64         // liens vérifiés par passe
+	MaxHosts     int           // hôtes interrogés en parallèle
+}
+
+// DefaultConfig vérifie chaque lien environ une fois par semaine
+var DefaultConfig = Config{
+	Interval:     time.Hour,
+	RecheckAfter: 7 * 24 * time.Hour,
+	HostDelay:    2 * time.Second,
+	BatchSize:    500,
+	MaxHosts:     8,
+}
+
+type Checker struct {
+	col    *mongo.Collection
+	client *http.Client
+	cfg    Config
+	limit  *hostLimiter
+	now    func() time.Time
+}
+
+func NewChecker(col *mongo.Collection, cfg Config) *Checker {
+	return &Checker{
+		col:    col,
+		client: &http.Client{Timeout: 15 * time.Second},
+		cfg:    cfg,
+		limit:  newHostLimiter(cfg.HostDelay),
+		now:    time.Now,
+	}
+}
+
+// Run lance une passe toutes les cfg.Interval jusqu'à l'annulation du contexte
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if n, err := c.CheckDue(ctx); err != nil {
+			log.Printf("ERROR: link check: %v", err)
+		} else if n > 0 {
+			log.Printf("link check: %d links checked", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckDue vérifie les liens jamais vérifiés ou dont la vérification est trop
+// ancienne, les plus anciens d'abord, et retourne le nombre de liens traités.
+// Chaque lien est réclamé avant d'être vérifié : avec plusieurs serveurs, une
+// URL n'est interrogée qu'une fois.
+func (c *Checker) CheckDue(ctx context.Context) (int, error) {
+	filter := bson.M{
+		"deleted_at": nil,
+		"url":        bson.M{"$regex": "^https?://"},
+		"$or": bson.A{
+			bson.M{"link_status.checked_at": nil},
+			bson.M{"link_status.checked_at": bson.M{"$lt": c.now().Add(-c.cfg.RecheckAfter)}},
+		},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1, "url": 1, "link_status.checked_at": 1}).
+		SetSort(bson.D{{Key: "link_status.checked_at", Value: 1}}).
+		SetLimit(c.cfg.BatchSize)
+	cursor, err := c.col.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	var due []struct {
+		ID         primitive.ObjectID `bson:"_id"`
+		URL        string             `bson:"url"`
+		LinkStatus *struct {
+			CheckedAt *time.Time `bson:"checked_at"`
+		} `bson:"link_status"`
+	}
+	if err := cursor.All(ctx, &due); err != nil {
+		return 0, err
+	}
+
+	// Un hôte est traité par une seule goroutine, qui espace ses requêtes
+	byHost := map[string][]int{}
+	for i, d := range due {
+		if u, err := url.Parse(d.URL); err == nil {
+			byHost[u.Host] = append(byHost[u.Host], i)
+		}
+	}
+	sem := make(chan struct{}, max(c.cfg.MaxHosts, 1))
+	var wg sync.WaitGroup
+	var checked atomic.Int64
+	for _, indexes := range byHost {
+		wg.Add(1)
+		go func(indexes []int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			for _, i := range indexes {
+				if ctx.Err() != nil {
+					return
+				}
+				// Un seul serveur vérifie un lien : il le réclame en datant la
+				// vérification, sans effacer le statut précédent
+				var prev *time.Time
+				if due[i].LinkStatus != nil {
+					prev = due[i].LinkStatus.CheckedAt
+				}
+				claim := bson.M{"_id": due[i].ID, "link_status.checked_at": prev}
+				stamp := bson.A{bson.M{"$set": bson.M{"link_status": bson.M{
+					"$mergeObjects": bson.A{"$link_status", bson.M{"checked_at": c.now()}},
+				}}}}
+				res, err := c.col.UpdateOne(ctx, claim, stamp)
+				if err != nil {
+					log.Printf("ERROR: link check: claim %s: %v", due[i].ID.Hex(), err)
+					continue
+				}
+				if res.ModifiedCount == 0 {
+					continue
+				}
+				checked.Add(1)
+				st := c.Check(ctx, due[i].URL)
+				// Le statut ne vaut que pour l'URL vérifiée
+				if _, err := c.col.UpdateOne(ctx, bson.M{"_id": due[i].ID, "url": due[i].URL}, bson.M{"$set": bson.M{"link_status": st}}); err != nil {
+					log.Printf("ERROR: link check: save status of %s: %v", due[i].ID.Hex(), err)
+				}
+			}
+		}(indexes)
+	}
+	wg.Wait()
+	return int(checked.Load()), ctx.Err()
+}
+
+// Check interroge une URL en respectant le délai de son hôte
+func (c *Checker) Check(ctx context.Context, rawURL string) link.LinkStatus {
+	st := link.LinkStatus{CheckedAt: c.now()}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		st.Broken, st.Reason = true, ReasonUnreachable
+		return st
+	}
+	if err := c.limit.wait(ctx, u.Host); err != nil {
+		st.Broken, st.Reason = true, ReasonUnreachable
+		return st
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		st.Broken, st.Reason = true, ReasonUnreachable
+		return st
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; Tribbae/1.0; link checker)")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		st.Broken, st.Reason = true, ReasonUnreachable
+		return st
+	}
+	resp.Body.Close()
+
+	st.HTTPStatus = int32(resp.StatusCode)
+	st.FinalURL = resp.Request.URL.String()
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone || resp.StatusCode >= 500:
+		st.Broken, st.Reason = true, ReasonHTTPError
+	case resp.StatusCode >= 400:
+		// 401, 403, 429… : souvent une protection anti-robots, la page existe sans doute
+	case redirectedToHome(u, resp.Request.URL):
+		st.Broken, st.Reason = true, ReasonRedirectToHome
+	}
+	return st
+}
+
+// redirectedToHome détecte les "soft 404" : une page profonde redirigée vers
+// la page d'accueil du site
+func redirectedToHome(from, to *url.URL) bool {
+	isHome := func(u *url.URL) bool { return u.Path == "" || u.Path == "/" }
+	return !isHome(from) && isHome(to) && from.String() != to.String()
+}
+
+// hostLimiter espace les requêtes vers un même hôte
+type hostLimiter struct {
+	delay time.Duration
+	mu    sync.Mutex
+	next  map[string]time.Time
+}
+
+func newHostLimiter(delay time.Duration) *hostLimiter {
+	return &hostLimiter{delay: delay, next: map[string]time.Time{}}
+}
+
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.delay)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(at.Sub(now))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait for %s: %w", host, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
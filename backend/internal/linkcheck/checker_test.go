@@ -0,0 +1,216 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func testServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/old-product", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("home"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCheck(t *testing.T) {
+	srv := testServer()
+	defer srv.Close()
+	c := NewChecker(nil, Config{})
+
+	cases := []struct {
+		path     string
+		status   int32
+		broken   bool
+		reason   string
+		finalURL string
+	}{
+		{"/ok", 200, false, "", srv.URL + "/ok"},
+		{"/gone", 404, true, ReasonHTTPError, srv.URL + "/gone"},
+		{"/protected", 403, false, "", srv.URL + "/protected"},
+		{"/old-product", 200, true, ReasonRedirectToHome, srv.URL + "/"},
+		{"/moved", 200, false, "", srv.URL + "/ok"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			st := c.Check(context.Background(), srv.URL+tc.path)
+			if st.HTTPStatus != tc.status || st.Broken != tc.broken || st.Reason != tc.reason || st.FinalURL != tc.finalURL {
+				t.Errorf("got %+v, want status=%d broken=%v reason=%q final=%q", st, tc.status, tc.broken, tc.reason, tc.finalURL)
+			}
+			if st.CheckedAt.IsZero() {
+				t.Error("checked_at should be set")
+			}
+		})
+	}
+}
+
+func TestCheck_Unreachable(t *testing.T) {
+	srv := testServer()
+	srv.Close()
+
+	st := NewChecker(nil, Config{}).Check(context.Background(), srv.URL+"/ok")
+	if !st.Broken || st.Reason != ReasonUnreachable {
+		t.Errorf("closed server should be unreachable, got %+v", st)
+	}
+}
+
+func TestHostLimiter_SpacesRequestsPerHost(t *testing.T) {
+	l := newHostLimiter(50 * time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.wait(ctx, "a.example"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests to the same host took %s, want at least 100ms", elapsed)
+	}
+
+	// Un autre hôte n'attend pas
+	start = time.Now()
+	if err := l.wait(ctx, "b.example"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 40*time.Millisecond {
+		t.Errorf("first request to another host waited %s", elapsed)
+	}
+}
+
+func TestHostLimiter_Cancel(t *testing.T) {
+	l := newHostLimiter(time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := l.wait(ctx, "a.example"); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if err := l.wait(ctx, "a.example"); err == nil {
+		t.Error("wait should stop when the context is cancelled")
+	}
+}
+
+// Plusieurs serveurs qui passent en même temps n'interrogent chaque URL
+// qu'une fois, et un lien revérifié garde son statut jusqu'au résultat
+func TestCheckDue_OncePerReplica(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	var mu sync.Mutex
+	hits := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		mu.Unlock()
+		if r.URL.Path == "/gone" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	col := db.Collection("links")
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-30 * 24 * time.Hour)
+	for _, doc := range []bson.M{
+		{"url": srv.URL + "/new", "link_status": nil},
+		{"url": srv.URL + "/gone", "link_status": link.LinkStatus{HTTPStatus: 200, CheckedAt: old}},
+		{"url": srv.URL + "/fresh", "link_status": link.LinkStatus{HTTPStatus: 200, CheckedAt: now}},
+	} {
+		if _, err := col.InsertOne(ctx, doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := DefaultConfig
+	cfg.HostDelay = time.Millisecond
+	var wg sync.WaitGroup
+	var total int
+	for range 3 {
+		c := NewChecker(col, cfg)
+		c.now = func() time.Time { return now }
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := c.CheckDue(ctx)
+			if err != nil {
+				t.Errorf("check due: %v", err)
+			}
+			mu.Lock()
+			total += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if total != 2 || hits["/new"] != 1 || hits["/gone"] != 1 || hits["/fresh"] != 0 {
+		t.Errorf("checked %d links, hits %v; want each due URL once", total, hits)
+	}
+	var l link.Link
+	if err := col.FindOne(ctx, bson.M{"url": srv.URL + "/gone"}).Decode(&l); err != nil {
+		t.Fatal(err)
+	}
+	if l.LinkStatus == nil || !l.LinkStatus.Broken || l.LinkStatus.HTTPStatus != http.StatusNotFound {
+		t.Errorf("gone status = %+v", l.LinkStatus)
+	}
+}
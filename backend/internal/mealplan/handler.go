@@ -0,0 +1,165 @@
+package mealplan
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/shopping"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedMealPlanServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+var mealTypes = map[pb.MealType]string{
+	pb.MealType_MEAL_TYPE_BREAKFAST: MealBreakfast,
+	pb.MealType_MEAL_TYPE_LUNCH:     MealLunch,
+	pb.MealType_MEAL_TYPE_SNACK:     MealSnack,
+	pb.MealType_MEAL_TYPE_DINNER:    MealDinner,
+}
+
+func mealToProto(meal string) pb.MealType {
+	for t, m := range mealTypes {
+		if m == meal {
+			return t
+		}
+	}
+	return pb.MealType_MEAL_TYPE_UNSPECIFIED
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrInvalidMeal), errors.Is(err, ErrInvalidDate):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrEmptyPlan):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrPlanNotFound), errors.Is(err, ErrEntryNotFound), errors.Is(err, link.ErrLinkNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotAuthorized), errors.Is(err, shopping.ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func toProto(p *Plan) *pb.MealPlan {
+	out := &pb.MealPlan{
+		FolderId:  p.FolderID,
+		WeekStart: p.WeekStart,
+		Entries:   make([]*pb.MealEntry, 0, len(p.Entries)),
+	}
+	if !p.ID.IsZero() {
+		out.Id = p.ID.Hex()
+		out.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	for _, e := range sortedEntries(p.Entries) {
+		out.Entries = append(out.Entries, &pb.MealEntry{
+			Id:       e.ID,
+			Date:     e.Date,
+			Meal:     mealToProto(e.Meal),
+			LinkId:   e.LinkID,
+			Title:    e.Title,
+			Url:      e.URL,
+			Servings: e.Servings,
+			Note:     e.Note,
+		})
+	}
+	return out
+}
+
+func (h *Handler) GetMealPlan(ctx context.Context, req *pb.GetMealPlanRequest) (*pb.GetMealPlanResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	p, err := h.svc.Get(ctx, userID, req.FolderId, req.Date)
+	if err != nil {
+		return nil, serviceError(err, "get meal plan")
+	}
+	return &pb.GetMealPlanResponse{Plan: toProto(p)}, nil
+}
+
+func (h *Handler) AddMealEntry(ctx context.Context, req *pb.AddMealEntryRequest) (*pb.AddMealEntryResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	p, err := h.svc.AddEntry(ctx, userID, req.FolderId, Entry{
+		Date:     req.Date,
+		Meal:     mealTypes[req.Meal],
+		LinkID:   req.LinkId,
+		Servings: req.Servings,
+		Note:     req.Note,
+	})
+	if err != nil {
+		return nil, serviceError(err, "add meal entry")
+	}
+	return &pb.AddMealEntryResponse{Plan: toProto(p)}, nil
+}
+
+func (h *Handler) RemoveMealEntry(ctx context.Context, req *pb.RemoveMealEntryRequest) (*pb.RemoveMealEntryResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	p, err := h.svc.RemoveEntry(ctx, userID, req.PlanId, req.EntryId)
+	if err != nil {
+		return nil, serviceError(err, "remove meal entry")
+	}
+	return &pb.RemoveMealEntryResponse{Plan: toProto(p)}, nil
+}
+
+func (h *Handler) CopyPreviousWeek(ctx context.Context, req *pb.CopyPreviousWeekRequest) (*pb.CopyPreviousWeekResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	p, err := h.svc.CopyPreviousWeek(ctx, userID, req.FolderId, req.Date)
+	if err != nil {
+		return nil, serviceError(err, "copy previous week")
+	}
+	return &pb.CopyPreviousWeekResponse{Plan: toProto(p)}, nil
+}
+
+func (h *Handler) GenerateShoppingList(ctx context.Context, req *pb.GenerateShoppingListRequest) (*pb.GenerateShoppingListResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	l, err := h.svc.GenerateShoppingList(ctx, userID, req.PlanId, req.Title)
+	if err != nil {
+		return nil, serviceError(err, "generate shopping list")
+	}
+	return &pb.GenerateShoppingListResponse{List: shopping.ToProto(l)}, nil
+}
+
+func (h *Handler) ExportMealPlan(ctx context.Context, req *pb.ExportMealPlanRequest) (*pb.ExportMealPlanResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	content, err := h.svc.ExportICal(ctx, userID, req.PlanId)
+	if err != nil {
+		return nil, serviceError(err, "export meal plan")
+	}
+	p, err := h.svc.load(ctx, req.PlanId)
+	if err != nil {
+		return nil, serviceError(err, "export meal plan")
+	}
+	return &pb.ExportMealPlanResponse{
+		Filename: "menus-" + p.WeekStart + ".ics",
+		Content:  string(content),
+	}, nil
+}
@@ -0,0 +1,366 @@
+// Package mealplan gère les menus de la semaine : des recettes placées sur
+// des créneaux (jour, repas) d'un calendrier partagé par les collaborateurs
+// d'un dossier.
+package mealplan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tribbae/backend/internal/ical"
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/shopping"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repas d'une journée
+const (
+	MealBreakfast = "breakfast"
+	MealLunch     = "lunch"
+	MealSnack     = "snack"
+	MealDinner    = "dinner"
+)
+
+// mealOrder donne l'ordre des repas dans la journée et l'heure (locale) à
+// laquelle ils apparaissent dans l'export iCalendar
+var mealOrder = map[string]struct {
+	rank         int
+	hour, minute int
+	label        string
+}{
+	MealBreakfast: {0, 8, 0, "Petit-déjeuner"},
+	MealLunch:     {1, 12, 30, "Déjeuner"},
+	MealSnack:     {2, 16, 30, "Goûter"},
+	MealDinner:    {3, 19, 30, "Dîner"},
+}
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrInvalidMeal   = errors.New("invalid meal")
+	ErrInvalidDate   = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrPlanNotFound  = errors.New("meal plan not found")
+	ErrEntryNotFound = errors.New("meal entry not found")
+	ErrNotAuthorized = errors.New("not authorized")
+	ErrEmptyPlan     = errors.New("meal plan has no recipe")
+)
+
+// LinkGetter charge un lien accessible à l'utilisateur. Implémenté par link.Service.
+type LinkGetter interface {
+	Get(ctx context.Context, linkID, userID string) (*link.Link, error)
+}
+
+// ShoppingLists crée des listes de courses. Implémenté par shopping.Service.
+type ShoppingLists interface {
+	Create(ctx context.Context, userID, title, folderID string, sources []shopping.Source) (*shopping.List, error)
+}
+
+// Entry est une recette placée sur un créneau. Title et URL sont copiés du
+// lien pour l'affichage et l'export.
+type Entry struct {
+	ID       string `bson:"id"                 json:"id"`
+	Date     string `bson:"date"               json:"date"` // YYYY-MM-DD
+	Meal     string `bson:"meal"               json:"meal"`
+	LinkID   string `bson:"link_id"            json:"link_id"`
+	Title    string `bson:"title"              json:"title"`
+	URL      string `bson:"url,omitempty"      json:"url,omitempty"`
+	Servings int32  `bson:"servings,omitempty" json:"servings,omitempty"`
+	Note     string `bson:"note,omitempty"     json:"note,omitempty"`
+}
+
+// Plan est le menu d'une semaine (du lundi au dimanche). Un menu de dossier
+// est commun à tous ses collaborateurs ; sans dossier, il est personnel.
+type Plan struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Scope     string             `bson:"scope"         json:"-"` // "folder:<id>" ou "user:<id>"
+	OwnerID   string             `bson:"owner_id"      json:"owner_id"`
+	FolderID  string             `bson:"folder_id"     json:"folder_id"`
+	WeekStart string             `bson:"week_start"    json:"week_start"` // lundi, YYYY-MM-DD
+	Entries   []Entry            `bson:"entries"       json:"entries"`
+	CreatedAt time.Time          `bson:"created_at"    json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"    json:"updated_at"`
+}
+
+type Service struct {
+	col       *mongo.Collection
+	folderCol *mongo.Collection
+	links     LinkGetter
+	shopping  ShoppingLists
+}
+
+func NewService(col, folderCol *mongo.Collection, links LinkGetter, shopping ShoppingLists) *Service {
+	return &Service{col: col, folderCol: folderCol, links: links, shopping: shopping}
+}
+
+// WeekStart retourne le lundi de la semaine de date (YYYY-MM-DD)
+func WeekStart(date string) (string, error) {
+	d, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return "", ErrInvalidDate
+	}
+	offset := (int(d.Weekday()) + 6) % 7 // lundi = 0
+	return d.AddDate(0, 0, -offset).Format(dateLayout), nil
+}
+
+func scopeOf(userID, folderID string) string {
+	if folderID != "" {
+		return "folder:" + folderID
+	}
+	return "user:" + userID
+}
+
+// Get retourne le menu de la semaine contenant date. Une semaine sans menu
+// donne un Plan vide (ID nul).
+func (s *Service) Get(ctx context.Context, userID, folderID, date string) (*Plan, error) {
+	week, err := WeekStart(date)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canView(ctx, userID, folderID) {
+		return nil, ErrNotAuthorized
+	}
+	var p Plan
+	err = s.col.FindOne(ctx, bson.M{"scope": scopeOf(userID, folderID), "week_start": week}).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &Plan{FolderID: folderID, WeekStart: week, Entries: []Entry{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// AddEntry place une recette sur un créneau, en créant le menu de la semaine
+// au besoin. Un créneau peut recevoir plusieurs recettes (plat et dessert).
+func (s *Service) AddEntry(ctx context.Context, userID, folderID string, e Entry) (*Plan, error) {
+	if _, ok := mealOrder[e.Meal]; !ok {
+		return nil, ErrInvalidMeal
+	}
+	week, err := WeekStart(e.Date)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canEdit(ctx, userID, folderID) {
+		return nil, ErrNotAuthorized
+	}
+	l, err := s.links.Get(ctx, e.LinkID, userID)
+	if err != nil {
+		return nil, link.ErrLinkNotFound
+	}
+	e.ID = primitive.NewObjectID().Hex()
+	e.Title, e.URL = l.Title, l.URL
+	if err := s.push(ctx, userID, folderID, week, []Entry{e}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, userID, folderID, week)
+}
+
+// RemoveEntry retire une recette du menu
+func (s *Service) RemoveEntry(ctx context.Context, userID, planID, entryID string) (*Plan, error) {
+	p, err := s.load(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canEdit(ctx, userID, p.FolderID) || (p.FolderID == "" && p.OwnerID != userID) {
+		return nil, ErrNotAuthorized
+	}
+	res, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": p.ID, "entries.id": entryID},
+		bson.M{"$pull": bson.M{"entries": bson.M{"id": entryID}}, "$set": bson.M{"updated_at": time.Now()}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrEntryNotFound
+	}
+	return s.load(ctx, planID)
+}
+
+// CopyPreviousWeek reprend le menu de la semaine précédente dans celle qui
+// contient date. Les créneaux déjà remplis ne sont pas touchés.
+func (s *Service) CopyPreviousWeek(ctx context.Context, userID, folderID, date string) (*Plan, error) {
+	week, err := WeekStart(date)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canEdit(ctx, userID, folderID) {
+		return nil, ErrNotAuthorized
+	}
+	current, err := s.Get(ctx, userID, folderID, week)
+	if err != nil {
+		return nil, err
+	}
+	start, _ := time.Parse(dateLayout, week)
+	previous, err := s.Get(ctx, userID, folderID, start.AddDate(0, 0, -7).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	filled := map[string]bool{}
+	for _, e := range current.Entries {
+		filled[e.Date+"/"+e.Meal] = true
+	}
+	var copied []Entry
+	for _, e := range previous.Entries {
+		d, err := time.Parse(dateLayout, e.Date)
+		if err != nil {
+			continue
+		}
+		e.Date = d.AddDate(0, 0, 7).Format(dateLayout)
+		if filled[e.Date+"/"+e.Meal] {
+			continue
+		}
+		e.ID = primitive.NewObjectID().Hex()
+		copied = append(copied, e)
+	}
+	if len(copied) > 0 {
+		if err := s.push(ctx, userID, folderID, week, copied); err != nil {
+			return nil, err
+		}
+	}
+	return s.Get(ctx, userID, folderID, week)
+}
+
+// GenerateShoppingList crée la liste de courses des recettes du menu, partagée
+// avec le même dossier. Une recette prévue deux fois compte deux fois.
+func (s *Service) GenerateShoppingList(ctx context.Context, userID, planID, title string) (*shopping.List, error) {
+	p, err := s.load(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canView(ctx, userID, p.FolderID) || (p.FolderID == "" && p.OwnerID != userID) {
+		return nil, ErrPlanNotFound
+	}
+	if len(p.Entries) == 0 {
+		return nil, ErrEmptyPlan
+	}
+	sources := make([]shopping.Source, 0, len(p.Entries))
+	for _, e := range sortedEntries(p.Entries) {
+		sources = append(sources, shopping.Source{LinkID: e.LinkID, Servings: e.Servings})
+	}
+	if title == "" {
+		title = "Courses de la semaine du " + p.WeekStart
+	}
+	return s.shopping.Create(ctx, userID, title, p.FolderID, sources)
+}
+
+// ExportICal retourne le menu au format iCalendar, un événement par recette à
+// l'heure habituelle du repas
+func (s *Service) ExportICal(ctx context.Context, userID, planID string) ([]byte, error) {
+	p, err := s.load(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canView(ctx, userID, p.FolderID) || (p.FolderID == "" && p.OwnerID != userID) {
+		return nil, ErrPlanNotFound
+	}
+	cal := &ical.Calendar{Name: "Menus de la semaine du " + p.WeekStart}
+	for _, e := range sortedEntries(p.Entries) {
+		day, err := time.Parse(dateLayout, e.Date)
+		if err != nil {
+			continue
+		}
+		meal := mealOrder[e.Meal]
+		start := day.Add(time.Duration(meal.hour)*time.Hour + time.Duration(meal.minute)*time.Minute)
+		description := e.Note
+		if e.Servings > 0 {
+			description = fmt.Sprintf("Pour %d personnes\n%s", e.Servings, e.Note)
+		}
+		cal.Events = append(cal.Events, ical.Event{
+			UID:         e.ID + "@tribbae",
+			Summary:     meal.label + " : " + e.Title,
+			Description: description,
+			URL:         e.URL,
+			Start:       start,
+			End:         start.Add(time.Hour),
+			Floating:    true,
+			Updated:     p.UpdatedAt,
+		})
+	}
+	var buf bytes.Buffer
+	if err := cal.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// push ajoute des recettes au menu d'une semaine, créé s'il n'existe pas
+func (s *Service) push(ctx context.Context, userID, folderID, week string, entries []Entry) error {
+	now := time.Now()
+	_, err := s.col.UpdateOne(ctx,
+		bson.M{"scope": scopeOf(userID, folderID), "week_start": week},
+		bson.M{
+			"$setOnInsert": bson.M{"owner_id": userID, "folder_id": folderID, "created_at": now},
+			"$push":        bson.M{"entries": bson.M{"$each": entries}},
+			"$set":         bson.M{"updated_at": now},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (s *Service) load(ctx context.Context, planID string) (*Plan, error) {
+	id, err := primitive.ObjectIDFromHex(planID)
+	if err != nil {
+		return nil, ErrPlanNotFound
+	}
+	var p Plan
+	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&p); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// canView : un menu de dossier est visible par son propriétaire et ses collaborateurs
+func (s *Service) canView(ctx context.Context, userID, folderID string) bool {
+	if folderID == "" {
+		return true
+	}
+	return s.folderMatches(ctx, folderID, bson.A{
+		bson.M{"owner_id": userID},
+		bson.M{"collaborators.user_id": userID},
+	})
+}
+
+// canEdit : un menu de dossier est modifiable par son propriétaire et ses éditeurs
+func (s *Service) canEdit(ctx context.Context, userID, folderID string) bool {
+	if folderID == "" {
+		return true
+	}
+	return s.folderMatches(ctx, folderID, bson.A{
+		bson.M{"owner_id": userID},
+		bson.M{"collaborators": bson.M{"$elemMatch": bson.M{"user_id": userID, "role": "editor"}}},
+	})
+}
+
+func (s *Service) folderMatches(ctx context.Context, folderID string, or bson.A) bool {
+	fid, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return false
+	}
+	count, _ := s.folderCol.CountDocuments(ctx, bson.M{"_id": fid, "deleted_at": nil, "$or": or})
+	return count > 0
+}
+
+// sortedEntries trie les recettes par jour puis par repas
+func sortedEntries(entries []Entry) []Entry {
+	out := append([]Entry(nil), entries...)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Date != out[j].Date {
+			return out[i].Date < out[j].Date
+		}
+		return mealOrder[out[i].Meal].rank < mealOrder[out[j].Meal].rank
+	})
+	return out
+}
@@ -0,0 +1,146 @@
+package mealplan
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/recipe"
+	"github.com/tribbae/backend/internal/shopping"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func TestWeekStart(t *testing.T) {
+	cases := map[string]string{
+		"2026-10-19": "2026-10-19", // lundi
+		"2026-10-21": "2026-10-19",
+		"2026-10-25": "2026-10-19", // dimanche
+		"2026-11-01": "2026-10-26",
+	}
+	for in, want := range cases {
+		if got, err := WeekStart(in); err != nil || got != want {
+			t.Errorf("WeekStart(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := WeekStart("19/10/2026"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("err = %v, want ErrInvalidDate", err)
+	}
+}
+
+// Un menu de dossier se remplit, se recopie la semaine suivante, donne une
+// liste de courses et s'exporte en iCalendar
+func TestMealPlan_Week(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	lists := shopping.NewService(db.Collection("shopping_lists"), links)
+	svc := NewService(db.Collection("meal_plans"), db.Collection("folders"), links, lists)
+	ownerID := primitive.NewObjectID().Hex()
+	viewerID := primitive.NewObjectID().Hex()
+
+	folderID := primitive.NewObjectID()
+	if _, err := db.Collection("folders").InsertOne(ctx, bson.M{
+		"_id": folderID, "owner_id": ownerID, "name": "Famille",
+		"collaborators": bson.A{bson.M{"user_id": viewerID, "role": "viewer"}},
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	crepes, err := links.Create(ctx, ownerID, &link.Link{
+		FolderID: folderID.Hex(), Title: "Crêpes", URL: "https://example.com/crepes",
+		Ingredients: []string{"250 g de farine", "4 œufs"}, Recipe: &recipe.Recipe{Servings: 4},
+	})
+	if err != nil {
+		t.Fatalf("create link: %v", err)
+	}
+
+	fid := folderID.Hex()
+	for _, e := range []Entry{
+		{Date: "2026-10-14", Meal: MealDinner, LinkID: crepes.ID.Hex(), Servings: 8},
+		{Date: "2026-10-12", Meal: MealLunch, LinkID: crepes.ID.Hex()},
+	} {
+		if _, err := svc.AddEntry(ctx, ownerID, fid, e); err != nil {
+			t.Fatalf("add entry: %v", err)
+		}
+	}
+	if _, err := svc.AddEntry(ctx, viewerID, fid, Entry{Date: "2026-10-13", Meal: MealLunch, LinkID: crepes.ID.Hex()}); !errors.Is(err, ErrNotAuthorized) {
+		t.Errorf("viewer add err = %v, want ErrNotAuthorized", err)
+	}
+
+	// La semaine suivante a déjà son mardi midi ; il n'est pas écrasé
+	if _, err := svc.AddEntry(ctx, ownerID, fid, Entry{Date: "2026-10-19", Meal: MealLunch, LinkID: crepes.ID.Hex(), Note: "déjà prévu"}); err != nil {
+		t.Fatalf("add entry: %v", err)
+	}
+	next, err := svc.CopyPreviousWeek(ctx, ownerID, fid, "2026-10-22")
+	if err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if len(next.Entries) != 2 {
+		t.Fatalf("next week entries = %+v", next.Entries)
+	}
+	for _, e := range next.Entries {
+		if e.Date == "2026-10-19" && e.Note != "déjà prévu" {
+			t.Errorf("filled slot overwritten: %+v", e)
+		}
+	}
+
+	// Le lecteur voit le menu et peut en tirer la liste de courses
+	week, err := svc.Get(ctx, viewerID, fid, "2026-10-15")
+	if err != nil || week.ID.IsZero() || week.WeekStart != "2026-10-12" {
+		t.Fatalf("get = %+v, %v", week, err)
+	}
+	list, err := svc.GenerateShoppingList(ctx, viewerID, week.ID.Hex(), "")
+	if err != nil {
+		t.Fatalf("shopping list: %v", err)
+	}
+	if list.FolderID != fid || len(list.Items) != 2 || list.Items[0].Text() != "12 œufs" {
+		t.Errorf("list = %+v", list)
+	}
+
+	ics, err := svc.ExportICal(ctx, viewerID, week.ID.Hex())
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	out := string(ics)
+	lunch := strings.Index(out, "DTSTART:20261012T123000")
+	dinner := strings.Index(out, "DTSTART:20261014T193000")
+	if lunch < 0 || dinner < lunch || !strings.Contains(out, "SUMMARY:Dîner : Crêpes") {
+		t.Errorf("unexpected calendar:\n%s", out)
+	}
+}
@@ -0,0 +1,39 @@
+// Package notify définit l'envoi de notifications aux utilisateurs, quel que
+// soit le canal (push, e-mail…).
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// Notification est un message destiné à un utilisateur
+type Notification struct {
+	UserID string
+	Title  string
+	Body   string
+	LinkID string // lien concerné, ouvert quand on touche la notification
+	URL    string
+}
+
+// Notifier envoie des notifications. Une erreur indique que la notification
+// n'a pas été remise et peut être renvoyée.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// NotifierFunc adapte une fonction en Notifier
+type NotifierFunc func(ctx context.Context, n Notification) error
+
+func (f NotifierFunc) Notify(ctx context.Context, n Notification) error {
+	return f(ctx, n)
+}
+
+// LogNotifier écrit les notifications dans le journal du serveur, faute de
+// canal de diffusion configuré
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, n Notification) error {
+	log.Printf("notify %s: %s — %s", n.UserID, n.Title, n.Body)
+	return nil
+}
@@ -0,0 +1,118 @@
+// Package pagetoken encode les curseurs de pagination par clé (keyset).
+//
+// Un token contient la valeur de tri du dernier élément renvoyé et son _id,
+// ce qui garde des pages stables même quand des documents sont ajoutés
+// entre deux appels ou quand plusieurs éléments ont la même valeur de tri.
+package pagetoken
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrInvalid est retourné quand un token ne peut pas être décodé
+var ErrInvalid = errors.New("invalid page token")
+
+// Cursor est la position de fin d'une page
+type Cursor struct {
+	Value any                `bson:"v"`
+	ID    primitive.ObjectID `bson:"id"`
+	// Scope est l'empreinte de la requête (tri, recherche…) qui a émis le
+	// token, vide pour Encode
+	Scope string `bson:"s,omitempty"`
+}
+
+// Encode sérialise un curseur en token opaque utilisable dans une URL
+func Encode(value any, id primitive.ObjectID) (string, error) {
+	return EncodeScoped("", value, id)
+}
+
+// EncodeScoped est Encode pour un token qui n'est valable que pour la même
+// requête ; scope vient de Scope
+func EncodeScoped(scope string, value any, id primitive.ObjectID) (string, error) {
+	b, err := bson.Marshal(Cursor{Value: value, ID: id, Scope: scope})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Scope résume les paramètres d'une requête qui changent l'ordre ou le
+// contenu des pages (mode de tri, recherche…) en une courte empreinte
+func Scope(params ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(params, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Decode relit un token produit par Encode
+func Decode(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	var c Cursor
+	if err := bson.Unmarshal(b, &c); err != nil || c.ID.IsZero() {
+		return nil, ErrInvalid
+	}
+	return &c, nil
+}
+
+// DecodeScoped relit un token produit par EncodeScoped, et refuse celui
+// d'une autre requête : sa valeur de tri n'aurait pas de sens ici
+func DecodeScoped(scope, token string) (*Cursor, error) {
+	c, err := Decode(token)
+	if err != nil {
+		return nil, err
+	}
+	if c.Scope != scope {
+		return nil, ErrInvalid
+	}
+	return c, nil
+}
+
+// After retourne le filtre des documents situés après le curseur pour un tri
+// sur field (décroissant si desc) puis sur _id croissant.
+func After(field string, desc bool, c *Cursor) bson.M {
+	op := "$gt"
+	if desc {
+		op = "$lt"
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{op: c.Value}},
+		bson.M{field: c.Value, "_id": bson.M{"$gt": c.ID}},
+	}}
+}
+
+// Seek est la variante de After où _id suit le sens du tri, ce qui permet de
+// servir les deux sens avec un seul index {field: 1, _id: 1}. Les documents
+// sans valeur sont placés en premier en tri croissant et en dernier en tri
+// décroissant, comme le fait MongoDB.
+func Seek(field string, desc bool, c *Cursor) bson.M {
+	op := "$gt"
+	if desc {
+		op = "$lt"
+	}
+	if c.Value == nil {
+		if desc {
+			return bson.M{field: nil, "_id": bson.M{op: c.ID}}
+		}
+		return bson.M{"$or": bson.A{
+			bson.M{field: nil, "_id": bson.M{op: c.ID}},
+			bson.M{field: bson.M{"$ne": nil}},
+		}}
+	}
+	clauses := bson.A{
+		bson.M{field: bson.M{op: c.Value}},
+		bson.M{field: c.Value, "_id": bson.M{op: c.ID}},
+	}
+	if desc {
+		clauses = append(clauses, bson.M{field: nil})
+	}
+	return bson.M{"$or": clauses}
+}
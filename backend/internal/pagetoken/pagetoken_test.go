@@ -0,0 +1,121 @@
+package pagetoken
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	id := primitive.NewObjectID()
+	now := time.Now().Truncate(time.Millisecond)
+
+	cases := []struct {
+		name  string
+		value any
+		check func(v any) bool
+	}{
+		{"score", 1.8333333333333333, func(v any) bool { return v == 1.8333333333333333 }},
+		{"title", "Gâteau au yaourt", func(v any) bool { return v == "Gâteau au yaourt" }},
+		{"rating", int32(4), func(v any) bool { return v == int32(4) }},
+		{"date", now, func(v any) bool {
+			dt, ok := v.(primitive.DateTime)
+			return ok && dt.Time().Equal(now)
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := Encode(tc.value, id)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			c, err := Decode(token)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if c.ID != id {
+				t.Errorf("id = %s, want %s", c.ID.Hex(), id.Hex())
+			}
+			if !tc.check(c.Value) {
+				t.Errorf("value = %#v (%T), want %#v", c.Value, c.Value, tc.value)
+			}
+		})
+	}
+}
+
+func TestDecode_RejectsGarbage(t *testing.T) {
+	for _, token := range []string{"", "not-base64!", primitive.NewObjectID().Hex()} {
+		if _, err := Decode(token); err != ErrInvalid {
+			t.Errorf("Decode(%q) error = %v, want ErrInvalid", token, err)
+		}
+	}
+}
+
+func TestAfter_Direction(t *testing.T) {
+	c := &Cursor{Value: 2.5, ID: primitive.NewObjectID()}
+
+	desc := After("score", true, c)
+	first := desc["$or"].(bson.A)[0].(bson.M)["score"].(bson.M)
+	if _, ok := first["$lt"]; !ok {
+		t.Errorf("descending sort should use $lt, got %v", first)
+	}
+
+	asc := After("title", false, c)
+	first = asc["$or"].(bson.A)[0].(bson.M)["title"].(bson.M)
+	if _, ok := first["$gt"]; !ok {
+		t.Errorf("ascending sort should use $gt, got %v", first)
+	}
+}
+
+func TestSeek_NullValues(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	// Décroissant : les documents sans valeur viennent après toutes les valeurs
+	desc := Seek("event_at", true, &Cursor{Value: time.Now(), ID: id})
+	if clauses := desc["$or"].(bson.A); len(clauses) != 3 {
+		t.Errorf("descending seek should include null values, got %v", desc)
+	}
+
+	// Croissant depuis un document sans valeur : les autres nulls puis toutes les valeurs
+	asc := Seek("event_at", false, &Cursor{Value: nil, ID: id})
+	clauses := asc["$or"].(bson.A)
+	if len(clauses) != 2 {
+		t.Fatalf("ascending seek from null should have 2 clauses, got %v", asc)
+	}
+	if _, ok := clauses[1].(bson.M)["event_at"].(bson.M)["$ne"]; !ok {
+		t.Errorf("second clause should select non-null values, got %v", clauses[1])
+	}
+
+	// Décroissant depuis un document sans valeur : seulement les nulls suivants
+	last := Seek("event_at", true, &Cursor{Value: nil, ID: id})
+	if _, ok := last["_id"].(bson.M)["$lt"]; !ok || last["event_at"] != nil {
+		t.Errorf("descending seek from null should only page through nulls, got %v", last)
+	}
+}
+
+func TestDecodeScoped_RejectsOtherQuery(t *testing.T) {
+	id := primitive.NewObjectID()
+	scope := Scope("score", "gâteau")
+	token, err := EncodeScoped(scope, 1.5, id)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if c, err := DecodeScoped(scope, token); err != nil || c.ID != id {
+		t.Fatalf("same scope = %v, %v", c, err)
+	}
+	unscoped, _ := Encode(1.5, id)
+	for name, tc := range map[string]struct{ scope, token string }{
+		"other query": {Scope("score", "vélo"), token},
+		"other sort":  {Scope("updated_at", ""), token},
+		"unscoped":    {scope, unscoped},
+	} {
+		if _, err := DecodeScoped(tc.scope, tc.token); err != ErrInvalid {
+			t.Errorf("%s: error = %v, want ErrInvalid", name, err)
+		}
+	}
+	if Scope("a", "bc") == Scope("ab", "c") {
+		t.Error("scope should not depend on how parameters are split")
+	}
+}
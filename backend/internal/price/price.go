@@ -0,0 +1,108 @@
+// Package price lit les prix écrits librement sur les liens (« 25€ »,
+// « 25,90 EUR », « gratuit ») en montants en centimes et en devise ISO 4217,
+// qui peuvent être additionnés et comparés.
+package price
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// DefaultCurrency est la devise d'un montant écrit sans devise
+const DefaultCurrency = "EUR"
+
+// Amount est un montant en centimes dans une devise ISO 4217
+type Amount struct {
+	Cents    int64  `bson:"cents"    json:"cents"`
+	Currency string `bson:"currency" json:"currency"`
+}
+
+var (
+	// numberRe accepte les séparateurs de milliers (espaces, point, virgule,
+	// apostrophe) et le « € » placé comme virgule (« 25€90 »)
+	numberRe = regexp.MustCompile(`\d+(?:[ \x{a0}\x{202f}.,'’]\d+)*(?:\s*€\s*\d{2}\b)?`)
+	freeRe   = regexp.MustCompile(`\b(gratuit|gratuite|gratis|free|offert|offerte)\b`)
+	// currencies associe les symboles et codes reconnus à leur code ISO, dans
+	// l'ordre où ils sont cherchés
+	currencies = []struct {
+		re   *regexp.Regexp
+		code string
+	}{
+		{regexp.MustCompile(`€|\beur(o|os)?\b`), "EUR"},
+		{regexp.MustCompile(`\bchf\b|francs? suisses?`), "CHF"},
+		{regexp.MustCompile(`\bcad\b|\$ ?ca\b|\bca ?\$`), "CAD"},
+		{regexp.MustCompile(`\$|\busd\b|\bdollars?\b`), "USD"},
+		{regexp.MustCompile(`£|\bgbp\b|\blivres? sterling\b`), "GBP"},
+	}
+)
+
+// Parse lit un prix. Une fourchette (« 20-30 € ») compte pour sa borne
+// basse ; « gratuit » vaut zéro. ok est faux si le texte n'a pas de montant.
+func Parse(s string) (a Amount, ok bool) {
+	t := textutil.Fold(strings.TrimSpace(s))
+	if t == "" {
+		return Amount{}, false
+	}
+	// « gratuit pour les moins de 3 ans » : le nombre n'est pas un prix
+	if freeRe.MatchString(t) {
+		return Amount{Cents: 0, Currency: DefaultCurrency}, true
+	}
+	loc := numberRe.FindStringIndex(t)
+	if loc == nil {
+		return Amount{}, false
+	}
+	cents, ok := parseCents(t[loc[0]:loc[1]])
+	if !ok {
+		return Amount{}, false
+	}
+	return Amount{Cents: cents, Currency: currencyOf(t)}, true
+}
+
+// parseCents convertit un nombre écrit à la française ou à l'anglaise. Le
+// dernier séparateur est la virgule décimale s'il est suivi d'un ou deux
+// chiffres ; les autres séparent les milliers.
+func parseCents(n string) (int64, bool) {
+	n = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "", "'", "", "’", "").Replace(n)
+	n = strings.Replace(n, "€", ",", 1)
+	units, decimals := n, ""
+	if i := strings.LastIndexAny(n, ".,"); i >= 0 && len(n)-i-1 <= 2 {
+		units, decimals = n[:i], n[i+1:]
+	}
+	units = strings.NewReplacer(".", "", ",", "").Replace(units)
+	u, err := strconv.ParseInt(units, 10, 64)
+	if err != nil || u > 1e12 {
+		return 0, false
+	}
+	d := int64(0)
+	if decimals != "" {
+		if d, err = strconv.ParseInt(decimals, 10, 64); err != nil {
+			return 0, false
+		}
+		if len(decimals) == 1 {
+			d *= 10
+		}
+	}
+	return u*100 + d, true
+}
+
+func currencyOf(t string) string {
+	for _, c := range currencies {
+		if c.re.MatchString(t) {
+			return c.code
+		}
+	}
+	return DefaultCurrency
+}
+
+// String écrit le montant à la française : « 49,90 € », « 15,00 USD »
+func (a Amount) String() string {
+	s := fmt.Sprintf("%d,%02d", a.Cents/100, a.Cents%100)
+	if a.Currency == "EUR" {
+		return s + " €"
+	}
+	return s + " " + a.Currency
+}
@@ -0,0 +1,51 @@
+package price
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+		ok   bool
+	}{
+		{"25€", Amount{2500, "EUR"}, true},
+		{"25 €", Amount{2500, "EUR"}, true},
+		{"25,90 EUR", Amount{2590, "EUR"}, true},
+		{"25.9", Amount{2590, "EUR"}, true},
+		{"25€90", Amount{2590, "EUR"}, true},
+		{"environ 12 euros", Amount{1200, "EUR"}, true},
+		{"1 299,00 €", Amount{129900, "EUR"}, true},
+		{"1\u202f299,00\u00a0€", Amount{129900, "EUR"}, true}, // espaces insécables
+		{"1.299,99€", Amount{129999, "EUR"}, true},
+		{"$1,299.99", Amount{129999, "USD"}, true},
+		{"1,299 USD", Amount{129900, "USD"}, true},
+		{"£15", Amount{1500, "GBP"}, true},
+		{"30 CHF", Amount{3000, "CHF"}, true},
+		{"20-30 €", Amount{2000, "EUR"}, true},
+		{"Gratuit", Amount{0, "EUR"}, true},
+		{"gratuit pour les moins de 3 ans", Amount{0, "EUR"}, true},
+		{"Offert", Amount{0, "EUR"}, true},
+		{"12 CA$", Amount{1200, "CAD"}, true},
+		{"cadeau à 20", Amount{2000, "EUR"}, true},
+		{"", Amount{}, false},
+		{"sur devis", Amount{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q) = %+v, %v; want %+v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAmount_String(t *testing.T) {
+	for a, want := range map[Amount]string{
+		{4990, "EUR"}: "49,90 €",
+		{5, "EUR"}:    "0,05 €",
+		{1500, "USD"}: "15,00 USD",
+	} {
+		if got := a.String(); got != want {
+			t.Errorf("%+v.String() = %q, want %q", a, got, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package price
+
+import (
+	"strings"
+
+	"github.com/tribbae/backend/internal/schemaorg"
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// FromSchema retourne le prix le plus bas des offres schema.org de la page
+// (Offer, AggregateOffer, seules ou dans un Product). Les offres épuisées
+// sont ignorées quand d'autres sont disponibles.
+func FromSchema(items []schemaorg.Item) (Amount, bool) {
+	var best, bestOut *Amount
+	consider := func(it schemaorg.Item, key string) {
+		a, ok := offerAmount(it, key)
+		if !ok {
+			return
+		}
+		target := &best
+		if outOfStock(it) {
+			target = &bestOut
+		}
+		if *target == nil || a.Cents < (*target).Cents {
+			*target = &a
+		}
+	}
+	for _, it := range schemaorg.Find(items, "Offer") {
+		consider(it, "price")
+	}
+	for _, it := range schemaorg.Find(items, "AggregateOffer") {
+		consider(it, "lowPrice")
+		consider(it, "price")
+	}
+	switch {
+	case best != nil:
+		return *best, true
+	case bestOut != nil:
+		return *bestOut, true
+	}
+	return Amount{}, false
+}
+
+// offerAmount lit le prix d'une offre. schema.org impose le point décimal,
+// mais les pages écrivent souvent le prix affiché (« 49,90 € »).
+func offerAmount(it schemaorg.Item, key string) (Amount, bool) {
+	raw := it.String(key)
+	if raw == "" {
+		// Prix dans une PriceSpecification
+		for _, spec := range it.Items("priceSpecification") {
+			if raw = spec.String("price"); raw != "" {
+				it = spec
+				break
+			}
+		}
+	}
+	loc := numberRe.FindStringIndex(raw)
+	if loc == nil {
+		return Amount{}, false
+	}
+	cents, ok := parseCents(raw[loc[0]:loc[1]])
+	if !ok {
+		return Amount{}, false
+	}
+	currency := strings.ToUpper(it.String("priceCurrency"))
+	if len(currency) != 3 {
+		currency = currencyOf(textutil.Fold(raw))
+	}
+	return Amount{Cents: cents, Currency: currency}, true
+}
+
+func outOfStock(it schemaorg.Item) bool {
+	a := strings.ToLower(it.String("availability"))
+	return strings.HasSuffix(a, "outofstock") || strings.HasSuffix(a, "discontinued") || strings.HasSuffix(a, "soldout")
+}
@@ -0,0 +1,221 @@
+// Package pricewatch relève périodiquement le prix des pages des liens
+// suivis (link.PriceWatch), d'après leurs offres schema.org, garde
+// l'historique des prix et prévient quand un prix baisse.
+package pricewatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/notify"
+	"github.com/tribbae/backend/internal/price"
+	"github.com/tribbae/backend/internal/schemaorg"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/html"
+)
+
+// maxPageSize limite la lecture d'une page produit
+const maxPageSize = 4 << 20
+
+// ErrNoPrice est retourné quand la page ne publie pas d'offre schema.org
+var ErrNoPrice = errors.New("no schema.org offer on page")
+
+// Config règle la fréquence et la politesse des relevés
+type Config struct {
+	Interval     time.Duration // entre deux passes
+	RecheckAfter time.Duration // âge minimal d'un relevé avant de le refaire
+	Delay        time.Duration // entre deux pages
+	BatchSize    int64         // liens relevés par passe
+}
+
+// DefaultConfig relève chaque prix deux fois par jour
+var DefaultConfig = Config{
+	Interval:     time.Hour,
+	RecheckAfter: 12 * time.Hour,
+	Delay:        2 * time.Second,
+	BatchSize:    200,
+}
+
+type Watcher struct {
+	links    *mongo.Collection
+	history  *mongo.Collection
+	notifier notify.Notifier
+	client   *http.Client
+	cfg      Config
+	now      func() time.Time
+}
+
+func NewWatcher(links, history *mongo.Collection, notifier notify.Notifier, cfg Config) *Watcher {
+	return &Watcher{
+		links:    links,
+		history:  history,
+		notifier: notifier,
+		client:   &http.Client{Timeout: 15 * time.Second},
+		cfg:      cfg,
+		now:      time.Now,
+	}
+}
+
+// Run lance une passe toutes les cfg.Interval jusqu'à l'annulation du contexte
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if n, err := w.CheckDue(ctx); err != nil {
+			log.Printf("ERROR: price watch: %v", err)
+		} else if n > 0 {
+			log.Printf("price watch: %d prices checked", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckDue relève le prix des liens suivis jamais relevés ou dont le relevé
+// est trop ancien, et retourne le nombre de liens traités
+func (w *Watcher) CheckDue(ctx context.Context) (int, error) {
+	now := w.now()
+	filter := bson.M{
+		"deleted_at":  nil,
+		"price_watch": bson.M{"$exists": true},
+		"$or": bson.A{
+			bson.M{"price_watch.checked_at": nil},
+			bson.M{"price_watch.checked_at": bson.M{"$lt": now.Add(-w.cfg.RecheckAfter)}},
+		},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1, "title": 1, "url": 1, "price_watch": 1}).
+		SetSort(bson.D{{Key: "price_watch.checked_at", Value: 1}}).
+		SetLimit(w.cfg.BatchSize)
+	cursor, err := w.links.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	var due []*link.Link
+	if err := cursor.All(ctx, &due); err != nil {
+		return 0, err
+	}
+
+	checked := 0
+	for i, l := range due {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return checked, ctx.Err()
+			case <-time.After(w.cfg.Delay):
+			}
+		}
+		// Un seul serveur relève un lien : il le réclame en datant le relevé
+		claim := bson.M{"_id": l.ID, "price_watch.checked_at": l.PriceWatch.CheckedAt}
+		res, err := w.links.UpdateOne(ctx, claim, bson.M{"$set": bson.M{"price_watch.checked_at": w.now()}})
+		if err != nil {
+			return checked, err
+		}
+		if res.ModifiedCount == 0 {
+			continue
+		}
+		checked++
+		a, err := w.Fetch(ctx, l.URL)
+		if err != nil {
+			log.Printf("WARN: price watch: link %s: %v", l.ID.Hex(), err)
+			continue
+		}
+		if err := w.record(ctx, l, a); err != nil {
+			log.Printf("ERROR: price watch: link %s: %v", l.ID.Hex(), err)
+		}
+	}
+	return checked, ctx.Err()
+}
+
+// Fetch lit le prix publié par une page
+func (w *Watcher) Fetch(ctx context.Context, rawURL string) (price.Amount, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return price.Amount{}, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; Tribbae/1.0; price watch)")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return price.Amount{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return price.Amount{}, fmt.Errorf("GET %s: %s", rawURL, resp.Status)
+	}
+	doc, err := html.Parse(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		return price.Amount{}, err
+	}
+	a, ok := price.FromSchema(schemaorg.Extract(doc))
+	if !ok {
+		return price.Amount{}, ErrNoPrice
+	}
+	return a, nil
+}
+
+// record enregistre un prix relevé : dans l'historique s'il a changé, sur le
+// lien, et envoie l'alerte s'il y a lieu
+func (w *Watcher) record(ctx context.Context, l *link.Link, a price.Amount) error {
+	pw := l.PriceWatch
+	prev := pw.Current
+	if prev == nil || *prev != a {
+		if _, err := w.history.InsertOne(ctx, link.PricePoint{LinkID: l.ID.Hex(), Amount: a, ObservedAt: w.now()}); err != nil {
+			return err
+		}
+	}
+
+	alert, alerted := alertFor(pw, a)
+	set := bson.M{"price_watch.current": a}
+	update := bson.M{"$set": set}
+	if alerted > 0 {
+		set["price_watch.alerted_cents"] = alerted
+	} else {
+		update["$unset"] = bson.M{"price_watch.alerted_cents": ""}
+	}
+	if _, err := w.links.UpdateOne(ctx, bson.M{"_id": l.ID}, update); err != nil {
+		return err
+	}
+	if alert == "" {
+		return nil
+	}
+	return w.notifier.Notify(ctx, notify.Notification{
+		UserID: pw.UserID,
+		Title:  l.Title,
+		Body:   alert,
+		LinkID: l.ID.Hex(),
+		URL:    l.URL,
+	})
+}
+
+// alertFor retourne le texte de l'alerte à envoyer pour le prix relevé a,
+// vide s'il n'y en a pas, et le prix d'alerte à retenir. Sous le seuil, une
+// alerte n'est répétée que si le prix baisse encore ; repassé au-dessus, le
+// seuil est réarmé.
+func alertFor(pw *link.PriceWatch, a price.Amount) (string, int64) {
+	prev := pw.Current
+	if pw.Threshold == 0 {
+		if prev != nil && prev.Currency == a.Currency && a.Cents < prev.Cents {
+			return fmt.Sprintf("Le prix baisse : %s au lieu de %s", a, prev), 0
+		}
+		return "", 0
+	}
+	if a.Cents > pw.Threshold {
+		return "", 0
+	}
+	if pw.AlertedCents > 0 && a.Cents >= pw.AlertedCents {
+		return "", pw.AlertedCents
+	}
+	threshold := price.Amount{Cents: pw.Threshold, Currency: a.Currency}
+	return fmt.Sprintf("Le prix passe à %s (seuil : %s)", a, threshold), a.Cents
+}
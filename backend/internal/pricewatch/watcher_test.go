@@ -0,0 +1,187 @@
+package pricewatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/notify"
+	"github.com/tribbae/backend/internal/price"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func TestFetch_Fixtures(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+	w := NewWatcher(nil, nil, nil, DefaultConfig)
+
+	tests := []struct {
+		page string
+		want price.Amount
+		err  error
+	}{
+		// L'offre épuisée, moins chère, est ignorée
+		{"product_jsonld.html", price.Amount{Cents: 3990, Currency: "EUR"}, nil},
+		{"product_microdata.html", price.Amount{Cents: 1250, Currency: "EUR"}, nil},
+		{"product_aggregate.html", price.Amount{Cents: 5999, Currency: "EUR"}, nil},
+		{"no_offer.html", price.Amount{}, ErrNoPrice},
+	}
+	for _, tt := range tests {
+		got, err := w.Fetch(context.Background(), srv.URL+"/"+tt.page)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("Fetch(%s) = %+v, %v; want %+v, %v", tt.page, got, err, tt.want, tt.err)
+		}
+	}
+	if _, err := w.Fetch(context.Background(), srv.URL+"/missing.html"); err == nil {
+		t.Error("Fetch(missing page) succeeded")
+	}
+}
+
+func TestAlertFor(t *testing.T) {
+	eur := func(cents int64) *price.Amount { return &price.Amount{Cents: cents, Currency: "EUR"} }
+	tests := []struct {
+		name        string
+		watch       link.PriceWatch
+		now         int64
+		wantAlert   bool
+		wantAlerted int64
+	}{
+		{"first check above threshold", link.PriceWatch{Threshold: 3500}, 3990, false, 0},
+		{"first check below threshold", link.PriceWatch{Threshold: 3500}, 3300, true, 3300},
+		{"at threshold", link.PriceWatch{Threshold: 3500, Current: eur(3990)}, 3500, true, 3500},
+		{"already alerted", link.PriceWatch{Threshold: 3500, Current: eur(3300), AlertedCents: 3300}, 3300, false, 3300},
+		{"drops further", link.PriceWatch{Threshold: 3500, Current: eur(3300), AlertedCents: 3300}, 3200, true, 3200},
+		{"back above rearms", link.PriceWatch{Threshold: 3500, Current: eur(3300), AlertedCents: 3300}, 4000, false, 0},
+		{"any drop", link.PriceWatch{Current: eur(3990)}, 3890, true, 0},
+		{"any drop, first check", link.PriceWatch{}, 3890, false, 0},
+		{"any drop, rise", link.PriceWatch{Current: eur(3990)}, 4190, false, 0},
+	}
+	for _, tt := range tests {
+		alert, alerted := alertFor(&tt.watch, *eur(tt.now))
+		if (alert != "") != tt.wantAlert || alerted != tt.wantAlerted {
+			t.Errorf("%s: alert %q, alerted %d; want alert %v, alerted %d", tt.name, alert, alerted, tt.wantAlert, tt.wantAlerted)
+		}
+	}
+}
+
+// Les relevés alimentent l'historique quand le prix change et préviennent
+// une seule fois par baisse sous le seuil, même avec plusieurs serveurs
+func TestCheckDue(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	var cents atomic.Int64
+	cents.Store(3990)
+	shop := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := cents.Load()
+		fmt.Fprintf(w, `<html><head><script type="application/ld+json">
+{"@type":"Product","name":"Camion","offers":{"@type":"Offer","price":"%d.%02d","priceCurrency":"EUR"}}
+</script></head></html>`, c/100, c%100)
+	}))
+	defer shop.Close()
+
+	links := db.Collection("links")
+	svc := link.NewService(links, db.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+	l, err := svc.Create(ctx, ownerID, &link.Link{Title: "Camion", URL: shop.URL + "/camion", Category: "LINK_CATEGORY_CADEAU"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := svc.WatchPrice(ctx, l.ID.Hex(), ownerID, 3500); err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	var mu sync.Mutex
+	var sent []notify.Notification
+	notifier := notify.NotifierFunc(func(_ context.Context, n notify.Notification) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, n)
+		return nil
+	})
+	cfg := DefaultConfig
+	cfg.Delay = 0
+	now := time.Now()
+	watchers := make([]*Watcher, 3)
+	for i := range watchers {
+		watchers[i] = NewWatcher(links, db.Collection("price_history"), notifier, cfg)
+		watchers[i].now = func() time.Time { return now }
+	}
+	pass := func(c int64) {
+		t.Helper()
+		cents.Store(c)
+		var wg sync.WaitGroup
+		for _, w := range watchers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := w.CheckDue(ctx); err != nil {
+					t.Errorf("check: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+		now = now.Add(cfg.RecheckAfter + time.Minute)
+	}
+
+	for _, c := range []int64{3990, 3990, 3300, 3300, 3200, 4000, 3400} {
+		pass(c)
+	}
+	if len(sent) != 3 {
+		t.Fatalf("sent %d alerts, want 3 (33,00, 32,00 and 34,00 €): %+v", len(sent), sent)
+	}
+	if sent[0].UserID != ownerID || sent[0].LinkID != l.ID.Hex() || sent[0].Body != "Le prix passe à 33,00 € (seuil : 35,00 €)" {
+		t.Errorf("first alert = %+v", sent[0])
+	}
+
+	history, err := svc.PriceHistory(ctx, l.ID.Hex(), ownerID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	var got []int64
+	for _, p := range history {
+		got = append(got, p.Amount.Cents)
+	}
+	if fmt.Sprint(got) != "[3990 3300 3200 4000 3400]" {
+		t.Errorf("history = %v, want [3990 3300 3200 4000 3400]", got)
+	}
+}
@@ -0,0 +1,387 @@
+package recipe
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// Ingredient est une ligne d'ingrédient analysée : "1/2 c. à soupe d'huile"
+// donne Quantity 0.5, Unit "c. à soupe", Item "huile". Une ligne sans quantité
+// ("sel, poivre") garde Quantity à 0 et tout son texte dans Item.
+type Ingredient struct {
+	Raw         string  `bson:"raw"                    json:"raw"`
+	Quantity    float64 `bson:"quantity,omitempty"     json:"quantity,omitempty"`
+	QuantityMax float64 `bson:"quantity_max,omitempty" json:"quantity_max,omitempty"` // "2 à 3 pommes"
+	Unit        string  `bson:"unit,omitempty"         json:"unit,omitempty"`         // clé de units
+	Item        string  `bson:"item"                   json:"item"`
+}
+
+// unit décrit une unité reconnue. base est sa valeur en grammes ou en
+// millilitres (0 pour les unités de compte comme la gousse ou la pincée).
+type unit struct {
+	dimension string // "mass", "volume" ou "" pour les unités de compte
+	base      float64
+	plural    bool // s'accorde au pluriel ("2 gousses"), contrairement aux symboles
+	imperial  bool // convertie par Metric
+	fractions bool // quantités écrites en fractions (½) plutôt qu'en décimales
+	aliases   []string
+}
+
+var units = map[string]unit{
+	"mg": {dimension: "mass", base: 0.001, aliases: []string{"mg", "milligramme", "milligrammes"}},
+	"g":  {dimension: "mass", base: 1, aliases: []string{"g", "gr", "gr.", "gramme", "grammes"}},
+	"kg": {dimension: "mass", base: 1000, aliases: []string{"kg", "kilo", "kilos", "kilogramme", "kilogrammes"}},
+	"ml": {dimension: "volume", base: 1, aliases: []string{"ml", "millilitre", "millilitres"}},
+	"cl": {dimension: "volume", base: 10, aliases: []string{"cl", "centilitre", "centilitres"}},
+	"dl": {dimension: "volume", base: 100, aliases: []string{"dl", "decilitre", "decilitres"}},
+	"l":  {dimension: "volume", base: 1000, aliases: []string{"l", "litre", "litres"}},
+
+	"c. à soupe": {dimension: "volume", base: 15, fractions: true, aliases: []string{
+		"c. a soupe", "c.a soupe", "c a soupe", "c. a s.", "c.a.s.", "c.a.s", "cas", "cs", "c. s.",
+		"cuillere a soupe", "cuilleres a soupe", "cuill. a soupe", "cuil. a soupe",
+	}},
+	"c. à café": {dimension: "volume", base: 5, fractions: true, aliases: []string{
+		"c. a cafe", "c.a cafe", "c a cafe", "c. a c.", "c.a.c.", "c.a.c", "cac", "cc", "c. c.",
+		"cuillere a cafe", "cuilleres a cafe", "cuill. a cafe", "cuil. a cafe",
+	}},
+	"tasse": {dimension: "volume", base: 250, plural: true, fractions: true, aliases: []string{"tasse", "tasses"}},
+	"verre": {dimension: "volume", base: 200, plural: true, fractions: true, aliases: []string{"verre", "verres"}},
+
+	"cup":   {dimension: "volume", base: 240, imperial: true, fractions: true, aliases: []string{"cup", "cups"}},
+	"tbsp":  {dimension: "volume", base: 15, imperial: true, fractions: true, aliases: []string{"tbsp", "tablespoon", "tablespoons"}},
+	"tsp":   {dimension: "volume", base: 5, imperial: true, fractions: true, aliases: []string{"tsp", "teaspoon", "teaspoons"}},
+	"fl oz": {dimension: "volume", base: 29.5735, imperial: true, aliases: []string{"fl oz", "fl. oz."}},
+	"oz":    {dimension: "mass", base: 28.3495, imperial: true, aliases: []string{"oz", "ounce", "ounces"}},
+	"lb":    {dimension: "mass", base: 453.592, imperial: true, aliases: []string{"lb", "lbs", "pound", "pounds"}},
+}
+
+// countUnits sont les unités de compte, qui ne se convertissent pas
+var countUnits = []string{
+	"pincée", "sachet", "gousse", "tranche", "bouquet", "brin", "boîte", "pot",
+	"feuille", "botte", "noisette", "filet", "poignée", "cube", "paquet",
+	"barquette", "rouleau", "morceau", "tablette", "carré", "bâton", "zeste",
+}
+
+// aliasIndex associe chaque alias replié (textutil.Fold) à sa clé d'unité,
+// les plus longs d'abord pour que "cl" ne soit pas lu comme "c"
+var aliasIndex []struct{ alias, key string }
+
+func init() {
+	for _, name := range countUnits {
+		plural := name + "s"
+		if strings.HasSuffix(name, "s") || strings.HasSuffix(name, "x") {
+			plural = name
+		}
+		if name == "morceau" {
+			plural = "morceaux"
+		}
+		units[name] = unit{plural: true, fractions: true, aliases: []string{name, plural}}
+	}
+	for key, u := range units {
+		for _, a := range u.aliases {
+			aliasIndex = append(aliasIndex, struct{ alias, key string }{textutil.Fold(a), key})
+		}
+	}
+	sort.Slice(aliasIndex, func(i, j int) bool {
+		if len(aliasIndex[i].alias) != len(aliasIndex[j].alias) {
+			return len(aliasIndex[i].alias) > len(aliasIndex[j].alias)
+		}
+		return aliasIndex[i].alias < aliasIndex[j].alias
+	})
+}
+
+var vulgarFractions = map[rune]float64{
+	'½': 0.5, '¼': 0.25, '¾': 0.75, '⅓': 1.0 / 3, '⅔': 2.0 / 3, '⅛': 0.125,
+}
+
+var (
+	fractionRe = regexp.MustCompile(`^(\d+)\s*/\s*(\d+)`)
+	decimalRe  = regexp.MustCompile(`^\d+(?:[.,]\d+)?`)
+	rangeRe    = regexp.MustCompile(`^\s*(?:-|–|à|a)\s*`)
+)
+
+// ParseIngredient analyse une ligne d'ingrédient
+func ParseIngredient(line string) Ingredient {
+	line = strings.Join(strings.Fields(line), " ")
+	ing := Ingredient{Raw: line, Item: line}
+
+	q, rest, ok := parseQuantity(line)
+	if !ok {
+		return ing
+	}
+	ing.Quantity = q
+	if m := rangeRe.FindString(rest); m != "" {
+		if max, after, ok := parseQuantity(rest[len(m):]); ok && max > q {
+			ing.QuantityMax, rest = max, after
+		}
+	}
+	rest = strings.TrimSpace(rest)
+	if key, after, ok := parseUnit(rest); ok {
+		ing.Unit, rest = key, after
+	}
+	ing.Item = trimOf(rest)
+	return ing
+}
+
+// ParseIngredients analyse chaque ligne de lines
+func ParseIngredients(lines []string) []Ingredient {
+	out := make([]Ingredient, 0, len(lines))
+	for _, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			out = append(out, ParseIngredient(l))
+		}
+	}
+	return out
+}
+
+// parseQuantity lit un nombre en début de texte : "2", "1,5", "1/2", "1 1/2",
+// "1½", "½" ou l'article "un"/"une"
+func parseQuantity(s string) (float64, string, bool) {
+	s = strings.TrimLeft(s, " ")
+	if r, size := utf8.DecodeRuneInString(s); vulgarFractions[r] != 0 {
+		return vulgarFractions[r], s[size:], true
+	}
+	if m := fractionRe.FindStringSubmatch(s); m != nil {
+		if v, ok := fraction(m[1], m[2]); ok {
+			return v, s[len(m[0]):], true
+		}
+	}
+	if m := decimalRe.FindString(s); m != "" {
+		v, err := strconv.ParseFloat(strings.Replace(m, ",", ".", 1), 64)
+		if err != nil {
+			return 0, s, false
+		}
+		rest := s[len(m):]
+		// Partie fractionnaire : "1 1/2", "1½"
+		trimmed := strings.TrimLeft(rest, " ")
+		if r, size := utf8.DecodeRuneInString(trimmed); vulgarFractions[r] != 0 {
+			return v + vulgarFractions[r], trimmed[size:], true
+		}
+		if f := fractionRe.FindStringSubmatch(trimmed); f != nil && len(trimmed) < len(rest) {
+			if fv, ok := fraction(f[1], f[2]); ok && fv < 1 {
+				return v + fv, trimmed[len(f[0]):], true
+			}
+		}
+		return v, rest, true
+	}
+	folded := textutil.Fold(s)
+	for _, article := range []string{"une ", "un "} {
+		if strings.HasPrefix(folded, article) && !strings.HasPrefix(folded[len(article):], "peu") {
+			return 1, s[len(article):], true
+		}
+	}
+	return 0, s, false
+}
+
+func fraction(num, den string) (float64, bool) {
+	n, err1 := strconv.Atoi(num)
+	d, err2 := strconv.Atoi(den)
+	if err1 != nil || err2 != nil || d == 0 {
+		return 0, false
+	}
+	return float64(n) / float64(d), true
+}
+
+// parseUnit reconnaît une unité en début de texte, sans tenir compte des
+// accents ni de la casse
+func parseUnit(s string) (string, string, bool) {
+	folded := textutil.Fold(s)
+	for _, a := range aliasIndex {
+		if !strings.HasPrefix(folded, a.alias) {
+			continue
+		}
+		// L'unité doit être un mot entier : "l" ne doit pas prendre "lait"
+		if next, _ := utf8.DecodeRuneInString(folded[len(a.alias):]); unicode.IsLetter(next) || unicode.IsDigit(next) {
+			continue
+		}
+		return a.key, strings.TrimLeft(s[originalLen(s, len(a.alias)):], " ."), true
+	}
+	return "", s, false
+}
+
+// originalLen retourne la longueur en octets du préfixe de s dont la forme
+// repliée fait n octets
+func originalLen(s string, n int) int {
+	folded := 0
+	for i, r := range s {
+		if folded >= n {
+			return i
+		}
+		folded += len(textutil.Fold(string(r)))
+	}
+	return len(s)
+}
+
+// trimOf retire le "de" qui relie l'unité à l'ingrédient
+func trimOf(s string) string {
+	s = strings.TrimSpace(s)
+	folded := textutil.Fold(s)
+	for _, prefix := range []string{"de ", "d'"} {
+		if strings.HasPrefix(folded, prefix) {
+			return strings.TrimSpace(s[originalLen(s, len(prefix)):])
+		}
+	}
+	return s
+}
+
+// Scale multiplie les quantités par factor et repasse à l'unité métrique la
+// plus lisible ("1200 g" devient "1,2 kg")
+func (i Ingredient) Scale(factor float64) Ingredient {
+	if i.Quantity == 0 {
+		return i
+	}
+	i.Quantity *= factor
+	i.QuantityMax *= factor
+	return i.normalize()
+}
+
+// Metric convertit les unités impériales (cup, oz, lb…) en grammes ou millilitres
+func (i Ingredient) Metric() Ingredient {
+	u, ok := units[i.Unit]
+	if !ok || !u.imperial || i.Quantity == 0 {
+		return i
+	}
+	i.Quantity *= u.base
+	i.QuantityMax *= u.base
+	i.Unit = "g"
+	if u.dimension == "volume" {
+		i.Unit = "ml"
+	}
+	return i.normalize()
+}
+
+// normalize choisit entre g et kg, ou entre ml, cl et l, selon la quantité
+func (i Ingredient) normalize() Ingredient {
+	u, ok := units[i.Unit]
+	if !ok || u.imperial || u.fractions || u.dimension == "" {
+		return i
+	}
+	base := i.Quantity * u.base
+	target := i.Unit
+	switch u.dimension {
+	case "mass":
+		switch {
+		case base >= 1000:
+			target = "kg"
+		case base >= 1 && (i.Unit == "kg" || i.Unit == "mg"):
+			target = "g"
+		}
+	case "volume":
+		switch {
+		case base >= 1000:
+			target = "l"
+		case i.Unit == "l":
+			target = "cl"
+		}
+	}
+	if target != i.Unit {
+		ratio := u.base / units[target].base
+		i.Quantity *= ratio
+		i.QuantityMax *= ratio
+		i.Unit = target
+	}
+	return i
+}
+
+// String écrit l'ingrédient en français : "1½ c. à soupe d'huile", "2 à 3 pommes"
+func (i Ingredient) String() string {
+	if i.Quantity == 0 {
+		return i.Item
+	}
+	u := units[i.Unit]
+	q := formatQuantity(i.Quantity, i.Unit == "" || u.fractions)
+	amount := i.Quantity
+	if i.QuantityMax > 0 {
+		q += " à " + formatQuantity(i.QuantityMax, i.Unit == "" || u.fractions)
+		amount = i.QuantityMax
+	}
+	if i.Unit == "" {
+		return strings.TrimSpace(q + " " + i.Item)
+	}
+	name := i.Unit
+	if u.plural && amount >= 2 {
+		name = u.aliases[1]
+	}
+	if i.Item == "" {
+		return q + " " + name
+	}
+	return q + " " + name + " " + of(i.Item)
+}
+
+// of ajoute "de", élidé devant une voyelle ou un h muet
+func of(item string) string {
+	r, _ := utf8.DecodeRuneInString(textutil.Fold(item))
+	if strings.ContainsRune("aeiouyh", r) {
+		return "d'" + item
+	}
+	return "de " + item
+}
+
+var fractionGlyphs = []struct {
+	value float64
+	glyph string
+}{
+	{0, ""}, {0.25, "¼"}, {1.0 / 3, "⅓"}, {0.5, "½"}, {2.0 / 3, "⅔"}, {0.75, "¾"}, {1, ""},
+}
+
+// formatQuantity arrondit au quart ou au tiers le plus proche pour les
+// cuillères et les pièces, et à une précision raisonnable pour les masses et
+// volumes métriques
+func formatQuantity(q float64, fractions bool) string {
+	if fractions {
+		whole := math.Floor(q)
+		best := fractionGlyphs[0]
+		for _, f := range fractionGlyphs {
+			if math.Abs(q-whole-f.value) < math.Abs(q-whole-best.value) {
+				best = f
+			}
+		}
+		if best.value == 1 {
+			whole++
+		}
+		if whole == 0 && best.glyph == "" {
+			return "¼" // jamais "0 œuf"
+		}
+		if whole == 0 {
+			return best.glyph
+		}
+		return strconv.Itoa(int(whole)) + best.glyph
+	}
+	var s string
+	switch {
+	case q < 10:
+		s = strconv.FormatFloat(math.Round(q*100)/100, 'f', -1, 64)
+	case q < 100:
+		s = strconv.FormatFloat(math.Round(q), 'f', -1, 64)
+	default:
+		s = strconv.FormatFloat(math.Round(q/5)*5, 'f', -1, 64)
+	}
+	return strings.Replace(s, ".", ",", 1)
+}
+
+// Dimension retourne "mass" ou "volume" pour une unité convertible en grammes
+// ou en millilitres, et "" pour une unité de compte
+func Dimension(unitKey string) string {
+	return units[unitKey].dimension
+}
+
+// ToBase convertit une quantité en grammes ou en millilitres. Une unité de
+// compte est retournée telle quelle.
+func ToBase(q float64, unitKey string) (float64, string) {
+	u, ok := units[unitKey]
+	switch {
+	case !ok || u.dimension == "":
+		return q, unitKey
+	case u.dimension == "mass":
+		return q * u.base, "g"
+	default:
+		return q * u.base, "ml"
+	}
+}
@@ -0,0 +1,77 @@
+package recipe
+
+import "testing"
+
+func TestParseIngredient(t *testing.T) {
+	cases := []struct {
+		line     string
+		quantity float64
+		max      float64
+		unit     string
+		item     string
+	}{
+		{"250 g de farine", 250, 0, "g", "farine"},
+		{"250g de farine", 250, 0, "g", "farine"},
+		{"1/2 c. à soupe d'huile d'olive", 0.5, 0, "c. à soupe", "huile d'olive"},
+		{"1 1/2 cuillère à café de sel", 1.5, 0, "c. à café", "sel"},
+		{"½ cuillère à soupe de sucre", 0.5, 0, "c. à soupe", "sucre"},
+		{"1½ CàS de miel", 1.5, 0, "c. à soupe", "miel"},
+		{"2,5 kg de pommes de terre", 2.5, 0, "kg", "pommes de terre"},
+		{"50 cl de lait", 50, 0, "cl", "lait"},
+		{"1 l de lait", 1, 0, "l", "lait"},
+		{"3 œufs", 3, 0, "", "œufs"},
+		{"2 à 3 pommes", 2, 3, "", "pommes"},
+		{"2-3 gousses d’ail", 2, 3, "gousse", "ail"},
+		{"une pincée de sel", 1, 0, "pincée", "sel"},
+		{"1 cup flour", 1, 0, "cup", "flour"},
+		{"sel, poivre", 0, 0, "", "sel, poivre"},
+		{"un peu de persil", 0, 0, "", "un peu de persil"},
+		{"2 limes", 2, 0, "", "limes"},
+	}
+	for _, tc := range cases {
+		got := ParseIngredient(tc.line)
+		if got.Quantity != tc.quantity || got.QuantityMax != tc.max || got.Unit != tc.unit || got.Item != tc.item || got.Raw != tc.line {
+			t.Errorf("ParseIngredient(%q) = %+v, want %v-%v %q %q", tc.line, got, tc.quantity, tc.max, tc.unit, tc.item)
+		}
+	}
+}
+
+func TestIngredient_ScaleAndString(t *testing.T) {
+	cases := []struct {
+		line   string
+		factor float64
+		want   string
+	}{
+		{"250 g de farine", 2, "500 g de farine"},
+		{"600 g de farine", 2, "1,2 kg de farine"},
+		{"75 cl de lait", 2, "1,5 l de lait"},
+		{"1 l de lait", 0.5, "50 cl de lait"},
+		{"1/2 c. à soupe d'huile", 3, "1½ c. à soupe d'huile"},
+		{"3 œufs", 0.5, "1½ œufs"},
+		{"1 gousse d'ail", 2, "2 gousses d'ail"},
+		{"2 à 3 pommes", 2, "4 à 6 pommes"},
+		{"sel", 4, "sel"},
+		{"1 pincée de sel", 0.1, "¼ pincée de sel"},
+	}
+	for _, tc := range cases {
+		if got := ParseIngredient(tc.line).Scale(tc.factor).String(); got != tc.want {
+			t.Errorf("%q × %v = %q, want %q", tc.line, tc.factor, got, tc.want)
+		}
+	}
+}
+
+func TestIngredient_Metric(t *testing.T) {
+	cases := map[string]string{
+		"2 cups milk":          "480 ml de milk",
+		"1 lb butter":          "455 g de butter",
+		"3 lb potatoes":        "1,36 kg de potatoes",
+		"1 tbsp sugar":         "15 ml de sugar",
+		"2 c. à soupe de miel": "2 c. à soupe de miel",
+		"200 g de riz":         "200 g de riz",
+	}
+	for line, want := range cases {
+		if got := ParseIngredient(line).Metric().String(); got != want {
+			t.Errorf("Metric(%q) = %q, want %q", line, got, want)
+		}
+	}
+}
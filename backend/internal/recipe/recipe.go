@@ -0,0 +1,167 @@
+// Package recipe construit la fiche structurée d'une recette à partir des
+// données schema.org (Recipe) publiées par les sites de cuisine.
+package recipe
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tribbae/backend/internal/schemaorg"
+)
+
+// Recipe est la fiche d'une recette. Les ingrédients restent sur le lien
+// (link.Link.Ingredients), où l'utilisateur peut les modifier.
+type Recipe struct {
+	Instructions []string   `bson:"instructions,omitempty"  json:"instructions,omitempty"`
+	Yield        string     `bson:"yield,omitempty"         json:"yield,omitempty"`    // tel qu'annoncé : "6 parts"
+	Servings     int32      `bson:"servings,omitempty"      json:"servings,omitempty"` // nombre de parts lu dans Yield
+	PrepMinutes  int32      `bson:"prep_minutes,omitempty"  json:"prep_minutes,omitempty"`
+	CookMinutes  int32      `bson:"cook_minutes,omitempty"  json:"cook_minutes,omitempty"`
+	TotalMinutes int32      `bson:"total_minutes,omitempty" json:"total_minutes,omitempty"`
+	Nutrition    *Nutrition `bson:"nutrition,omitempty"     json:"nutrition,omitempty"`
+}
+
+// Nutrition reprend les valeurs de schema.org/NutritionInformation telles
+// qu'écrites par le site ("250 kcal", "12 g")
+type Nutrition struct {
+	ServingSize  string `bson:"serving_size,omitempty"  json:"serving_size,omitempty"`
+	Calories     string `bson:"calories,omitempty"      json:"calories,omitempty"`
+	Fat          string `bson:"fat,omitempty"           json:"fat,omitempty"`
+	SaturatedFat string `bson:"saturated_fat,omitempty" json:"saturated_fat,omitempty"`
+	Carbohydrate string `bson:"carbohydrate,omitempty"  json:"carbohydrate,omitempty"`
+	Sugar        string `bson:"sugar,omitempty"         json:"sugar,omitempty"`
+	Fiber        string `bson:"fiber,omitempty"         json:"fiber,omitempty"`
+	Protein      string `bson:"protein,omitempty"       json:"protein,omitempty"`
+	Sodium       string `bson:"sodium,omitempty"        json:"sodium,omitempty"`
+}
+
+// FromSchema retourne les ingrédients et la fiche de la première recette
+// trouvée parmi les objets schema.org de la page, ou nil s'il n'y en a pas.
+func FromSchema(items []schemaorg.Item) ([]string, *Recipe) {
+	recipes := schemaorg.Find(items, "Recipe")
+	if len(recipes) == 0 {
+		return nil, nil
+	}
+	it := recipes[0]
+
+	ingredients := it.Strings("recipeIngredient")
+	if len(ingredients) == 0 {
+		ingredients = it.Strings("ingredients") // ancien nom de la propriété
+	}
+	for i := range ingredients {
+		ingredients[i] = clean(ingredients[i])
+	}
+
+	r := &Recipe{
+		Instructions: instructions(it["recipeInstructions"]),
+		PrepMinutes:  minutes(it.String("prepTime")),
+		CookMinutes:  minutes(it.String("cookTime")),
+		TotalMinutes: minutes(it.String("totalTime")),
+	}
+	if r.TotalMinutes == 0 {
+		r.TotalMinutes = r.PrepMinutes + r.CookMinutes
+	}
+	// recipeYield est souvent doublé : ["6", "6 parts"]. On garde le libellé le
+	// plus parlant et le premier nombre.
+	for _, y := range it.Strings("recipeYield") {
+		y = clean(y)
+		if len(y) > len(r.Yield) {
+			r.Yield = y
+		}
+		if r.Servings == 0 {
+			r.Servings = firstNumber(y)
+		}
+	}
+	if n := it.Items("nutrition"); len(n) > 0 {
+		r.Nutrition = nutrition(n[0])
+	}
+	return ingredients, r
+}
+
+func nutrition(it schemaorg.Item) *Nutrition {
+	n := &Nutrition{
+		ServingSize:  clean(it.String("servingSize")),
+		Calories:     clean(it.String("calories")),
+		Fat:          clean(it.String("fatContent")),
+		SaturatedFat: clean(it.String("saturatedFatContent")),
+		Carbohydrate: clean(it.String("carbohydrateContent")),
+		Sugar:        clean(it.String("sugarContent")),
+		Fiber:        clean(it.String("fiberContent")),
+		Protein:      clean(it.String("proteinContent")),
+		Sodium:       clean(it.String("sodiumContent")),
+	}
+	if *n == (Nutrition{}) {
+		return nil
+	}
+	return n
+}
+
+// instructions aplatit recipeInstructions, qui peut être un texte, une liste
+// de textes, de HowToStep ou de HowToSection contenant des HowToStep
+func instructions(v any) []string {
+	var steps []string
+	var visit func(v any)
+	visit = func(v any) {
+		switch v := v.(type) {
+		case string:
+			for _, line := range strings.Split(stripTags(v), "\n") {
+				if line = clean(line); line != "" {
+					steps = append(steps, line)
+				}
+			}
+		case []any:
+			for _, e := range v {
+				visit(e)
+			}
+		case map[string]any:
+			it := schemaorg.Item(v)
+			if it.Is("HowToSection") || it["itemListElement"] != nil {
+				visit(it["itemListElement"])
+				return
+			}
+			if text := it.String("text"); text != "" {
+				visit(text)
+			} else {
+				visit(it.String("name"))
+			}
+		}
+	}
+	visit(v)
+	return steps
+}
+
+var (
+	tagRe    = regexp.MustCompile(`(?i)<br\s*/?>|</?(p|li|ol|ul|div)[^>]*>`)
+	anyTagRe = regexp.MustCompile(`<[^>]*>`)
+	numberRe = regexp.MustCompile(`\d+`)
+)
+
+// stripTags transforme le HTML parfois glissé dans les instructions en texte,
+// un paragraphe par ligne
+func stripTags(s string) string {
+	s = tagRe.ReplaceAllString(s, "\n")
+	return anyTagRe.ReplaceAllString(s, "")
+}
+
+func clean(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+func minutes(iso string) int32 {
+	d, ok := schemaorg.ParseDuration(iso)
+	if !ok {
+		return 0
+	}
+	return int32(d.Round(time.Minute) / time.Minute)
+}
+
+func firstNumber(s string) int32 {
+	n, err := strconv.Atoi(numberRe.FindString(s))
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
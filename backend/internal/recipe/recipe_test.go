@@ -0,0 +1,72 @@
+package recipe
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tribbae/backend/internal/schemaorg"
+)
+
+func fromJSON(t *testing.T, raw string) ([]string, *Recipe) {
+	t.Helper()
+	var it schemaorg.Item
+	if err := json.Unmarshal([]byte(raw), &it); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return FromSchema([]schemaorg.Item{it})
+}
+
+func TestFromSchema(t *testing.T) {
+	ingredients, r := fromJSON(t, `{
+		"@type": "Recipe",
+		"recipeIngredient": ["250 g de farine", " 4  œufs ", "50 cl de lait &amp; eau"],
+		"recipeYield": ["6", "6 parts"],
+		"prepTime": "PT15M",
+		"cookTime": "PT1H",
+		"recipeInstructions": [
+			{"@type": "HowToSection", "name": "Pâte", "itemListElement": [
+				{"@type": "HowToStep", "text": "Mélanger la farine et les œufs."},
+				{"@type": "HowToStep", "text": "Ajouter le lait."}
+			]},
+			{"@type": "HowToStep", "text": "Cuire."}
+		],
+		"nutrition": {"@type": "NutritionInformation", "calories": "320 kcal", "proteinContent": "9 g"}
+	}`)
+	if want := []string{"250 g de farine", "4 œufs", "50 cl de lait & eau"}; !reflect.DeepEqual(ingredients, want) {
+		t.Errorf("ingredients = %q, want %q", ingredients, want)
+	}
+	want := &Recipe{
+		Instructions: []string{"Mélanger la farine et les œufs.", "Ajouter le lait.", "Cuire."},
+		Yield:        "6 parts",
+		Servings:     6,
+		PrepMinutes:  15,
+		CookMinutes:  60,
+		TotalMinutes: 75,
+		Nutrition:    &Nutrition{Calories: "320 kcal", Protein: "9 g"},
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("recipe = %+v, want %+v", r, want)
+	}
+}
+
+func TestFromSchema_TextInstructions(t *testing.T) {
+	_, r := fromJSON(t, `{
+		"@type": "Recipe",
+		"recipeYield": 4,
+		"totalTime": "PT40M",
+		"recipeInstructions": "<p>Éplucher les pommes.</p><p>Enfourner<br>30 minutes.</p>"
+	}`)
+	if want := []string{"Éplucher les pommes.", "Enfourner", "30 minutes."}; !reflect.DeepEqual(r.Instructions, want) {
+		t.Errorf("instructions = %q, want %q", r.Instructions, want)
+	}
+	if r.Servings != 4 || r.TotalMinutes != 40 || r.Nutrition != nil {
+		t.Errorf("recipe = %+v", r)
+	}
+}
+
+func TestFromSchema_NoRecipe(t *testing.T) {
+	if ingredients, r := fromJSON(t, `{"@type": "Product", "name": "Lego"}`); ingredients != nil || r != nil {
+		t.Errorf("got %v, %v; want nothing", ingredients, r)
+	}
+}
@@ -0,0 +1,201 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BirthdayDelivery est la trace d'un rappel d'anniversaire. Son index unique
+// sur (child_id, user_id, days, birthday) fait qu'un seul serveur l'envoie.
+type BirthdayDelivery struct {
+	ChildID   string     `bson:"child_id"`
+	UserID    string     `bson:"user_id"`
+	Days      int        `bson:"days"`
+	Birthday  time.Time  `bson:"birthday"`
+	ClaimedAt time.Time  `bson:"claimed_at"`
+	SentAt    *time.Time `bson:"sent_at,omitempty"`
+}
+
+// SendBirthdays envoie les rappels d'anniversaire des enfants arrivés à
+// échéance depuis moins de cfg.Grace, à cfg.Hour, heure locale, et retourne
+// le nombre de rappels envoyés
+func (s *Scheduler) SendBirthdays(ctx context.Context) (int, error) {
+	if len(s.cfg.BirthdayOffsets) == 0 {
+		return 0, nil
+	}
+	now := s.now().In(s.cfg.Location)
+
+	// Jours dont le rappel peut être dû : d'aujourd'hui à now - Grace
+	var days []time.Time
+	for d := dayOf(now.Add(-s.cfg.Grace)); !d.After(now); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	var monthDays []int32
+	for _, d := range days {
+		for _, offset := range s.cfg.BirthdayOffsets {
+			monthDays = append(monthDays, child.MonthDaysOn(d.AddDate(0, 0, offset))...)
+		}
+	}
+	cursor, err := s.children.Find(ctx, bson.M{"birthMonthDay": bson.M{"$in": monthDays}})
+	if err != nil {
+		return 0, err
+	}
+	var children []*child.Child
+	if err := cursor.All(ctx, &children); err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, c := range children {
+		birthYear, _, _ := c.Birthday()
+		for _, d := range days {
+			due := time.Date(d.Year(), d.Month(), d.Day(), s.cfg.Hour, 0, 0, 0, s.cfg.Location)
+			if due.After(now) || now.Sub(due) > s.cfg.Grace {
+				continue
+			}
+			for _, offset := range s.cfg.BirthdayOffsets {
+				birthday := d.AddDate(0, 0, offset)
+				age := birthday.Year() - birthYear
+				if age < 1 || !c.BirthdayIn(birthday.Year(), s.cfg.Location).Equal(birthday) {
+					continue
+				}
+				n, err := s.deliverBirthday(ctx, c, birthday, age, offset, now)
+				if err != nil {
+					log.Printf("ERROR: reminders: child %s, %d days: %v", c.ID.Hex(), offset, err)
+				}
+				sent += n
+			}
+		}
+	}
+	return sent, ctx.Err()
+}
+
+// dayOf retourne minuit du jour de t, dans le fuseau de t
+func dayOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// deliverBirthday prévient les parents (propriétaire, co-parents et parents
+// de son foyer), et au rappel le plus en avance les collaborateurs des listes
+// rattachées à l'enfant si cfg.NotifyCollaborators
+func (s *Scheduler) deliverBirthday(ctx context.Context, c *child.Child, birthday time.Time, age, days int, now time.Time) (int, error) {
+	title := "Anniversaire de " + c.Name
+	sent := 0
+	notified := map[string]bool{}
+	family, err := child.FamilyIDs(ctx, s.folders.Database(), c.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	var parents bson.A
+	for _, id := range append(c.ParentIDs(), family...) {
+		if notified[id.Hex()] {
+			continue
+		}
+		parentID := id.Hex()
+		notified[parentID] = true
+		parents = append(parents, parentID)
+		n := notify.Notification{UserID: parentID, Title: title, Body: birthdayMessage(c.Name, age, birthday, days)}
+		d := BirthdayDelivery{ChildID: c.ID.Hex(), UserID: parentID, Days: days, Birthday: birthday, ClaimedAt: now}
+		ok, err := s.claimAndSend(ctx, d, n)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	if !s.cfg.NotifyCollaborators || days == 0 || days != slices.Max(s.cfg.BirthdayOffsets) {
+		return sent, nil
+	}
+
+	// Les listes de l'enfant, pour ceux qui les partagent
+	cursor, err := s.folders.Find(ctx, bson.M{"child_ids": c.ID.Hex(), "owner_id": bson.M{"$in": parents}, "deleted_at": nil})
+	if err != nil {
+		return sent, err
+	}
+	var folders []struct {
+		Name          string `bson:"name"`
+		Collaborators []struct {
+			UserID string `bson:"user_id"`
+		} `bson:"collaborators"`
+	}
+	if err := cursor.All(ctx, &folders); err != nil {
+		return sent, err
+	}
+	for _, f := range folders {
+		for _, collab := range f.Collaborators {
+			if notified[collab.UserID] {
+				continue
+			}
+			notified[collab.UserID] = true
+			n := notify.Notification{
+				UserID: collab.UserID,
+				Title:  title,
+				Body:   fmt.Sprintf("%s aura %d ans le %s — liste « %s »", c.Name, age, frenchDate(birthday), f.Name),
+			}
+			d := BirthdayDelivery{ChildID: c.ID.Hex(), UserID: collab.UserID, Days: days, Birthday: birthday, ClaimedAt: now}
+			ok, err := s.claimAndSend(ctx, d, n)
+			if err != nil {
+				return sent, err
+			}
+			if ok {
+				sent++
+			}
+		}
+	}
+	return sent, nil
+}
+
+// claimAndSend réclame puis envoie un rappel d'anniversaire, comme deliver
+func (s *Scheduler) claimAndSend(ctx context.Context, d BirthdayDelivery, n notify.Notification) (bool, error) {
+	if _, err := s.birthdays.InsertOne(ctx, d); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	key := bson.M{"child_id": d.ChildID, "user_id": d.UserID, "days": d.Days, "birthday": d.Birthday}
+	if err := s.notifier.Notify(ctx, n); err != nil {
+		if _, delErr := s.birthdays.DeleteOne(ctx, key); delErr != nil {
+			err = errors.Join(err, delErr)
+		}
+		return false, err
+	}
+	_, err := s.birthdays.UpdateOne(ctx, key, bson.M{"$set": bson.M{"sent_at": s.now()}})
+	return true, err
+}
+
+// birthdayMessage est le texte du rappel au parent : « Léa aura 6 ans dans
+// 14 jours, le 15 mars — pensez aux cadeaux »
+func birthdayMessage(name string, age int, birthday time.Time, days int) string {
+	switch days {
+	case 0:
+		return fmt.Sprintf("%s fête ses %d ans aujourd'hui", name, age)
+	case 1:
+		return fmt.Sprintf("%s aura %d ans demain", name, age)
+	default:
+		return fmt.Sprintf("%s aura %d ans dans %d jours, le %s — pensez aux cadeaux", name, age, days, frenchDate(birthday))
+	}
+}
+
+var frenchMonths = [...]string{"janvier", "février", "mars", "avril", "mai", "juin",
+	"juillet", "août", "septembre", "octobre", "novembre", "décembre"}
+
+// frenchDate écrit « 15 mars », « 1er mai »
+func frenchDate(t time.Time) string {
+	day := fmt.Sprint(t.Day())
+	if t.Day() == 1 {
+		day = "1er"
+	}
+	return day + " " + frenchMonths[t.Month()-1]
+}
@@ -0,0 +1,127 @@
+package reminder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/child"
+	"github.com/tribbae/backend/internal/db"
+	"github.com/tribbae/backend/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBirthdayMessage(t *testing.T) {
+	birthday := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		days int
+		want string
+	}{
+		{14, "Léa aura 6 ans dans 14 jours, le 1er mai — pensez aux cadeaux"},
+		{1, "Léa aura 6 ans demain"},
+		{0, "Léa fête ses 6 ans aujourd'hui"},
+	}
+	for _, tt := range tests {
+		if got := birthdayMessage("Léa", 6, birthday, tt.days); got != tt.want {
+			t.Errorf("birthdayMessage(%d) = %q, want %q", tt.days, got, tt.want)
+		}
+	}
+}
+
+// Les parents sont prévenus deux semaines avant et le jour même, une seule
+// fois quel que soit le nombre de serveurs ; les collaborateurs de la liste
+// de l'enfant le sont au premier rappel
+func TestSendBirthdays(t *testing.T) {
+	_, database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := db.EnsureIndexes(ctx, database); err != nil {
+		t.Fatalf("indexes: %v", err)
+	}
+	parent := primitive.NewObjectID()
+	coParent := primitive.NewObjectID()
+	granny := primitive.NewObjectID().Hex()
+	if _, err := database.Collection("users").InsertOne(ctx, bson.M{"_id": coParent, "email": "dad@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	lea, err := child.NewService(database).Create(ctx, parent, "Léa", time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	if err != nil {
+		t.Fatalf("create child: %v", err)
+	}
+	if _, err := child.NewService(database).AddCoParent(ctx, lea.ID, parent, "dad@example.com"); err != nil {
+		t.Fatalf("add co-parent: %v", err)
+	}
+	if _, err := child.NewService(database).AcceptCoParentInvite(ctx, lea.ID, coParent); err != nil {
+		t.Fatalf("accept co-parent invite: %v", err)
+	}
+	if _, err := child.NewService(database).Create(ctx, parent, "Tom", time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC).UnixMilli()); err != nil {
+		t.Fatalf("create child: %v", err)
+	}
+	if _, err := database.Collection("folders").InsertOne(ctx, bson.M{
+		"owner_id": parent.Hex(), "name": "Anniversaire de Léa", "child_ids": bson.A{lea.ID.Hex()},
+		"collaborators": bson.A{bson.M{"user_id": granny, "role": "viewer"}},
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+
+	var mu sync.Mutex
+	var got []notify.Notification
+	notifier := notify.NotifierFunc(func(_ context.Context, n notify.Notification) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, n)
+		return nil
+	})
+	cfg := DefaultConfig
+	cfg.NotifyCollaborators = true
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, cfg.Location)
+	replicas := make([]*Scheduler, 3)
+	for i := range replicas {
+		replicas[i] = NewScheduler(database.Collection("links"), database.Collection("reminders"), notifier, cfg)
+		replicas[i].now = func() time.Time { return now }
+	}
+	var wg sync.WaitGroup
+	for _, s := range replicas {
+		wg.Add(1)
+		go func(s *Scheduler) {
+			defer wg.Done()
+			if _, err := s.SendBirthdays(ctx); err != nil {
+				t.Errorf("send birthdays: %v", err)
+			}
+		}(s)
+	}
+	wg.Wait()
+	if len(got) != 3 {
+		t.Fatalf("notifications = %+v, want both parents and granny", got)
+	}
+	for _, n := range got {
+		switch n.UserID {
+		case parent.Hex(), coParent.Hex():
+			if n.Body != "Léa aura 6 ans dans 14 jours, le 15 mars — pensez aux cadeaux" {
+				t.Errorf("parent body = %q", n.Body)
+			}
+		case granny:
+			if n.Body != "Léa aura 6 ans le 15 mars — liste « Anniversaire de Léa »" {
+				t.Errorf("granny body = %q", n.Body)
+			}
+		default:
+			t.Errorf("unexpected notification %+v", n)
+		}
+	}
+
+	// Le jour même, seuls les parents
+	got = nil
+	now = time.Date(2026, 3, 15, 8, 30, 0, 0, cfg.Location)
+	if n, err := replicas[0].SendBirthdays(ctx); err != nil || n != 2 {
+		t.Errorf("birthday morning = %d, %v, %+v", n, err, got)
+	}
+	// Avant 8 h, rien
+	got = nil
+	now = time.Date(2026, 6, 17, 7, 0, 0, 0, cfg.Location)
+	if n, err := replicas[0].SendBirthdays(ctx); err != nil || n != 0 {
+		t.Errorf("before 8 = %d, %v, %+v", n, err, got)
+	}
+}
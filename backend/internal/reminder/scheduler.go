@@ -0,0 +1,235 @@
+// Package reminder envoie les rappels des liens datés dont le rappel est
+// activé, quelques jours avant l'événement et le matin même, ainsi que les
+// rappels d'anniversaire des enfants.
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+	_ "time/tzdata" // le fuseau des rappels ne dépend pas de l'image du serveur
+
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Config règle la fréquence et les échéances des rappels
+type Config struct {
+	Interval time.Duration // entre deux passes
+	// Offsets sont les rappels, en jours avant l'événement (0 : le jour même)
+	Offsets  []int
+	Hour     int            // heure locale d'envoi des rappels
+	Location *time.Location // fuseau des familles
+	// Grace est le retard au-delà duquel un rappel manqué (serveur arrêté) est
+	// abandonné ; elle doit dépasser Interval
+	Grace time.Duration
+	// BirthdayOffsets sont les rappels d'anniversaire des enfants, en jours
+	// avant ; le plus grand laisse le temps de prévoir les cadeaux
+	BirthdayOffsets []int
+	// NotifyCollaborators prévient aussi, au plus grand de BirthdayOffsets,
+	// les collaborateurs des listes rattachées à l'enfant
+	NotifyCollaborators bool
+}
+
+// DefaultConfig envoie les rappels une semaine avant, la veille et le matin
+// même à 8 h, heure de Paris ; ceux des anniversaires deux semaines avant et
+// le jour même
+var DefaultConfig = Config{
+	Interval: 5 * time.Minute,
+	Offsets:  []int{7, 1, 0},
+	Hour:     8,
+	Location: mustLoadLocation("Europe/Paris"),
+	Grace:    12 * time.Hour,
+
+	BirthdayOffsets: []int{14, 0},
+}
+
+// Delivery est la trace d'un rappel. Son index unique sur (link_id, days,
+// event_at) fait qu'un seul serveur le réclame, et donc l'envoie ; changer
+// la date de l'événement donne de nouveaux rappels.
+type Delivery struct {
+	LinkID    string     `bson:"link_id"`
+	Days      int        `bson:"days"`
+	EventAt   time.Time  `bson:"event_at"`
+	UserID    string     `bson:"user_id"`
+	ClaimedAt time.Time  `bson:"claimed_at"`
+	SentAt    *time.Time `bson:"sent_at,omitempty"`
+}
+
+type Scheduler struct {
+	links      *mongo.Collection
+	deliveries *mongo.Collection
+	children   *mongo.Collection
+	folders    *mongo.Collection
+	birthdays  *mongo.Collection // traces des rappels d'anniversaire
+	notifier   notify.Notifier
+	cfg        Config
+	now        func() time.Time
+}
+
+func NewScheduler(links, deliveries *mongo.Collection, notifier notify.Notifier, cfg Config) *Scheduler {
+	return &Scheduler{
+		links:      links,
+		deliveries: deliveries,
+		children:   links.Database().Collection("children"),
+		folders:    links.Database().Collection("folders"),
+		birthdays:  deliveries.Database().Collection("birthday_reminders"),
+		notifier:   notifier,
+		cfg:        cfg,
+		now:        time.Now,
+	}
+}
+
+// Run lance une passe toutes les cfg.Interval jusqu'à l'annulation du contexte
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.SendDue(ctx); err != nil {
+			log.Printf("ERROR: reminders: %v", err)
+		} else if n > 0 {
+			log.Printf("reminders: %d sent", n)
+		}
+		if n, err := s.SendBirthdays(ctx); err != nil {
+			log.Printf("ERROR: birthday reminders: %v", err)
+		} else if n > 0 {
+			log.Printf("birthday reminders: %d sent", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue envoie les rappels arrivés à échéance depuis moins de cfg.Grace et
+// retourne le nombre de rappels envoyés
+func (s *Scheduler) SendDue(ctx context.Context) (int, error) {
+	if len(s.cfg.Offsets) == 0 {
+		return 0, nil
+	}
+	now := s.now()
+	// Les événements d'une journée sont datés de minuit UTC : leur rappel du
+	// matin même tombe après event_at
+	filter := bson.M{
+		"deleted_at":       nil,
+		"reminder_enabled": true,
+		"event_at": bson.M{
+			"$gte": now.Add(-48 * time.Hour),
+			"$lte": now.AddDate(0, 0, slices.Max(s.cfg.Offsets)+2),
+		},
+	}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "owner_id": 1, "title": 1, "url": 1, "location": 1, "event_at": 1})
+	cursor, err := s.links.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	var links []*link.Link
+	if err := cursor.All(ctx, &links); err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, l := range links {
+		for _, days := range s.cfg.Offsets {
+			due := s.cfg.due(l, days)
+			if due.After(now) || now.Sub(due) > s.cfg.Grace {
+				continue
+			}
+			ok, err := s.deliver(ctx, l, days, now)
+			if err != nil {
+				log.Printf("ERROR: reminders: link %s, %d days: %v", l.ID.Hex(), days, err)
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+	}
+	return sent, ctx.Err()
+}
+
+// deliver réclame puis envoie un rappel. Il retourne false si un autre
+// serveur l'a déjà réclamé. Un échec d'envoi libère la réclamation pour que
+// la passe suivante réessaie.
+func (s *Scheduler) deliver(ctx context.Context, l *link.Link, days int, now time.Time) (bool, error) {
+	d := Delivery{LinkID: l.ID.Hex(), Days: days, EventAt: *l.EventAt, UserID: l.OwnerID, ClaimedAt: now}
+	if _, err := s.deliveries.InsertOne(ctx, d); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	key := bson.M{"link_id": d.LinkID, "days": days, "event_at": d.EventAt}
+
+	err := s.notifier.Notify(ctx, notify.Notification{
+		UserID: l.OwnerID,
+		Title:  l.Title,
+		Body:   s.cfg.message(l, days),
+		LinkID: d.LinkID,
+		URL:    l.URL,
+	})
+	if err != nil {
+		if _, delErr := s.deliveries.DeleteOne(ctx, key); delErr != nil {
+			err = errors.Join(err, delErr)
+		}
+		return false, err
+	}
+	_, err = s.deliveries.UpdateOne(ctx, key, bson.M{"$set": bson.M{"sent_at": s.now()}})
+	return true, err
+}
+
+// due retourne l'heure d'envoi du rappel days jours avant l'événement : à
+// cfg.Hour, heure locale, ou une heure avant l'événement s'il commence plus tôt
+func (cfg Config) due(l *link.Link, days int) time.Time {
+	// La date d'un événement d'une journée est celle du jour UTC
+	start := l.EventAt.UTC()
+	if !l.AllDayEvent() {
+		start = l.EventAt.In(cfg.Location)
+	}
+	y, m, d := start.Date()
+	due := time.Date(y, m, d-days, cfg.Hour, 0, 0, 0, cfg.Location)
+	if !l.AllDayEvent() {
+		if latest := l.EventAt.Add(-time.Hour); due.After(latest) {
+			due = latest
+		}
+	}
+	return due
+}
+
+// message est le texte du rappel : « Demain à 14h30 — Salle des fêtes »
+func (cfg Config) message(l *link.Link, days int) string {
+	var when string
+	switch days {
+	case 0:
+		when = "Aujourd'hui"
+	case 1:
+		when = "Demain"
+	case 7:
+		when = "Dans une semaine"
+	default:
+		when = fmt.Sprintf("Dans %d jours", days)
+	}
+	if !l.AllDayEvent() {
+		when += " à " + l.EventAt.In(cfg.Location).Format("15h04")
+	}
+	if l.Location != "" {
+		when += " — " + l.Location
+	}
+	return when
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
@@ -0,0 +1,190 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/db"
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+func at(t time.Time) *link.Link {
+	return &link.Link{ID: primitive.NewObjectID(), Title: "Kermesse", EventAt: &t}
+}
+
+func TestDue(t *testing.T) {
+	cfg := DefaultConfig
+	paris := cfg.Location
+	allDay := at(time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC))
+	afternoon := at(time.Date(2026, 6, 20, 14, 30, 0, 0, paris))
+	early := at(time.Date(2026, 6, 20, 8, 30, 0, 0, paris))
+
+	tests := []struct {
+		name string
+		l    *link.Link
+		days int
+		want time.Time
+	}{
+		{"all day, week", allDay, 7, time.Date(2026, 6, 13, 8, 0, 0, 0, paris)},
+		{"all day, morning", allDay, 0, time.Date(2026, 6, 20, 8, 0, 0, 0, paris)},
+		{"timed, day before", afternoon, 1, time.Date(2026, 6, 19, 8, 0, 0, 0, paris)},
+		{"timed, morning", afternoon, 0, time.Date(2026, 6, 20, 8, 0, 0, 0, paris)},
+		{"early event, an hour before", early, 0, time.Date(2026, 6, 20, 7, 30, 0, 0, paris)},
+	}
+	for _, tt := range tests {
+		if got := cfg.due(tt.l, tt.days); !got.Equal(tt.want) {
+			t.Errorf("%s: due = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	cfg := DefaultConfig
+	l := at(time.Date(2026, 6, 20, 14, 30, 0, 0, cfg.Location))
+	l.Location = "Salle des fêtes"
+	if got := cfg.message(l, 1); got != "Demain à 14h30 — Salle des fêtes" {
+		t.Errorf("message = %q", got)
+	}
+	if got := cfg.message(at(time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)), 3); got != "Dans 3 jours" {
+		t.Errorf("message = %q", got)
+	}
+}
+
+// Deux serveurs qui passent en même temps n'envoient chaque rappel qu'une
+// fois ; changer la date de l'événement reprogramme ses rappels
+func TestSendDue_OncePerReplica(t *testing.T) {
+	_, database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := db.EnsureIndexes(ctx, database); err != nil {
+		t.Fatalf("indexes: %v", err)
+	}
+	links := link.NewService(database.Collection("links"), database.Collection("folders"))
+	ownerID := primitive.NewObjectID().Hex()
+	event := time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)
+	l, err := links.Create(ctx, ownerID, &link.Link{Title: "Kermesse", EventDate: event.Unix(), ReminderEnabled: true})
+	if err != nil {
+		t.Fatalf("create link: %v", err)
+	}
+	if _, err := links.Create(ctx, ownerID, &link.Link{Title: "Sans rappel", EventDate: event.Unix()}); err != nil {
+		t.Fatalf("create link: %v", err)
+	}
+
+	var mu sync.Mutex
+	var got []notify.Notification
+	notifier := notify.NotifierFunc(func(_ context.Context, n notify.Notification) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, n)
+		return nil
+	})
+	now := time.Date(2026, 6, 19, 9, 0, 0, 0, DefaultConfig.Location) // la veille, après 8 h
+	replicas := make([]*Scheduler, 3)
+	for i := range replicas {
+		replicas[i] = NewScheduler(database.Collection("links"), database.Collection("reminders"), notifier, DefaultConfig)
+		replicas[i].now = func() time.Time { return now }
+	}
+	var wg sync.WaitGroup
+	for _, s := range replicas {
+		wg.Add(1)
+		go func(s *Scheduler) {
+			defer wg.Done()
+			if _, err := s.SendDue(ctx); err != nil {
+				t.Errorf("send due: %v", err)
+			}
+		}(s)
+	}
+	wg.Wait()
+	if len(got) != 1 || got[0].LinkID != l.ID.Hex() || got[0].Body != "Demain" || got[0].UserID != ownerID {
+		t.Fatalf("notifications = %+v, want one for tomorrow", got)
+	}
+
+	// Reporté de six jours : c'est maintenant le rappel « dans une semaine »
+	// qui est dû
+	l.EventDate = event.AddDate(0, 0, 6).Unix()
+	if _, err := links.Update(ctx, l.ID.Hex(), ownerID, l, []string{"event_date"}, ""); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got = nil
+	if n, err := replicas[0].SendDue(ctx); err != nil || n != 1 || got[0].Body != "Dans une semaine" {
+		t.Errorf("after reschedule = %d, %v, %+v", n, err, got)
+	}
+}
+
+// Un envoi raté est retenté à la passe suivante
+func TestSendDue_RetriesFailures(t *testing.T) {
+	_, database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := db.EnsureIndexes(ctx, database); err != nil {
+		t.Fatalf("indexes: %v", err)
+	}
+	links := link.NewService(database.Collection("links"), database.Collection("folders"))
+	event := time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)
+	if _, err := links.Create(ctx, primitive.NewObjectID().Hex(), &link.Link{Title: "Kermesse", EventDate: event.Unix(), ReminderEnabled: true}); err != nil {
+		t.Fatalf("create link: %v", err)
+	}
+
+	fail := true
+	notifier := notify.NotifierFunc(func(context.Context, notify.Notification) error {
+		if fail {
+			return errors.New("push unavailable")
+		}
+		return nil
+	})
+	s := NewScheduler(database.Collection("links"), database.Collection("reminders"), notifier, DefaultConfig)
+	s.now = func() time.Time { return time.Date(2026, 6, 20, 8, 5, 0, 0, DefaultConfig.Location) }
+	if n, _ := s.SendDue(ctx); n != 0 {
+		t.Errorf("failed pass sent %d", n)
+	}
+	fail = false
+	if n, err := s.SendDue(ctx); err != nil || n != 1 {
+		t.Errorf("retry = %d, %v; want 1", n, err)
+	}
+	if n, err := s.SendDue(ctx); err != nil || n != 0 {
+		t.Errorf("third pass = %d, %v; want 0", n, err)
+	}
+	count, _ := database.Collection("reminders").CountDocuments(ctx, bson.M{"sent_at": bson.M{"$ne": nil}})
+	if count != 1 {
+		t.Errorf("sent deliveries = %d, want 1", count)
+	}
+}
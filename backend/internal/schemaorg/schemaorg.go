@@ -0,0 +1,301 @@
+// Package schemaorg extrait les données structurées schema.org d'une page
+// HTML, qu'elles soient publiées en JSON-LD ou en microdata.
+package schemaorg
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Item est un objet schema.org sous la forme d'une map JSON-LD : "@type" et
+// les propriétés. Une propriété vaut une chaîne, un nombre, un Item ou une
+// liste de ces valeurs.
+type Item map[string]any
+
+// Types retourne les types de l'objet sans préfixe d'URL ("Recipe", "Product"…)
+func (it Item) Types() []string {
+	var types []string
+	for _, v := range flatten(it["@type"]) {
+		if s, ok := v.(string); ok {
+			types = append(types, shortType(s))
+		}
+	}
+	return types
+}
+
+// Is indique si l'objet a le type t
+func (it Item) Is(t string) bool {
+	for _, typ := range it.Types() {
+		if strings.EqualFold(typ, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Strings retourne les valeurs textuelles d'une propriété. Les objets
+// imbriqués sont ramenés à leur "text" ou leur "name".
+func (it Item) Strings(key string) []string {
+	var out []string
+	for _, v := range flatten(it[key]) {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case map[string]any:
+			s = Item(v).String("text")
+			if s == "" {
+				s = Item(v).String("name")
+			}
+		}
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// String retourne la première valeur textuelle d'une propriété
+func (it Item) String(key string) string {
+	if values := it.Strings(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Items retourne les objets imbriqués d'une propriété
+func (it Item) Items(key string) []Item {
+	var out []Item
+	for _, v := range flatten(it[key]) {
+		if m, ok := v.(map[string]any); ok {
+			out = append(out, Item(m))
+		}
+	}
+	return out
+}
+
+// Extract retourne les objets de premier niveau publiés par la page
+func Extract(doc *html.Node) []Item {
+	var items []Item
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			if n.Data == "script" && strings.EqualFold(attr(n, "type"), "application/ld+json") {
+				items = append(items, parseJSONLD(textContent(n))...)
+				return
+			}
+			if hasAttr(n, "itemscope") && attr(n, "itemtype") != "" && !hasAttr(n, "itemprop") {
+				items = append(items, microdataItem(n))
+				// Les objets imbriqués sont rattachés à leur parent
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return items
+}
+
+// Find retourne les objets du type t, y compris ceux imbriqués dans d'autres
+// (un Recipe en "mainEntity" d'une WebPage, par exemple)
+func Find(items []Item, t string) []Item {
+	var out []Item
+	var visit func(v any)
+	visit = func(v any) {
+		switch v := v.(type) {
+		case []any:
+			for _, e := range v {
+				visit(e)
+			}
+		case map[string]any:
+			if Item(v).Is(t) {
+				out = append(out, Item(v))
+				return
+			}
+			for key, e := range v {
+				if key != "@type" {
+					visit(e)
+				}
+			}
+		}
+	}
+	for _, it := range items {
+		visit(map[string]any(it))
+	}
+	return out
+}
+
+var durationRe = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseDuration lit une durée ISO 8601 ("PT1H30M", "P0DT0H20M"). Les années
+// et les mois, sans durée fixe, ne sont pas acceptés.
+func ParseDuration(s string) (time.Duration, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	m := durationRe.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" || m[1] != "" || m[2] != "" {
+		return 0, false
+	}
+	units := []time.Duration{0, 0, 0, 7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i := 3; i < len(m); i++ {
+		if m[i] == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(m[i], 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(v * float64(units[i]))
+	}
+	return d, true
+}
+
+func parseJSONLD(raw string) []Item {
+	var v any
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		// Beaucoup de sites laissent des retours à la ligne bruts dans les chaînes
+		cleaned := strings.NewReplacer("\n", " ", "\r", " ", "\t", " ").Replace(raw)
+		if err := json.Unmarshal([]byte(cleaned), &v); err != nil {
+			return nil
+		}
+	}
+	var items []Item
+	for _, e := range flatten(v) {
+		m, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		if graph, ok := m["@graph"]; ok {
+			for _, g := range flatten(graph) {
+				if gm, ok := g.(map[string]any); ok {
+					items = append(items, Item(gm))
+				}
+			}
+			continue
+		}
+		items = append(items, Item(m))
+	}
+	return items
+}
+
+// microdataItem convertit un élément itemscope en Item. Les propriétés des
+// objets imbriqués (itemscope avec itemprop) ne remontent pas au parent.
+func microdataItem(n *html.Node) Item {
+	it := Item{}
+	var types []any
+	for _, t := range strings.Fields(attr(n, "itemtype")) {
+		types = append(types, t)
+	}
+	it["@type"] = types
+
+	var walk func(*html.Node)
+	walk = func(c *html.Node) {
+		for ; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			props := strings.Fields(attr(c, "itemprop"))
+			var value any
+			if len(props) > 0 {
+				if hasAttr(c, "itemscope") {
+					value = map[string]any(microdataItem(c))
+				} else {
+					value = microdataValue(c)
+				}
+				for _, p := range props {
+					if prev, ok := it[p]; ok {
+						it[p] = append(flatten(prev), value)
+					} else {
+						it[p] = value
+					}
+				}
+			}
+			if !hasAttr(c, "itemscope") {
+				walk(c.FirstChild)
+			}
+		}
+	}
+	walk(n.FirstChild)
+	return it
+}
+
+func microdataValue(n *html.Node) string {
+	switch n.Data {
+	case "meta":
+		return attr(n, "content")
+	case "a", "link", "area":
+		return attr(n, "href")
+	case "img", "audio", "video", "source", "embed", "iframe":
+		return attr(n, "src")
+	case "time":
+		if v := attr(n, "datetime"); v != "" {
+			return v
+		}
+	case "data", "meter":
+		return attr(n, "value")
+	}
+	if v := attr(n, "content"); v != "" {
+		return v
+	}
+	return strings.Join(strings.Fields(textContent(n)), " ")
+}
+
+func flatten(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+func shortType(t string) string {
+	if i := strings.LastIndexAny(t, "/:"); i >= 0 {
+		return t[i+1:]
+	}
+	return t
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, key string) bool {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package schemaorg
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+func parse(t *testing.T, page string) []Item {
+	t.Helper()
+	doc, err := html.Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	return Extract(doc)
+}
+
+func TestExtract_JSONLDGraph(t *testing.T) {
+	items := parse(t, `<html><head>
+<script type="application/ld+json">{"@context":"https://schema.org","@graph":[
+  {"@type":"WebPage","name":"Blog","mainEntity":{"@type":["Recipe","NewsArticle"],"name":"Crêpes"}},
+  {"@type":"Organization","name":"Blog"}
+]}</script>
+<script type="application/ld+json">{"@type":"BreadcrumbList"}</script>
+</head></html>`)
+	if len(items) != 3 {
+		t.Fatalf("got %d items, want 3", len(items))
+	}
+	recipes := Find(items, "Recipe")
+	if len(recipes) != 1 || recipes[0].String("name") != "Crêpes" {
+		t.Errorf("nested recipe not found: %v", recipes)
+	}
+}
+
+func TestExtract_JSONLDRawNewlines(t *testing.T) {
+	items := parse(t, "<script type=\"application/ld+json\">{\"@type\":\"Recipe\",\"name\":\"Tarte\n aux pommes\"}</script>")
+	if len(Find(items, "Recipe")) != 1 {
+		t.Error("JSON-LD with raw newlines in strings should still be read")
+	}
+}
+
+func TestExtract_Microdata(t *testing.T) {
+	items := parse(t, `<div itemscope itemtype="http://schema.org/Recipe">
+  <h1 itemprop="name">Quiche lorraine</h1>
+  <meta itemprop="prepTime" content="PT20M">
+  <ul>
+    <li itemprop="recipeIngredient">200 g de lardons</li>
+    <li itemprop="recipeIngredient">3 <b>œufs</b></li>
+  </ul>
+  <div itemprop="nutrition" itemscope itemtype="http://schema.org/NutritionInformation">
+    <span itemprop="calories">420 kcal</span>
+    <span itemprop="name">ne remonte pas</span>
+  </div>
+</div>`)
+	recipes := Find(items, "Recipe")
+	if len(recipes) != 1 {
+		t.Fatalf("got %d recipes, want 1", len(recipes))
+	}
+	r := recipes[0]
+	if r.String("name") != "Quiche lorraine" || r.String("prepTime") != "PT20M" {
+		t.Errorf("unexpected properties: %v", r)
+	}
+	if got := r.Strings("recipeIngredient"); len(got) != 2 || got[1] != "3 œufs" {
+		t.Errorf("ingredients = %q", got)
+	}
+	nutrition := r.Items("nutrition")
+	if len(nutrition) != 1 || nutrition[0].String("calories") != "420 kcal" || !nutrition[0].Is("NutritionInformation") {
+		t.Errorf("nutrition = %v", nutrition)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT20M":     20 * time.Minute,
+		"PT1H30M":   90 * time.Minute,
+		"P0DT0H45M": 45 * time.Minute,
+		"pt1.5h":    90 * time.Minute,
+		"P1D":       24 * time.Hour,
+	}
+	for in, want := range cases {
+		if got, ok := ParseDuration(in); !ok || got != want {
+			t.Errorf("ParseDuration(%q) = %v, %v; want %v", in, got, ok, want)
+		}
+	}
+	for _, in := range []string{"", "P", "PT", "20 min", "P1M", "PT20"} {
+		if _, ok := ParseDuration(in); ok {
+			t.Errorf("ParseDuration(%q) should fail", in)
+		}
+	}
+}
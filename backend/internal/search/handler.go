@@ -0,0 +1,88 @@
+package search
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Handler struct {
+	pb.UnimplementedSearchServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+func (h *Handler) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	q := Query{
+		Text:      req.Query,
+		Tag:       req.Tag,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	}
+	if req.Category != pb.LinkCategory_LINK_CATEGORY_UNSPECIFIED {
+		q.Category = req.Category.String()
+	}
+
+	res, err := h.svc.Search(ctx, userID, q)
+	switch {
+	case errors.Is(err, ErrEmptyQuery):
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	case errors.Is(err, ErrInvalidPageToken):
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to search: %v", err)
+	}
+
+	resp := &pb.SearchResponse{
+		Results:       make([]*pb.SearchResult, 0, len(res.Hits)),
+		Categories:    facetsToProto(res.Categories),
+		Tags:          facetsToProto(res.Tags),
+		NextPageToken: res.NextPageToken,
+	}
+	for _, hit := range res.Hits {
+		r := &pb.SearchResult{
+			Id:       hit.ID.Hex(),
+			Title:    hit.Title,
+			FolderId: hit.FolderID,
+			Category: pb.LinkCategory(pb.LinkCategory_value[hit.Category]),
+			ImageUrl: hit.ImageURL,
+			Score:    hit.Score,
+		}
+		if hit.Type == TypeFolder {
+			r.Type = pb.SearchResultType_SEARCH_RESULT_TYPE_FOLDER
+		} else {
+			r.Type = pb.SearchResultType_SEARCH_RESULT_TYPE_LINK
+		}
+		for _, s := range hit.Snippets {
+			snippet := &pb.Snippet{Field: s.Field, Text: s.Text}
+			for _, hl := range s.Highlights {
+				snippet.Highlights = append(snippet.Highlights, &pb.HighlightRange{Start: int32(hl.Start), End: int32(hl.End)})
+			}
+			r.Snippets = append(r.Snippets, snippet)
+		}
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
+
+func facetsToProto(facets []Facet) []*pb.FacetCount {
+	out := make([]*pb.FacetCount, 0, len(facets))
+	for _, f := range facets {
+		if f.Value == "" {
+			continue
+		}
+		out = append(out, &pb.FacetCount{Value: f.Value, Count: f.Count})
+	}
+	return out
+}
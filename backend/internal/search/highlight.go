@@ -0,0 +1,118 @@
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// snippetLength est la longueur maximale d'un extrait, en runes
+const snippetLength = 160
+
+// Mots ignorés par l'index texte français, à ne pas surligner non plus
+var stopWords = map[string]bool{
+	"a": true, "au": true, "aux": true, "avec": true, "ce": true, "de": true,
+	"des": true, "du": true, "en": true, "et": true, "la": true, "le": true,
+	"les": true, "l": true, "d": true, "ou": true, "par": true, "pour": true,
+	"sur": true, "un": true, "une": true,
+}
+
+type Range struct {
+	Start int
+	End   int
+}
+
+type Snippet struct {
+	Field      string
+	Text       string
+	Highlights []Range
+}
+
+// queryTerms retourne les racines des mots d'une recherche
+func queryTerms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, tok := range textutil.Tokenize(query) {
+		if stopWords[tok.Text] {
+			continue
+		}
+		stem := textutil.Stem(tok.Text)
+		if !seen[stem] {
+			seen[stem] = true
+			terms = append(terms, stem)
+		}
+	}
+	return terms
+}
+
+// highlight repère dans text les mots dont la racine commence par un des termes
+func highlight(text string, terms []string) []Range {
+	var ranges []Range
+	for _, tok := range textutil.Tokenize(text) {
+		stem := textutil.Stem(tok.Text)
+		for _, term := range terms {
+			if strings.HasPrefix(stem, term) || strings.HasPrefix(tok.Text, term) {
+				ranges = append(ranges, Range{Start: tok.Start, End: tok.End})
+				break
+			}
+		}
+	}
+	return ranges
+}
+
+// makeSnippet construit l'extrait d'un champ, ou nil s'il ne contient aucun terme.
+// Les textes longs sont coupés autour du premier mot surligné.
+func makeSnippet(field, text string, terms []string) *Snippet {
+	ranges := highlight(text, terms)
+	if len(ranges) == 0 {
+		return nil
+	}
+	if utf8.RuneCountInString(text) <= snippetLength {
+		return &Snippet{Field: field, Text: text, Highlights: ranges}
+	}
+
+	runes := []rune(text)
+	start := ranges[0].Start - snippetLength/4
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLength
+	if end > len(runes) {
+		end = len(runes)
+		start = end - snippetLength
+	}
+
+	var b strings.Builder
+	offset := -start
+	if start > 0 {
+		b.WriteRune('…')
+		offset++
+	}
+	b.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		b.WriteRune('…')
+	}
+
+	var kept []Range
+	for _, r := range ranges {
+		if r.Start >= start && r.End <= end {
+			kept = append(kept, Range{Start: r.Start + offset, End: r.End + offset})
+		}
+	}
+	return &Snippet{Field: field, Text: b.String(), Highlights: kept}
+}
+
+// snippets retourne les extraits des champs qui contiennent un terme recherché
+func snippets(terms []string, fields ...[2]string) []Snippet {
+	var out []Snippet
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if s := makeSnippet(f[0], f[1], terms); s != nil {
+			out = append(out, *s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package search
+
+import "testing"
+
+func TestQueryTerms_SkipsStopWordsAndStems(t *testing.T) {
+	got := queryTerms("Gâteaux au chocolat pour les enfants")
+	want := []string{"gateau", "chocolat", "enfant"}
+	if len(got) != len(want) {
+		t.Fatalf("queryTerms = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("term %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMakeSnippet_HighlightsAccentInsensitive(t *testing.T) {
+	s := makeSnippet("title", "Crème brûlée et crèmes glacées", queryTerms("creme"))
+	if s == nil {
+		t.Fatal("expected a snippet")
+	}
+	want := []Range{{Start: 0, End: 5}, {Start: 16, End: 22}}
+	if len(s.Highlights) != len(want) {
+		t.Fatalf("highlights = %v, want %v", s.Highlights, want)
+	}
+	for i := range want {
+		if s.Highlights[i] != want[i] {
+			t.Errorf("highlight %d = %v, want %v", i, s.Highlights[i], want[i])
+		}
+	}
+}
+
+func TestMakeSnippet_NoMatch(t *testing.T) {
+	if s := makeSnippet("title", "Balade en forêt", queryTerms("piscine")); s != nil {
+		t.Fatalf("expected no snippet, got %+v", s)
+	}
+}
+
+func TestMakeSnippet_LongTextIsCutAroundMatch(t *testing.T) {
+	long := ""
+	for i := 0; i < 40; i++ {
+		long += "texte "
+	}
+	long += "piscine municipale"
+	for i := 0; i < 40; i++ {
+		long += " suite"
+	}
+
+	s := makeSnippet("description", long, queryTerms("piscine"))
+	if s == nil || len(s.Highlights) != 1 {
+		t.Fatalf("expected one highlight, got %+v", s)
+	}
+	runes := []rune(s.Text)
+	if len(runes) > snippetLength+2 {
+		t.Errorf("snippet too long: %d runes", len(runes))
+	}
+	h := s.Highlights[0]
+	if got := string(runes[h.Start:h.End]); got != "piscine" {
+		t.Errorf("highlighted %q, want %q", got, "piscine")
+	}
+}
@@ -0,0 +1,285 @@
+// Package search implémente la recherche personnelle dans les dossiers et les
+// liens accessibles à un utilisateur (les siens et ceux partagés avec lui).
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/tribbae/backend/internal/pagetoken"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 50
+	maxTagFacets    = 20
+)
+
+var (
+	ErrEmptyQuery       = errors.New("query is required")
+	ErrInvalidPageToken = pagetoken.ErrInvalid
+)
+
+// FolderAccess donne les dossiers qu'un utilisateur peut consulter.
+// Implémenté par link.Service.
+type FolderAccess interface {
+	AccessibleFolderIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+type Query struct {
+	Text      string
+	Category  string // ex. "LINK_CATEGORY_RECETTE", restreint aux liens
+	Tag       string
+	PageSize  int32
+	PageToken string
+}
+
+const (
+	TypeFolder = "folder"
+	TypeLink   = "link"
+)
+
+type Hit struct {
+	Type     string
+	ID       primitive.ObjectID
+	Title    string
+	FolderID string
+	Category string
+	ImageURL string
+	Score    float64
+	Snippets []Snippet
+}
+
+type Facet struct {
+	Value string `bson:"_id"`
+	Count int32  `bson:"count"`
+}
+
+type Result struct {
+	Hits          []Hit
+	Categories    []Facet
+	Tags          []Facet
+	NextPageToken string
+}
+
+type Service struct {
+	linkCol   *mongo.Collection
+	folderCol *mongo.Collection
+	access    FolderAccess
+}
+
+func NewService(linkCol, folderCol *mongo.Collection, access FolderAccess) *Service {
+	return &Service{linkCol: linkCol, folderCol: folderCol, access: access}
+}
+
+type linkDoc struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	FolderID    string             `bson:"folder_id"`
+	Title       string             `bson:"title"`
+	Description string             `bson:"description"`
+	Category    string             `bson:"category"`
+	Tags        []string           `bson:"tags"`
+	Ingredients []string           `bson:"ingredients"`
+	Location    string             `bson:"location"`
+	ImageURL    string             `bson:"image_url"`
+	Score       float64            `bson:"score"`
+}
+
+type folderDoc struct {
+	ID    primitive.ObjectID `bson:"_id"`
+	Name  string             `bson:"name"`
+	Tags  []string           `bson:"tags"`
+	Score float64            `bson:"score"`
+}
+
+// Search cherche dans les noms de dossiers et dans les titres, descriptions,
+// tags, ingrédients et lieux des liens. Les deux sources sont fusionnées par
+// pertinence ; les facettes comptent les liens trouvés avant les filtres
+// category et tag pour permettre d'affiner la recherche.
+func (s *Service) Search(ctx context.Context, userID string, q Query) (*Result, error) {
+	text := strings.TrimSpace(q.Text)
+	if text == "" {
+		return nil, ErrEmptyQuery
+	}
+	pageSize := q.PageSize
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+	var cursor *pagetoken.Cursor
+	if q.PageToken != "" {
+		c, err := pagetoken.Decode(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+
+	folderIDs, err := s.access.AccessibleFolderIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkScope := bson.M{"$text": bson.M{"$search": text}, "deleted_at": nil}
+	if len(folderIDs) > 0 {
+		linkScope["$or"] = bson.A{
+			bson.M{"owner_id": userID},
+			bson.M{"folder_id": bson.M{"$in": folderIDs}},
+		}
+	} else {
+		linkScope["owner_id"] = userID
+	}
+
+	linkMatch := bson.M{}
+	for k, v := range linkScope {
+		linkMatch[k] = v
+	}
+	if q.Category != "" {
+		linkMatch["category"] = q.Category
+	}
+	if q.Tag != "" {
+		linkMatch["tags"] = q.Tag
+	}
+
+	var links []linkDoc
+	if err := s.find(ctx, s.linkCol, linkMatch, cursor, pageSize+1, &links); err != nil {
+		return nil, err
+	}
+
+	var folders []folderDoc
+	if q.Category == "" && len(folderIDs) > 0 {
+		oids := make([]primitive.ObjectID, 0, len(folderIDs))
+		for _, id := range folderIDs {
+			if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+				oids = append(oids, oid)
+			}
+		}
+		folderMatch := bson.M{
+			"$text":      bson.M{"$search": text},
+			"_id":        bson.M{"$in": oids},
+			"deleted_at": nil,
+		}
+		if q.Tag != "" {
+			folderMatch["tags"] = q.Tag
+		}
+		if err := s.find(ctx, s.folderCol, folderMatch, cursor, pageSize+1, &folders); err != nil {
+			return nil, err
+		}
+	}
+
+	terms := queryTerms(text)
+	hits := make([]Hit, 0, len(links)+len(folders))
+	for _, l := range links {
+		hits = append(hits, Hit{
+			Type:     TypeLink,
+			ID:       l.ID,
+			Title:    l.Title,
+			FolderID: l.FolderID,
+			Category: l.Category,
+			ImageURL: l.ImageURL,
+			Score:    l.Score,
+			Snippets: snippets(terms,
+				[2]string{"title", l.Title},
+				[2]string{"description", l.Description},
+				[2]string{"tags", strings.Join(l.Tags, ", ")},
+				[2]string{"ingredients", strings.Join(l.Ingredients, ", ")},
+				[2]string{"location", l.Location},
+			),
+		})
+	}
+	for _, f := range folders {
+		hits = append(hits, Hit{
+			Type:  TypeFolder,
+			ID:    f.ID,
+			Title: f.Name,
+			Score: f.Score,
+			Snippets: snippets(terms,
+				[2]string{"name", f.Name},
+				[2]string{"tags", strings.Join(f.Tags, ", ")},
+			),
+		})
+	}
+	// Même ordre que le tri Mongo de chaque source, pour que le curseur reste valable
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID.Hex() < hits[j].ID.Hex()
+	})
+
+	result := &Result{Hits: hits}
+	if len(hits) > int(pageSize) {
+		result.Hits = hits[:pageSize]
+		last := result.Hits[len(result.Hits)-1]
+		if result.NextPageToken, err = pagetoken.Encode(last.Score, last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.Categories, result.Tags, err = s.facets(ctx, linkScope); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// find exécute une recherche texte triée par score puis _id, après le curseur
+func (s *Service) find(ctx context.Context, col *mongo.Collection, match bson.M, cursor *pagetoken.Cursor, limit int32, out any) error {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+	}
+	if cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: pagetoken.After("score", true, cursor)}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: int64(limit)}},
+	)
+	cur, err := col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+	return cur.All(ctx, out)
+}
+
+// facets compte les liens trouvés par catégorie et par tag (les plus fréquents)
+func (s *Service) facets(ctx context.Context, scope bson.M) ([]Facet, []Facet, error) {
+	byCount := bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: scope}},
+		bson.D{{Key: "$facet", Value: bson.M{
+			"categories": bson.A{
+				bson.M{"$group": bson.M{"_id": "$category", "count": bson.M{"$sum": 1}}},
+				bson.M{"$sort": byCount},
+			},
+			"tags": bson.A{
+				bson.M{"$unwind": "$tags"},
+				bson.M{"$group": bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}},
+				bson.M{"$sort": byCount},
+				bson.M{"$limit": maxTagFacets},
+			},
+		}}},
+	}
+	cur, err := s.linkCol.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cur.Close(ctx)
+
+	var out []struct {
+		Categories []Facet `bson:"categories"`
+		Tags       []Facet `bson:"tags"`
+	}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, nil, err
+	}
+	if len(out) == 0 {
+		return nil, nil, nil
+	}
+	return out[0].Categories, out[0].Tags, nil
+}
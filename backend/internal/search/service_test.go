@@ -0,0 +1,113 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/db"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+type staticAccess []string
+
+func (a staticAccess) AccessibleFolderIDs(context.Context, string) ([]string, error) {
+	return a, nil
+}
+
+// La recherche ne renvoie que le contenu accessible, avec extraits et facettes
+func TestSearch_ScopeSnippetsAndFacets(t *testing.T) {
+	_, database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := db.EnsureIndexes(ctx, database); err != nil {
+		t.Fatalf("ensure indexes: %v", err)
+	}
+	links := database.Collection("links")
+	folders := database.Collection("folders")
+
+	userID := primitive.NewObjectID().Hex()
+	sharedFolder := primitive.NewObjectID()
+	if _, err := folders.InsertOne(ctx, bson.M{"_id": sharedFolder, "owner_id": "someone", "name": "Gâteaux d'anniversaire"}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	docs := []any{
+		bson.M{"owner_id": userID, "title": "Gâteau au chocolat", "category": "LINK_CATEGORY_RECETTE", "tags": []string{"dessert"}},
+		bson.M{"owner_id": "someone", "folder_id": sharedFolder.Hex(), "title": "Moule à gâteau", "category": "LINK_CATEGORY_CADEAU", "tags": []string{"cuisine"}},
+		bson.M{"owner_id": "stranger", "title": "Gâteau basque", "category": "LINK_CATEGORY_RECETTE"},
+		bson.M{"owner_id": userID, "title": "Gâteau supprimé", "category": "LINK_CATEGORY_RECETTE", "deleted_at": time.Now()},
+	}
+	if _, err := links.InsertMany(ctx, docs); err != nil {
+		t.Fatalf("insert links: %v", err)
+	}
+
+	svc := NewService(links, folders, staticAccess{sharedFolder.Hex()})
+	res, err := svc.Search(ctx, userID, Query{Text: "gateaux", PageSize: 2})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(res.Hits) != 2 || res.NextPageToken == "" {
+		t.Fatalf("expected a full first page and a token, got %d hits", len(res.Hits))
+	}
+	for _, h := range res.Hits {
+		if len(h.Snippets) == 0 {
+			t.Errorf("hit %q has no snippet", h.Title)
+		}
+	}
+	next, err := svc.Search(ctx, userID, Query{Text: "gateaux", PageSize: 2, PageToken: res.NextPageToken})
+	if err != nil {
+		t.Fatalf("search page 2: %v", err)
+	}
+	if len(next.Hits) != 1 || next.NextPageToken != "" {
+		t.Fatalf("expected the last hit on page 2, got %d hits", len(next.Hits))
+	}
+
+	counts := map[string]int32{}
+	for _, f := range res.Categories {
+		counts[f.Value] = f.Count
+	}
+	if counts["LINK_CATEGORY_RECETTE"] != 1 || counts["LINK_CATEGORY_CADEAU"] != 1 {
+		t.Errorf("unexpected category facets: %v", res.Categories)
+	}
+
+	filtered, err := svc.Search(ctx, userID, Query{Text: "gateau", Category: "LINK_CATEGORY_CADEAU"})
+	if err != nil {
+		t.Fatalf("filtered search: %v", err)
+	}
+	if len(filtered.Hits) != 1 || filtered.Hits[0].Title != "Moule à gâteau" {
+		t.Fatalf("category filter should keep only the gift, got %+v", filtered.Hits)
+	}
+}
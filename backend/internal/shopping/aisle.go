@@ -0,0 +1,129 @@
+package shopping
+
+import (
+	"strings"
+
+	"github.com/tribbae/backend/internal/textutil"
+)
+
+// Rayons, dans l'ordre d'un parcours de magasin
+const (
+	AisleProduce = "Fruits et légumes"
+	AisleButcher = "Boucherie"
+	AisleFish    = "Poissonnerie"
+	AisleDairy   = "Crèmerie"
+	AisleBakery  = "Boulangerie"
+	AisleSavory  = "Épicerie salée"
+	AisleSweet   = "Épicerie sucrée"
+	AisleSpices  = "Épices et condiments"
+	AisleFrozen  = "Surgelés"
+	AisleDrinks  = "Boissons"
+	AisleOther   = "Autres"
+)
+
+// Aisles est l'ordre d'affichage des rayons
+var Aisles = []string{
+	AisleProduce, AisleButcher, AisleFish, AisleDairy, AisleBakery,
+	AisleSavory, AisleSweet, AisleSpices, AisleFrozen, AisleDrinks, AisleOther,
+}
+
+var aisleKeywords = map[string][]string{
+	AisleProduce: {
+		"pomme", "poire", "banane", "citron", "orange", "clementine", "pamplemousse", "fraise",
+		"framboise", "myrtille", "cerise", "abricot", "peche", "prune", "raisin", "kiwi",
+		"mangue", "ananas", "avocat", "tomate", "carotte", "oignon", "ail", "echalote",
+		"poireau", "courgette", "aubergine", "poivron", "salade", "laitue", "roquette",
+		"epinard", "champignon", "concombre", "chou", "brocoli", "navet", "celeri", "radis",
+		"potiron", "courge", "butternut", "patate", "betterave", "fenouil", "asperge",
+		"artichaut", "persil", "ciboulette", "basilic", "coriandre", "menthe", "thym",
+		"laurier", "romarin", "estragon", "aneth", "gingembre", "lime",
+	},
+	AisleButcher: {
+		"poulet", "boeuf", "veau", "porc", "agneau", "dinde", "canard", "lapin", "lardon",
+		"jambon", "saucisse", "saucisson", "viande", "steak", "escalope", "chorizo", "bacon",
+		"merguez", "roti", "filet mignon",
+	},
+	AisleFish: {
+		"saumon", "thon", "cabillaud", "crevette", "moule", "poisson", "truite", "sardine",
+		"colin", "lieu", "maquereau", "crabe", "calamar", "encornet", "dorade", "bar",
+		"noix de saint jacques", "saint jacques",
+	},
+	AisleDairy: {
+		"lait", "beurre", "creme", "oeuf", "fromage", "yaourt", "gruyere", "parmesan",
+		"mozzarella", "emmental", "comte", "chevre", "feta", "mascarpone", "ricotta",
+		"reblochon", "camembert", "roquefort", "fromage blanc", "petit suisse",
+	},
+	AisleBakery: {"pain", "baguette", "brioche", "pain de mie", "tortilla", "pate feuilletee", "pate brisee", "pate sablee"},
+	AisleSavory: {
+		"pate", "spaghetti", "tagliatelle", "penne", "riz", "lentille", "pois chiche",
+		"semoule", "quinoa", "boulgour", "huile", "vinaigre", "moutarde", "sauce", "bouillon",
+		"concentre", "coulis", "olive", "cornichon", "capre", "haricot", "mais", "chapelure",
+	},
+	AisleSweet: {
+		"sucre", "farine", "chocolat", "levure", "miel", "confiture", "vanille", "cacao",
+		"amande", "noisette", "noix", "biscuit", "maizena", "fecule", "gelatine", "raisin sec",
+		"pepite", "sirop", "caramel", "flocon d'avoine", "cereale",
+	},
+	AisleSpices: {
+		"sel", "poivre", "cumin", "paprika", "curry", "cannelle", "muscade", "piment",
+		"herbe de provence", "curcuma", "safran", "clou de girofle", "origan", "ketchup",
+		"mayonnaise", "sauce soja",
+	},
+	AisleFrozen: {"surgele", "glace", "sorbet"},
+	AisleDrinks: {"eau", "vin", "biere", "jus", "cidre", "soda", "cafe", "the", "rhum", "porto", "cognac"},
+}
+
+// keywordAisles associe la clé (nameKey) de chaque mot-clé à son rayon
+var keywordAisles = map[string]string{}
+
+func init() {
+	for aisle, keywords := range aisleKeywords {
+		for _, k := range keywords {
+			keywordAisles[nameKey(k)] = aisle
+		}
+	}
+}
+
+// AisleOf devine le rayon d'un ingrédient. Les expressions de plusieurs mots
+// ("pois chiche", "sauce soja") l'emportent sur les mots seuls, et parmi les
+// mots seuls le premier, qui est le plus souvent le nom principal
+// ("jus de citron" est une boisson, "huile d'olive" de l'épicerie).
+func AisleOf(name string) string {
+	words := strings.Fields(nameKey(name))
+	for size := len(words); size >= 1; size-- {
+		for start := 0; start+size <= len(words); start++ {
+			if aisle, ok := keywordAisles[strings.Join(words[start:start+size], " ")]; ok {
+				return aisle
+			}
+		}
+	}
+	return AisleOther
+}
+
+// stopWords sont ignorés pour comparer les noms d'ingrédients
+var stopWords = map[string]bool{
+	"de": true, "d": true, "du": true, "des": true, "la": true, "le": true, "l": true,
+	"les": true, "a": true, "au": true, "aux": true, "en": true, "et": true,
+}
+
+// nameKey réduit un nom d'ingrédient à une forme comparable : "Œufs frais"
+// et "oeuf frais" donnent la même clé
+func nameKey(name string) string {
+	var words []string
+	for _, t := range textutil.Tokenize(name) {
+		if !stopWords[t.Text] {
+			words = append(words, textutil.Stem(t.Text))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// aisleRank retourne la position d'un rayon dans Aisles
+func aisleRank(aisle string) int {
+	for i, a := range Aisles {
+		if a == aisle {
+			return i
+		}
+	}
+	return len(Aisles)
+}
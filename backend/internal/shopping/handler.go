@@ -0,0 +1,141 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/interceptor"
+	"github.com/tribbae/backend/internal/link"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	pb.UnimplementedShoppingListServiceServer
+	svc *Service
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// serviceError traduit les erreurs du service en statuts gRPC
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, ErrNoSources), errors.Is(err, ErrTooManyLinks):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrListNotFound), errors.Is(err, ErrItemNotFound), errors.Is(err, link.ErrLinkNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, ErrNotAuthorized), errors.Is(err, ErrInvalidFolder):
+		return status.Errorf(codes.PermissionDenied, "failed to %s: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+// ToProto convertit une liste de courses, ses articles regroupés par rayon
+func ToProto(l *List) *pb.ShoppingList {
+	out := &pb.ShoppingList{
+		Id:        l.ID.Hex(),
+		OwnerId:   l.OwnerID,
+		FolderId:  l.FolderID,
+		Title:     l.Title,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		UpdatedAt: timestamppb.New(l.UpdatedAt),
+	}
+	for _, src := range l.Sources {
+		out.Sources = append(out.Sources, &pb.ShoppingListSource{LinkId: src.LinkID, Servings: src.Servings})
+	}
+	// Les articles sont déjà triés par rayon
+	for _, it := range l.Items {
+		if n := len(out.Aisles); n == 0 || out.Aisles[n-1].Name != it.Aisle {
+			out.Aisles = append(out.Aisles, &pb.ShoppingAisle{Name: it.Aisle})
+		}
+		item := &pb.ShoppingItem{
+			Id:        it.ID,
+			Name:      it.Name,
+			Quantity:  it.Quantity,
+			Unit:      it.Unit,
+			Text:      it.Text(),
+			Aisle:     it.Aisle,
+			LinkIds:   it.LinkIDs,
+			Checked:   it.Checked,
+			CheckedBy: it.CheckedBy,
+		}
+		if it.CheckedAt != nil {
+			item.CheckedAt = timestamppb.New(*it.CheckedAt)
+		}
+		aisle := out.Aisles[len(out.Aisles)-1]
+		aisle.Items = append(aisle.Items, item)
+	}
+	return out
+}
+
+func (h *Handler) CreateShoppingList(ctx context.Context, req *pb.CreateShoppingListRequest) (*pb.CreateShoppingListResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	sources := make([]Source, 0, len(req.Sources))
+	for _, src := range req.Sources {
+		sources = append(sources, Source{LinkID: src.LinkId, Servings: src.Servings})
+	}
+	l, err := h.svc.Create(ctx, userID, req.Title, req.FolderId, sources)
+	if err != nil {
+		return nil, serviceError(err, "create shopping list")
+	}
+	return &pb.CreateShoppingListResponse{List: ToProto(l)}, nil
+}
+
+func (h *Handler) GetShoppingList(ctx context.Context, req *pb.GetShoppingListRequest) (*pb.GetShoppingListResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	l, err := h.svc.Get(ctx, req.ListId, userID)
+	if err != nil {
+		return nil, serviceError(err, "get shopping list")
+	}
+	return &pb.GetShoppingListResponse{List: ToProto(l)}, nil
+}
+
+func (h *Handler) ListShoppingLists(ctx context.Context, req *pb.ListShoppingListsRequest) (*pb.ListShoppingListsResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	lists, err := h.svc.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err, "list shopping lists")
+	}
+	resp := &pb.ListShoppingListsResponse{Lists: make([]*pb.ShoppingList, 0, len(lists))}
+	for _, l := range lists {
+		resp.Lists = append(resp.Lists, ToProto(l))
+	}
+	return resp, nil
+}
+
+func (h *Handler) CheckShoppingItem(ctx context.Context, req *pb.CheckShoppingItemRequest) (*pb.CheckShoppingItemResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	l, err := h.svc.CheckItem(ctx, req.ListId, req.ItemId, userID, req.Checked)
+	if err != nil {
+		return nil, serviceError(err, "check shopping item")
+	}
+	return &pb.CheckShoppingItemResponse{List: ToProto(l)}, nil
+}
+
+func (h *Handler) DeleteShoppingList(ctx context.Context, req *pb.DeleteShoppingListRequest) (*pb.DeleteShoppingListResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.Delete(ctx, req.ListId, userID); err != nil {
+		return nil, serviceError(err, "delete shopping list")
+	}
+	return &pb.DeleteShoppingListResponse{}, nil
+}
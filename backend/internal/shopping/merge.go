@@ -0,0 +1,106 @@
+package shopping
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/tribbae/backend/internal/recipe"
+)
+
+// Item est une ligne de la liste de courses, issue d'un ou plusieurs ingrédients
+type Item struct {
+	ID        string     `bson:"id"                   json:"id"`
+	Name      string     `bson:"name"                 json:"name"`
+	Quantity  float64    `bson:"quantity,omitempty"   json:"quantity,omitempty"`
+	Unit      string     `bson:"unit,omitempty"       json:"unit,omitempty"`
+	Aisle     string     `bson:"aisle"                json:"aisle"`
+	LinkIDs   []string   `bson:"link_ids"             json:"link_ids"` // recettes qui en ont besoin
+	Checked   bool       `bson:"checked"              json:"checked"`
+	CheckedBy string     `bson:"checked_by,omitempty" json:"checked_by,omitempty"`
+	CheckedAt *time.Time `bson:"checked_at,omitempty" json:"checked_at,omitempty"`
+}
+
+// Text écrit la ligne avec sa quantité : "1,2 kg de farine"
+func (it Item) Text() string {
+	return recipe.Ingredient{Quantity: it.Quantity, Unit: it.Unit, Item: it.Name}.String()
+}
+
+// sourced est un ingrédient déjà adapté au nombre de parts, avec sa recette
+type sourced struct {
+	LinkID     string
+	Ingredient recipe.Ingredient
+}
+
+// Merge regroupe les ingrédients identiques et additionne leurs quantités.
+// Deux ingrédients sont identiques si leurs noms se ressemblent (accents,
+// pluriels) et que leurs unités s'additionnent : "200 g de farine" et
+// "1 kg de farine" font "1,2 kg de farine", mais "2 c. à soupe de sucre" et
+// "100 g de sucre" restent deux lignes. Pour une fourchette ("2 à 3 pommes"),
+// la borne haute est retenue.
+func Merge(ingredients []sourced) []Item {
+	var items []Item
+	index := map[string]int{}
+	for _, s := range ingredients {
+		ing := s.Ingredient
+		quantity := ing.Quantity
+		if ing.QuantityMax > 0 {
+			quantity = ing.QuantityMax
+		}
+		key := nameKey(ing.Item) + "|" + unitGroup(ing)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(items)
+			items = append(items, Item{
+				ID:       itemID(key),
+				Name:     ing.Item,
+				Quantity: quantity,
+				Unit:     ing.Unit,
+				Aisle:    AisleOf(ing.Item),
+				LinkIDs:  []string{s.LinkID},
+			})
+			continue
+		}
+		it := &items[i]
+		if it.Unit != ing.Unit {
+			// Même dimension, unités différentes : on additionne en g ou en ml
+			it.Quantity, it.Unit = recipe.ToBase(it.Quantity, it.Unit)
+			quantity, _ = recipe.ToBase(quantity, ing.Unit)
+		}
+		it.Quantity += quantity
+		if !slices.Contains(it.LinkIDs, s.LinkID) {
+			it.LinkIDs = append(it.LinkIDs, s.LinkID)
+		}
+	}
+
+	for i := range items {
+		if items[i].Quantity > 0 {
+			// "1200 g" devient "1,2 kg"
+			n := recipe.Ingredient{Quantity: items[i].Quantity, Unit: items[i].Unit}.Scale(1)
+			items[i].Quantity, items[i].Unit = n.Quantity, n.Unit
+		}
+	}
+	sort.SliceStable(items, func(a, b int) bool {
+		return aisleRank(items[a].Aisle) < aisleRank(items[b].Aisle)
+	})
+	return items
+}
+
+// unitGroup retourne ce qui doit être commun pour additionner deux quantités :
+// la dimension (masse, volume) ou l'unité de compte elle-même
+func unitGroup(ing recipe.Ingredient) string {
+	if ing.Quantity == 0 {
+		return "-"
+	}
+	if dim := recipe.Dimension(ing.Unit); dim != "" {
+		return dim
+	}
+	return ing.Unit
+}
+
+func itemID(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,84 @@
+package shopping
+
+import (
+	"testing"
+
+	"github.com/tribbae/backend/internal/recipe"
+)
+
+func ingredients(linkID string, lines ...string) []sourced {
+	var out []sourced
+	for _, l := range lines {
+		out = append(out, sourced{LinkID: linkID, Ingredient: recipe.ParseIngredient(l)})
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	in := append(ingredients("crepes", "250 g de farine", "4 œufs", "50 cl de lait", "sel"),
+		ingredients("gateau", "1 kg de farine", "3 oeufs", "2 c. à soupe de sucre", "100 g de sucre", "sel")...)
+	items := Merge(in)
+
+	byText := map[string]Item{}
+	for _, it := range items {
+		byText[it.Text()] = it
+	}
+	for _, want := range []string{"1,25 kg de farine", "7 œufs", "50 cl de lait", "2 c. à soupe de sucre", "100 g de sucre", "sel"} {
+		if _, ok := byText[want]; !ok {
+			t.Errorf("missing %q in %v", want, texts(items))
+		}
+	}
+	if len(items) != 6 {
+		t.Errorf("got %d items, want 6: %v", len(items), texts(items))
+	}
+	if it := byText["7 œufs"]; len(it.LinkIDs) != 2 || it.Aisle != AisleDairy {
+		t.Errorf("eggs = %+v", it)
+	}
+
+	// Les rayons suivent l'ordre de Aisles
+	for i := 1; i < len(items); i++ {
+		if aisleRank(items[i-1].Aisle) > aisleRank(items[i].Aisle) {
+			t.Errorf("items not sorted by aisle: %v", texts(items))
+		}
+	}
+}
+
+func TestMerge_MixedVolumes(t *testing.T) {
+	items := Merge(ingredients("a", "1 c. à soupe d'huile", "1 c. à café d'huile", "2 gousses d'ail", "1 gousse d'ail"))
+	if len(items) != 2 {
+		t.Fatalf("got %v", texts(items))
+	}
+	if got := items[0].Text(); got != "3 gousses d'ail" {
+		t.Errorf("garlic = %q", got)
+	}
+	if got := items[1].Text(); got != "20 ml d'huile" {
+		t.Errorf("oil = %q", got)
+	}
+}
+
+func TestAisleOf(t *testing.T) {
+	cases := map[string]string{
+		"pommes de terre":  AisleProduce,
+		"Huile d'olive":    AisleSavory,
+		"pois chiches":     AisleSavory,
+		"sauce soja":       AisleSpices,
+		"blancs de poulet": AisleButcher,
+		"pâte feuilletée":  AisleBakery,
+		"pâtes":            AisleSavory,
+		"Crème fraîche":    AisleDairy,
+		"papier cuisson":   AisleOther,
+	}
+	for name, want := range cases {
+		if got := AisleOf(name); got != want {
+			t.Errorf("AisleOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func texts(items []Item) []string {
+	var out []string
+	for _, it := range items {
+		out = append(out, it.Text())
+	}
+	return out
+}
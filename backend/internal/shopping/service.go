@@ -0,0 +1,269 @@
+// Package shopping construit des listes de courses à partir des ingrédients
+// de plusieurs recettes, partagées avec les collaborateurs d'un dossier.
+package shopping
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"github.com/tribbae/backend/internal/recipe"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxSources limite le nombre de recettes d'une liste
+const maxSources = 100
+
+var (
+	ErrNoSources     = errors.New("no recipe given")
+	ErrTooManyLinks  = errors.New("too many recipes")
+	ErrListNotFound  = errors.New("shopping list not found")
+	ErrItemNotFound  = errors.New("shopping item not found")
+	ErrNotAuthorized = errors.New("not authorized")
+	ErrInvalidFolder = errors.New("folder not found or not accessible")
+)
+
+// LinkSource donne accès aux liens et aux dossiers d'un utilisateur.
+// Implémenté par link.Service.
+type LinkSource interface {
+	Get(ctx context.Context, linkID, userID string) (*link.Link, error)
+	List(ctx context.Context, userID string, opts link.ListOptions) ([]*link.Link, string, error)
+	AccessibleFolderIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+// Source est une recette de la liste. Servings adapte ses quantités ; à 0,
+// la recette est prise telle qu'écrite.
+type Source struct {
+	LinkID   string `bson:"link_id"  json:"link_id"`
+	Servings int32  `bson:"servings" json:"servings"`
+}
+
+// List est une liste de courses. Quand FolderID est renseigné, les
+// collaborateurs de ce dossier la voient et peuvent cocher ses articles.
+type List struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OwnerID   string             `bson:"owner_id"      json:"owner_id"`
+	FolderID  string             `bson:"folder_id"     json:"folder_id"`
+	Title     string             `bson:"title"         json:"title"`
+	Sources   []Source           `bson:"sources"       json:"sources"`
+	Items     []Item             `bson:"items"         json:"items"`
+	CreatedAt time.Time          `bson:"created_at"    json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"    json:"updated_at"`
+}
+
+type Service struct {
+	col   *mongo.Collection
+	links LinkSource
+}
+
+func NewService(col *mongo.Collection, links LinkSource) *Service {
+	return &Service{col: col, links: links}
+}
+
+// Create construit une liste à partir des recettes sources, ou de tous les
+// liens du dossier folderID si sources est vide. La liste est partagée avec
+// les collaborateurs de folderID.
+func (s *Service) Create(ctx context.Context, userID, title, folderID string, sources []Source) (*List, error) {
+	if folderID != "" {
+		ok, err := s.canAccessFolder(ctx, userID, folderID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrInvalidFolder
+		}
+	}
+	if len(sources) == 0 && folderID != "" {
+		folderSources, err := s.folderSources(ctx, userID, folderID)
+		if err != nil {
+			return nil, err
+		}
+		sources = folderSources
+	}
+	if len(sources) == 0 {
+		return nil, ErrNoSources
+	}
+	if len(sources) > maxSources {
+		return nil, ErrTooManyLinks
+	}
+
+	items, err := s.Build(ctx, userID, sources)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	l := &List{
+		ID:        primitive.NewObjectID(),
+		OwnerID:   userID,
+		FolderID:  folderID,
+		Title:     title,
+		Sources:   sources,
+		Items:     items,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := s.col.InsertOne(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Build retourne les articles fusionnés des recettes sources, chacune adaptée
+// à son nombre de parts quand il est connu
+func (s *Service) Build(ctx context.Context, userID string, sources []Source) ([]Item, error) {
+	var ingredients []sourced
+	for _, src := range sources {
+		l, err := s.links.Get(ctx, src.LinkID, userID)
+		if err != nil {
+			return nil, link.ErrLinkNotFound
+		}
+		factor := 1.0
+		if src.Servings > 0 && l.Recipe != nil && l.Recipe.Servings > 0 {
+			factor = float64(src.Servings) / float64(l.Recipe.Servings)
+		}
+		parsed := l.ParsedIngredients
+		if len(parsed) == 0 {
+			parsed = recipe.ParseIngredients(l.Ingredients)
+		}
+		for _, ing := range parsed {
+			ingredients = append(ingredients, sourced{LinkID: src.LinkID, Ingredient: ing.Scale(factor)})
+		}
+	}
+	return Merge(ingredients), nil
+}
+
+// folderSources retourne les liens du dossier qui ont des ingrédients
+func (s *Service) folderSources(ctx context.Context, userID, folderID string) ([]Source, error) {
+	var sources []Source
+	opts := link.ListOptions{FolderID: folderID, PageSize: 500}
+	for {
+		links, next, err := s.links.List(ctx, userID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range links {
+			if len(l.Ingredients) > 0 {
+				sources = append(sources, Source{LinkID: l.ID.Hex()})
+			}
+		}
+		if next == "" || len(sources) > maxSources {
+			return sources, nil
+		}
+		opts.PageToken = next
+	}
+}
+
+// Get retourne une liste visible par l'utilisateur
+func (s *Service) Get(ctx context.Context, listID, userID string) (*List, error) {
+	l, err := s.load(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canAccess(ctx, l, userID) {
+		return nil, ErrListNotFound
+	}
+	return l, nil
+}
+
+// ListForUser retourne les listes de l'utilisateur et celles partagées avec
+// lui par ses dossiers, les plus récentes d'abord
+func (s *Service) ListForUser(ctx context.Context, userID string) ([]*List, error) {
+	folderIDs, err := s.links.AccessibleFolderIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	scope := bson.A{bson.M{"owner_id": userID}}
+	if len(folderIDs) > 0 {
+		scope = append(scope, bson.M{"folder_id": bson.M{"$in": folderIDs}})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := s.col.Find(ctx, bson.M{"$or": scope}, opts)
+	if err != nil {
+		return nil, err
+	}
+	lists := []*List{}
+	if err := cursor.All(ctx, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// CheckItem coche ou décoche un article ; tous ceux qui voient la liste le peuvent
+func (s *Service) CheckItem(ctx context.Context, listID, itemID, userID string, checked bool) (*List, error) {
+	l, err := s.Get(ctx, listID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(l.Items, func(it Item) bool { return it.ID == itemID }) {
+		return nil, ErrItemNotFound
+	}
+
+	set := bson.M{"items.$.checked": checked, "updated_at": time.Now()}
+	update := bson.M{"$set": set}
+	if checked {
+		set["items.$.checked_by"] = userID
+		set["items.$.checked_at"] = time.Now()
+	} else {
+		update["$unset"] = bson.M{"items.$.checked_by": "", "items.$.checked_at": ""}
+	}
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": l.ID, "items.id": itemID}, update)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrItemNotFound
+	}
+	return s.load(ctx, listID)
+}
+
+// Delete supprime une liste ; seul son créateur le peut
+func (s *Service) Delete(ctx context.Context, listID, userID string) error {
+	l, err := s.Get(ctx, listID, userID)
+	if err != nil {
+		return err
+	}
+	if l.OwnerID != userID {
+		return ErrNotAuthorized
+	}
+	_, err = s.col.DeleteOne(ctx, bson.M{"_id": l.ID})
+	return err
+}
+
+func (s *Service) load(ctx context.Context, listID string) (*List, error) {
+	id, err := primitive.ObjectIDFromHex(listID)
+	if err != nil {
+		return nil, ErrListNotFound
+	}
+	var l List
+	if err := s.col.FindOne(ctx, bson.M{"_id": id}).Decode(&l); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrListNotFound
+		}
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (s *Service) canAccess(ctx context.Context, l *List, userID string) bool {
+	if l.OwnerID == userID {
+		return true
+	}
+	if l.FolderID == "" {
+		return false
+	}
+	ok, err := s.canAccessFolder(ctx, userID, l.FolderID)
+	return err == nil && ok
+}
+
+func (s *Service) canAccessFolder(ctx context.Context, userID, folderID string) (bool, error) {
+	folderIDs, err := s.links.AccessibleFolderIDs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(folderIDs, folderID), nil
+}
@@ -0,0 +1,102 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tribbae/backend/internal/link"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupTestDB(t *testing.T) (*mongo.Client, *mongo.Database, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientOpts := options.Client().
+		ApplyURI("mongodb://localhost:27017").
+		SetServerSelectionTimeout(5 * time.Second)
+
+	client, err := mongo.Connect(ctx, clientOpts)
+	if err != nil {
+		t.Skipf("Skipping test: Failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Skipf("Skipping test: MongoDB not available: %v", err)
+	}
+
+	database := client.Database("tribbae_test_" + primitive.NewObjectID().Hex())
+	cleanup := func() {
+		ctx := context.Background()
+		if err := database.Drop(ctx); err != nil {
+			t.Logf("Failed to drop test database: %v", err)
+		}
+		if err := client.Disconnect(ctx); err != nil {
+			t.Logf("Failed to disconnect from MongoDB: %v", err)
+		}
+	}
+	return client, database, cleanup
+}
+
+// Une liste construite depuis un dossier est visible et cochable par ses
+// collaborateurs, et par eux seuls
+func TestShoppingList_FolderSharing(t *testing.T) {
+	_, db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	links := link.NewService(db.Collection("links"), db.Collection("folders"))
+	svc := NewService(db.Collection("shopping_lists"), links)
+	ownerID := primitive.NewObjectID().Hex()
+	collaboratorID := primitive.NewObjectID().Hex()
+	strangerID := primitive.NewObjectID().Hex()
+
+	folderID := primitive.NewObjectID()
+	if _, err := db.Collection("folders").InsertOne(ctx, bson.M{
+		"_id": folderID, "owner_id": ownerID, "name": "Repas de la semaine",
+		"collaborators": bson.A{bson.M{"user_id": collaboratorID, "role": "viewer"}},
+	}); err != nil {
+		t.Fatalf("insert folder: %v", err)
+	}
+	for _, l := range []*link.Link{
+		{FolderID: folderID.Hex(), Title: "Crêpes", Ingredients: []string{"250 g de farine", "4 œufs"}},
+		{FolderID: folderID.Hex(), Title: "Quiche", Ingredients: []string{"200 g de farine", "3 oeufs"}},
+		{FolderID: folderID.Hex(), Title: "Parc"},
+	} {
+		if _, err := links.Create(ctx, ownerID, l); err != nil {
+			t.Fatalf("create link: %v", err)
+		}
+	}
+
+	list, err := svc.Create(ctx, ownerID, "Courses", folderID.Hex(), nil)
+	if err != nil {
+		t.Fatalf("create list: %v", err)
+	}
+	if len(list.Sources) != 2 || len(list.Items) != 2 {
+		t.Fatalf("list = %+v", list)
+	}
+
+	shared, err := svc.ListForUser(ctx, collaboratorID)
+	if err != nil || len(shared) != 1 {
+		t.Fatalf("collaborator lists = %v, %v", shared, err)
+	}
+	itemID := list.Items[0].ID
+	checked, err := svc.CheckItem(ctx, list.ID.Hex(), itemID, collaboratorID, true)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !checked.Items[0].Checked || checked.Items[0].CheckedBy != collaboratorID {
+		t.Errorf("item = %+v", checked.Items[0])
+	}
+
+	if _, err := svc.CheckItem(ctx, list.ID.Hex(), itemID, strangerID, false); !errors.Is(err, ErrListNotFound) {
+		t.Errorf("stranger check err = %v, want ErrListNotFound", err)
+	}
+	if err := svc.Delete(ctx, list.ID.Hex(), collaboratorID); !errors.Is(err, ErrNotAuthorized) {
+		t.Errorf("collaborator delete err = %v, want ErrNotAuthorized", err)
+	}
+}
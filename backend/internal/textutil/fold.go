@@ -0,0 +1,81 @@
+// Package textutil regroupe la normalisation de texte partagée par la
+// recherche et l'analyse des ingrédients (minuscules, accents, mots).
+package textutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+var foldMap = map[rune]string{
+	'à': "a", 'â': "a", 'ä': "a", 'á': "a", 'ã': "a", 'å': "a",
+	'ç': "c",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e",
+	'î': "i", 'ï': "i", 'í': "i", 'ì': "i",
+	'ñ': "n",
+	'ô': "o", 'ö': "o", 'ó': "o", 'ò': "o", 'õ': "o",
+	'ù': "u", 'û': "u", 'ü': "u", 'ú': "u",
+	'ÿ': "y",
+	'œ': "oe", 'æ': "ae",
+	'’': "'",
+}
+
+// Fold met en minuscules et retire les accents : "Crème Brûlée" → "creme brulee".
+func Fold(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range strings.ToLower(s) {
+		if repl, ok := foldMap[r]; ok {
+			b.WriteString(repl)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Token est un mot d'un texte avec sa position en runes dans le texte d'origine
+type Token struct {
+	Text  string // forme repliée (Fold)
+	Start int
+	End   int
+}
+
+// Tokenize découpe un texte en mots (lettres et chiffres). Les positions sont
+// exprimées en runes dans le texte d'origine.
+func Tokenize(s string) []Token {
+	var tokens []Token
+	runes := []rune(s)
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		inWord := i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]))
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			tokens = append(tokens, Token{Text: Fold(string(runes[start:i])), Start: start, End: i})
+			start = -1
+		}
+	}
+	return tokens
+}
+
+// Stem réduit grossièrement un mot replié à sa racine pour les comparaisons :
+// retire les marques de pluriel et de féminin les plus courantes en français.
+func Stem(word string) string {
+	if len(word) <= 3 {
+		return word
+	}
+	if strings.HasSuffix(word, "eaux") {
+		return strings.TrimSuffix(word, "x")
+	}
+	if strings.HasSuffix(word, "aux") && len(word) > 4 {
+		return strings.TrimSuffix(word, "aux") + "al"
+	}
+	for _, suffix := range []string{"es", "s", "x", "e"} {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= 3 {
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
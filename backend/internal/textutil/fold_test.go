@@ -0,0 +1,51 @@
+package textutil
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	cases := map[string]string{
+		"Crème Brûlée":   "creme brulee",
+		"Noël à l’École": "noel a l'ecole",
+		"BŒUF":           "boeuf",
+		"déjà":           "deja",
+	}
+	for in, want := range cases {
+		if got := Fold(in); got != want {
+			t.Errorf("Fold(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestTokenize_RunePositions(t *testing.T) {
+	tokens := Tokenize("Gâteau, crème & 2 œufs")
+	want := []Token{
+		{Text: "gateau", Start: 0, End: 6},
+		{Text: "creme", Start: 8, End: 13},
+		{Text: "2", Start: 16, End: 17},
+		{Text: "oeufs", Start: 18, End: 22},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %d tokens %v, want %d", len(tokens), tokens, len(want))
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d = %+v, want %+v", i, tokens[i], want[i])
+		}
+	}
+}
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"gateaux": "gateau",
+		"chevaux": "cheval",
+		"pommes":  "pomm",
+		"pomme":   "pomm",
+		"jeux":    "jeu",
+		"bus":     "bus",
+	}
+	for in, want := range cases {
+		if got := Stem(in); got != want {
+			t.Errorf("Stem(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
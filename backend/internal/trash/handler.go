@@ -0,0 +1,101 @@
+package trash
+
+import (
+	"context"
+
+	pb "github.com/tribbae/backend/gen/tribbae/v1"
+	"github.com/tribbae/backend/internal/db"
+	"github.com/tribbae/backend/internal/folder"
+	"github.com/tribbae/backend/internal/interceptor"
+	"github.com/tribbae/backend/internal/link"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler expose la corbeille commune aux dossiers et aux liens.
+// La suppression logique elle-même est faite par folder.Service et link.Service.
+type Handler struct {
+	pb.UnimplementedTrashServiceServer
+	folders *folder.Service
+	links   *link.Service
+}
+
+func NewHandler(folders *folder.Service, links *link.Service) *Handler {
+	return &Handler{folders: folders, links: links}
+}
+
+func (h *Handler) ListTrash(ctx context.Context, _ *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	folders, err := h.folders.ListTrash(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trashed folders: %v", err)
+	}
+	links, err := h.links.ListTrash(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trashed links: %v", err)
+	}
+
+	items := make([]*pb.TrashItem, 0, len(folders)+len(links))
+	for _, f := range folders {
+		items = append(items, &pb.TrashItem{
+			Id:        f.ID.Hex(),
+			Type:      pb.TrashItemType_TRASH_ITEM_TYPE_FOLDER,
+			Title:     f.Name,
+			DeletedAt: timestamppb.New(*f.DeletedAt),
+			PurgeAt:   timestamppb.New(f.DeletedAt.Add(db.TrashRetention)),
+		})
+	}
+	for _, l := range links {
+		items = append(items, &pb.TrashItem{
+			Id:        l.ID.Hex(),
+			Type:      pb.TrashItemType_TRASH_ITEM_TYPE_LINK,
+			Title:     l.Title,
+			FolderId:  l.FolderID,
+			DeletedAt: timestamppb.New(*l.DeletedAt),
+			PurgeAt:   timestamppb.New(l.DeletedAt.Add(db.TrashRetention)),
+		})
+	}
+	return &pb.ListTrashResponse{Items: items}, nil
+}
+
+func (h *Handler) Restore(ctx context.Context, req *pb.RestoreRequest) (*pb.RestoreResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	switch req.Type {
+	case pb.TrashItemType_TRASH_ITEM_TYPE_FOLDER:
+		_, err = h.folders.Restore(ctx, req.Id, userID)
+	case pb.TrashItemType_TRASH_ITEM_TYPE_LINK:
+		_, err = h.links.Restore(ctx, req.Id, userID)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to restore: %v", err)
+	}
+	return &pb.RestoreResponse{}, nil
+}
+
+func (h *Handler) EmptyTrash(ctx context.Context, _ *pb.EmptyTrashRequest) (*pb.EmptyTrashResponse, error) {
+	userID, err := interceptor.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	folders, folderLinks, err := h.folders.EmptyTrash(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to empty folder trash: %v", err)
+	}
+	links, err := h.links.EmptyTrash(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to empty link trash: %v", err)
+	}
+	return &pb.EmptyTrashResponse{
+		FoldersDeleted: int32(folders),
+		LinksDeleted:   int32(folderLinks + links),
+	}, nil
+}